@@ -58,6 +58,11 @@ func (r *ccAdminUserResource) Configure(_ context.Context, req resource.Configur
 func (r *ccAdminUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan CCAdminUserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -117,6 +122,11 @@ func (r *ccAdminUserResource) Create(ctx context.Context, req resource.CreateReq
 func (r *ccAdminUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state CCAdminUserResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -157,6 +167,11 @@ func (r *ccAdminUserResource) Read(ctx context.Context, req resource.ReadRequest
 func (r *ccAdminUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.AddError(
 		"Error updating admin user",
 		"Admin Users with access_type set to Full cannot be updated.",
@@ -167,6 +182,11 @@ func (r *ccAdminUserResource) Update(ctx context.Context, req resource.UpdateReq
 func (r *ccAdminUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state CCAdminUserResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -255,6 +275,11 @@ func (r *ccAdminUserResource) ValidateConfig(ctx context.Context, req resource.V
 func (r *ccAdminUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.CCAdminsClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -4,6 +4,7 @@ package cc_admin_user
 
 import (
 	"context"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	"github.com/citrix/citrix-daas-rest-go/ccadmins"
 
@@ -27,6 +28,7 @@ type CCAdminUserResourceModel struct {
 	LastName     types.String `tfsdk:"last_name"`
 	ProviderType types.String `tfsdk:"provider_type"`
 	Type         types.String `tfsdk:"type"`
+	Site         types.String `tfsdk:"site"`
 }
 
 func (CCAdminUserResourceModel) GetSchema() schema.Schema {
@@ -34,6 +36,7 @@ func (CCAdminUserResourceModel) GetSchema() schema.Schema {
 		Description: "Citrix Cloud --- Manages an administrator user for cloud environment.",
 
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"user_id": schema.StringAttribute{
 				Description: "Id of the administrator.",
 				Computed:    true,
@@ -57,6 +57,11 @@ func (r *gacSettingsResource) Configure(_ context.Context, req resource.Configur
 func (r *gacSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan GACSettingsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -125,6 +130,11 @@ func (r *gacSettingsResource) Create(ctx context.Context, req resource.CreateReq
 func (r *gacSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state GACSettingsResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -152,6 +162,11 @@ func (r *gacSettingsResource) Read(ctx context.Context, req resource.ReadRequest
 func (r *gacSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan GACSettingsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -216,6 +231,11 @@ func (r *gacSettingsResource) Update(ctx context.Context, req resource.UpdateReq
 func (r *gacSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state GACSettingsResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -472,6 +492,11 @@ func CreateCategorySettingsForMacos(ctx context.Context, diagnostics *diag.Diagn
 func (r *gacSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.GacClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -27,6 +27,7 @@ type GACSettingsResourceModel struct {
 	Description     types.String `tfsdk:"description"`
 	UseForAppConfig types.Bool   `tfsdk:"use_for_app_config"`
 	AppSettings     types.Object `tfsdk:"app_settings"` // AppSettings
+	Site            types.String `tfsdk:"site"`
 }
 
 type AppSettings struct {
@@ -1558,6 +1559,7 @@ func GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "Citrix Cloud --- Manages the Global App Configuration settings for a service url.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"service_url": schema.StringAttribute{
 				Description: "Citrix workspace application store url for which settings are to be configured. The value is case sensitive and requires the protocol (\"https\" or \"http\") and port number.",
 				Required:    true,
@@ -0,0 +1,88 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package resource_locations
+
+import (
+	"context"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &cloudConnectorsDataSource{}
+	_ datasource.DataSourceWithConfigure = &cloudConnectorsDataSource{}
+)
+
+func NewCloudConnectorsDataSource() datasource.DataSource {
+	return &cloudConnectorsDataSource{}
+}
+
+// cloudConnectorsDataSource is the data source implementation.
+type cloudConnectorsDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata returns the data source type name.
+func (d *cloudConnectorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_connectors"
+}
+
+// Schema defines the schema for the data source.
+func (d *cloudConnectorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = CloudConnectorsDataSourceModel{}.GetSchema()
+}
+
+func (d *cloudConnectorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *cloudConnectorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ResourceLocationsClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	if d.client.AuthConfig.OnPremises {
+		resp.Diagnostics.AddError("Error reading Cloud Connectors", "Cloud Connectors are only supported for Cloud customers.")
+		return
+	}
+
+	var data CloudConnectorsDataSourceModel
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fail when the resource location does not exist instead of returning an empty list of connectors
+	_, err := GetResourceLocation(ctx, d.client, &resp.Diagnostics, data.ResourceLocationId.ValueString())
+	if err != nil {
+		return
+	}
+
+	connectors, err := getCloudConnectors(ctx, d.client, &resp.Diagnostics, data.ResourceLocationId.ValueString())
+	if err != nil {
+		return
+	}
+
+	data = data.RefreshPropertyValues(connectors)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
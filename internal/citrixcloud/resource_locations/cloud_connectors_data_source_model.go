@@ -0,0 +1,97 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package resource_locations
+
+import (
+	"regexp"
+
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CloudConnectorsDataSourceModel defines the Cloud Connectors data source implementation.
+type CloudConnectorsDataSourceModel struct {
+	ResourceLocationId types.String          `tfsdk:"resource_location_id"`
+	Connectors         []CloudConnectorModel `tfsdk:"connectors"`
+	Site               types.String          `tfsdk:"site"`
+}
+
+type CloudConnectorModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Version         types.String `tfsdk:"version"`
+	Status          types.String `tfsdk:"status"`
+	LastContactTime types.String `tfsdk:"last_contact_time"`
+}
+
+func (CloudConnectorModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the Cloud Connector.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Fully qualified domain name of the Cloud Connector.",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the Cloud Connector software.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Health status of the Cloud Connector reported by Citrix Cloud.",
+				Computed:    true,
+			},
+			"last_contact_time": schema.StringAttribute{
+				Description: "Time when the Cloud Connector last contacted Citrix Cloud.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (CloudConnectorModel) GetAttributes() map[string]schema.Attribute {
+	return CloudConnectorModel{}.GetSchema().Attributes
+}
+
+func (CloudConnectorsDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Citrix Cloud --- Data source to list the Cloud Connectors of a Citrix Cloud resource location, including their version and health.",
+
+		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
+			"resource_location_id": schema.StringAttribute{
+				Description: "ID of the resource location.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+			},
+			"connectors": schema.ListNestedAttribute{
+				Description:  "The Cloud Connectors of the resource location.",
+				Computed:     true,
+				NestedObject: CloudConnectorModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+func (r CloudConnectorsDataSourceModel) RefreshPropertyValues(connectors []cloudConnector) CloudConnectorsDataSourceModel {
+	r.Connectors = []CloudConnectorModel{}
+	for _, connector := range connectors {
+		r.Connectors = append(r.Connectors, CloudConnectorModel{
+			Id:              types.StringValue(connector.Id),
+			Name:            types.StringValue(connector.Fqdn),
+			Version:         types.StringValue(connector.CurrentVersion),
+			Status:          types.StringValue(connector.Status),
+			LastContactTime: types.StringValue(connector.LastContactDate),
+		})
+	}
+
+	return r
+}
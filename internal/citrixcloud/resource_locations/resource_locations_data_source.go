@@ -0,0 +1,89 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package resource_locations
+
+import (
+	"context"
+
+	ccresourcelocations "github.com/citrix/citrix-daas-rest-go/ccresourcelocations"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &resourceLocationDataSource{}
+	_ datasource.DataSourceWithConfigure = &resourceLocationDataSource{}
+)
+
+func NewResourceLocationDataSource() datasource.DataSource {
+	return &resourceLocationDataSource{}
+}
+
+// resourceLocationDataSource is the data source implementation.
+type resourceLocationDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata returns the data source type name.
+func (d *resourceLocationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_resource_location"
+}
+
+// Schema defines the schema for the data source.
+func (d *resourceLocationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ResourceLocationDataSourceModel{}.GetSchema()
+}
+
+func (d *resourceLocationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *resourceLocationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ResourceLocationsClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	if d.client.AuthConfig.OnPremises {
+		resp.Diagnostics.AddError("Error reading resource location", "Resource locations are only supported for Cloud customers.")
+		return
+	}
+
+	var data ResourceLocationDataSourceModel
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var resourceLocation *ccresourcelocations.CitrixCloudServicesRegistryApiModelsLocationsResourceLocationModel
+	var err error
+	if !data.Id.IsNull() {
+		resourceLocation, err = GetResourceLocation(ctx, d.client, &resp.Diagnostics, data.Id.ValueString())
+	} else {
+		resourceLocation, err = getResourceLocationByName(ctx, d.client, &resp.Diagnostics, data.Name.ValueString())
+	}
+	if err != nil {
+		return
+	}
+
+	data = data.RefreshPropertyValues(resourceLocation)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
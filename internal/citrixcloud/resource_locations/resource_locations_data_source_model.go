@@ -0,0 +1,69 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package resource_locations
+
+import (
+	ccresourcelocations "github.com/citrix/citrix-daas-rest-go/ccresourcelocations"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ResourceLocationDataSourceModel defines the Resource Location data source implementation.
+type ResourceLocationDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	InternalOnly types.Bool   `tfsdk:"internal_only"`
+	TimeZone     types.String `tfsdk:"time_zone"`
+	ReadOnly     types.Bool   `tfsdk:"read_only"`
+	Site         types.String `tfsdk:"site"`
+}
+
+func (ResourceLocationDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Citrix Cloud --- Data source to get details regarding a specific Citrix Cloud resource location.",
+
+		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
+			"id": schema.StringAttribute{
+				Description: "ID of the resource location.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")), // Ensures that only one of either Id or Name is provided. It will also cause a validation error if none are specified.
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the resource location.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"internal_only": schema.BoolAttribute{
+				Description: "Flag to determine if the resource location can only be used internally.",
+				Computed:    true,
+			},
+			"time_zone": schema.StringAttribute{
+				Description: "Timezone associated with the resource location.",
+				Computed:    true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Indicates whether the resource location is read-only.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r ResourceLocationDataSourceModel) RefreshPropertyValues(ccResourceLocation *ccresourcelocations.CitrixCloudServicesRegistryApiModelsLocationsResourceLocationModel) ResourceLocationDataSourceModel {
+	r.Id = types.StringValue(ccResourceLocation.GetId())
+	r.Name = types.StringValue(ccResourceLocation.GetName())
+	r.InternalOnly = types.BoolValue(ccResourceLocation.GetInternalOnly())
+	r.TimeZone = types.StringValue(ccResourceLocation.GetTimeZone())
+	r.ReadOnly = types.BoolValue(ccResourceLocation.GetReadOnly())
+
+	return r
+}
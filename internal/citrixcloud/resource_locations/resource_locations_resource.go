@@ -56,6 +56,11 @@ func (r *resourceLocationResource) Configure(_ context.Context, req resource.Con
 func (r *resourceLocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan ResourceLocationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -107,6 +112,11 @@ func (r *resourceLocationResource) Create(ctx context.Context, req resource.Crea
 func (r *resourceLocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state ResourceLocationResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -136,6 +146,11 @@ func (r *resourceLocationResource) Read(ctx context.Context, req resource.ReadRe
 func (r *resourceLocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan ResourceLocationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -183,6 +198,11 @@ func (r *resourceLocationResource) Update(ctx context.Context, req resource.Upda
 func (r *resourceLocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state ResourceLocationResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -232,6 +252,11 @@ func (r *resourceLocationResource) ValidateConfig(ctx context.Context, req resou
 func (r *resourceLocationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ResourceLocationsClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
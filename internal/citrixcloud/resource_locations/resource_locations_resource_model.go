@@ -4,6 +4,7 @@ package resource_locations
 
 import (
 	ccresourcelocations "github.com/citrix/citrix-daas-rest-go/ccresourcelocations"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -19,6 +20,7 @@ type ResourceLocationResourceModel struct {
 	Name         types.String `tfsdk:"name"`
 	InternalOnly types.Bool   `tfsdk:"internal_only"`
 	TimeZone     types.String `tfsdk:"time_zone"`
+	Site         types.String `tfsdk:"site"`
 }
 
 func (ResourceLocationResourceModel) GetSchema() schema.Schema {
@@ -27,6 +29,7 @@ func (ResourceLocationResourceModel) GetSchema() schema.Schema {
 		Description: "Citrix Cloud --- Manages a Citrix Cloud resource location.",
 
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "ID of the resource location.",
 				Computed:    true,
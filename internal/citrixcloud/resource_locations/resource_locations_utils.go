@@ -4,11 +4,18 @@ package resource_locations
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
 
 	resourcelocations "github.com/citrix/citrix-daas-rest-go/ccresourcelocations"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 func GetResourceLocation(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, resourceLocationId string) (*resourcelocations.CitrixCloudServicesRegistryApiModelsLocationsResourceLocationModel, error) {
@@ -25,3 +32,113 @@ func GetResourceLocation(ctx context.Context, client *citrixdaasclient.CitrixDaa
 
 	return resourceLocation, err
 }
+
+func getResourceLocationByName(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, resourceLocationName string) (*resourcelocations.CitrixCloudServicesRegistryApiModelsLocationsResourceLocationModel, error) {
+	getResourceLocationsRequest := client.ResourceLocationsClient.LocationsDAAS.LocationsGetAll(ctx)
+	resourceLocations, httpResp, err := citrixdaasclient.ExecuteWithRetry[*resourcelocations.CitrixCloudServicesRegistryApiModelsLocationsResourceLocationsResultsModel](getResourceLocationsRequest, client)
+	if err != nil {
+		diagnostics.AddError(
+			"Error reading resource location with name: "+resourceLocationName,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return nil, err
+	}
+
+	for _, resourceLocation := range resourceLocations.GetItems() {
+		if strings.EqualFold(resourceLocation.GetName(), resourceLocationName) {
+			return &resourceLocation, nil
+		}
+	}
+
+	err = fmt.Errorf("resource location with name %s not found", resourceLocationName)
+	diagnostics.AddError("Error reading resource location with name: "+resourceLocationName, err.Error())
+	return nil, err
+}
+
+// cloudConnector is a Cloud Connector returned by the Citrix Cloud connectors API, which is not part of the Citrix DaaS REST client
+type cloudConnector struct {
+	Id              string `json:"id"`
+	Fqdn            string `json:"fqdn"`
+	Location        string `json:"location"`
+	Status          string `json:"status"`
+	CurrentVersion  string `json:"currentVersion"`
+	LastContactDate string `json:"lastContactDate"`
+}
+
+func getCloudConnectors(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, resourceLocationId string) ([]cloudConnector, error) {
+	errorSummary := "Error reading Cloud Connectors of resource location with id: " + resourceLocationId
+
+	// The connectors API is served from the same Citrix Cloud host as the resource locations API
+	resourceLocationsCfg := client.ResourceLocationsClient.GetConfig()
+	connectorsUrl := strings.TrimSuffix(resourceLocationsCfg.Servers[0].URL, "/resourcelocations") + "/connectors"
+
+	token, httpResp, err := util.SignIn(client)
+	if err != nil {
+		diagnostics.AddError(
+			errorSummary,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, connectorsUrl, nil)
+	if err != nil {
+		diagnostics.AddError(errorSummary, "Error message: "+err.Error())
+		return nil, err
+	}
+	transactionId := uuid.NewString()
+	request.Header.Set("Authorization", token)
+	request.Header.Set("Citrix-CustomerId", client.ClientConfig.CustomerId)
+	request.Header.Set("Citrix-TransactionId", transactionId)
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("User-Agent", client.ClientConfig.UserAgent)
+
+	tflog.Info(ctx, "Citrix Cloud connectors API request", map[string]interface{}{
+		"url":           connectorsUrl,
+		"method":        http.MethodGet,
+		"transactionId": transactionId,
+	})
+
+	httpClient := resourceLocationsCfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	httpResp, err = httpClient.Do(request)
+	if err != nil {
+		diagnostics.AddError(
+			errorSummary,
+			"TransactionId: "+transactionId+
+				"\nError message: "+err.Error(),
+		)
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err == nil && httpResp.StatusCode >= http.StatusMultipleChoices {
+		err = fmt.Errorf("unexpected status %s: %s", httpResp.Status, string(body))
+	}
+	var connectors []cloudConnector
+	if err == nil {
+		err = json.Unmarshal(body, &connectors)
+	}
+	if err != nil {
+		diagnostics.AddError(
+			errorSummary,
+			"TransactionId: "+transactionId+
+				"\nError message: "+err.Error(),
+		)
+		return nil, err
+	}
+
+	locationConnectors := []cloudConnector{}
+	for _, connector := range connectors {
+		if strings.EqualFold(connector.Location, resourceLocationId) {
+			locationConnectors = append(locationConnectors, connector)
+		}
+	}
+
+	return locationConnectors, nil
+}
@@ -0,0 +1,88 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package admin_role
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &AdminPermissionsDataSource{}
+)
+
+func NewAdminPermissionsDataSource() datasource.DataSource {
+	return &AdminPermissionsDataSource{}
+}
+
+// AdminPermissionsDataSource defines the data source implementation.
+type AdminPermissionsDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *AdminPermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_permissions"
+}
+
+func (d *AdminPermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AdminPermissionsDataSourceModel{}.GetSchema()
+}
+
+func (d *AdminPermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *AdminPermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data AdminPermissionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, err := getPredefinedPermissions(ctx, d.client, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	data = data.RefreshPropertyValues(permissions)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func getPredefinedPermissions(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics) ([]citrixorchestration.PredefinedPermissionResponseModel, error) {
+	getPermissionsRequest := client.ApiClient.AdminAPIsDAAS.AdminGetPredefinedPermissions(ctx).Limit(util.ListDataSourcePageSize)
+	return util.GetAllPages[citrixorchestration.PredefinedPermissionResponseModel](diagnostics, "Error listing Admin Permissions", func(continuationToken string) (*citrixorchestration.PredefinedPermissionResponseModelCollection, *http.Response, error) {
+		if continuationToken != "" {
+			getPermissionsRequest = getPermissionsRequest.ContinuationToken(continuationToken)
+		}
+		return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.PredefinedPermissionResponseModelCollection](getPermissionsRequest, client)
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package admin_role
+
+import (
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AdminPermissionsDataSourceModel defines the Admin Permissions data source implementation.
+type AdminPermissionsDataSourceModel struct {
+	Permissions []AdminPermissionModel `tfsdk:"permissions"`
+	Site        types.String           `tfsdk:"site"`
+}
+
+func (AdminPermissionsDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source for the list of predefined permissions that can be assigned to an administrator role in the `permissions` attribute of `citrix_admin_role`.",
+
+		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
+			"permissions": schema.ListNestedAttribute{
+				Description:  "The predefined permissions.",
+				Computed:     true,
+				NestedObject: AdminPermissionModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+// AdminPermissionModel defines a predefined permission returned by the Admin Permissions data source.
+type AdminPermissionModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	GroupId     types.String `tfsdk:"group_id"`
+	GroupName   types.String `tfsdk:"group_name"`
+	IsReadOnly  types.Bool   `tfsdk:"is_read_only"`
+}
+
+func (AdminPermissionModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the permission, as used in the permissions of an admin role.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name of the permission.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the permission.",
+				Computed:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "ID of the group of the permission.",
+				Computed:    true,
+			},
+			"group_name": schema.StringAttribute{
+				Description: "Name of the group of the permission.",
+				Computed:    true,
+			},
+			"is_read_only": schema.BoolAttribute{
+				Description: "Indicates whether the permission only grants read access.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r AdminPermissionsDataSourceModel) RefreshPropertyValues(permissions []citrixorchestration.PredefinedPermissionResponseModel) AdminPermissionsDataSourceModel {
+	res := []AdminPermissionModel{}
+	for _, permission := range permissions {
+		res = append(res, AdminPermissionModel{
+			Id:          types.StringValue(permission.GetId()),
+			Name:        types.StringValue(permission.GetName()),
+			Description: types.StringValue(permission.GetDescription()),
+			GroupId:     types.StringValue(permission.GetGroupId()),
+			GroupName:   types.StringValue(permission.GetGroupName()),
+			IsReadOnly:  types.BoolValue(permission.GetIsReadOnly()),
+		})
+	}
+
+	r.Permissions = res
+
+	return r
+}
@@ -0,0 +1,90 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package admin_role
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AdminRoleDataSourceModel defines the Admin Role data source implementation.
+type AdminRoleDataSourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	IsBuiltIn        types.Bool   `tfsdk:"is_built_in"`
+	Description      types.String `tfsdk:"description"`
+	CanLaunchManage  types.Bool   `tfsdk:"can_launch_manage"`
+	CanLaunchMonitor types.Bool   `tfsdk:"can_launch_monitor"`
+	Permissions      types.Set    `tfsdk:"permissions"` // Set[string]
+	Site             types.String `tfsdk:"site"`
+}
+
+func (AdminRoleDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "CVAD --- Data source to get details regarding a specific administrator role.",
+
+		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
+			"id": schema.StringAttribute{
+				Description: "ID of the admin role.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")), // Ensures that only one of either Id or Name is provided. It will also cause a validation error if none are specified.
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the admin role.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"is_built_in": schema.BoolAttribute{
+				Description: "Flag to determine if the role was built-in or user defined.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the admin role.",
+				Computed:    true,
+			},
+			"can_launch_manage": schema.BoolAttribute{
+				Description: "Flag to determine if the user will have access to the Manage tab on the console.",
+				Computed:    true,
+			},
+			"can_launch_monitor": schema.BoolAttribute{
+				Description: "Flag to determine if the user will have access to the Monitor tab on the console.",
+				Computed:    true,
+			},
+			"permissions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "Permissions associated with the admin role.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r AdminRoleDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, adminRole *citrixorchestration.RoleResponseModel) AdminRoleDataSourceModel {
+	r.Id = types.StringValue(adminRole.GetId())
+	r.Name = types.StringValue(adminRole.GetName())
+	r.Description = types.StringValue(adminRole.GetDescription())
+	r.IsBuiltIn = types.BoolValue(adminRole.GetIsBuiltIn())
+	r.CanLaunchManage = types.BoolValue(adminRole.GetCanLaunchManage())
+	r.CanLaunchMonitor = types.BoolValue(adminRole.GetCanLaunchMonitor())
+
+	permissions := []string{}
+	for _, permission := range adminRole.GetPermissions() {
+		permissions = append(permissions, permission.GetId())
+	}
+	r.Permissions = util.StringArrayToStringSet(ctx, diagnostics, permissions)
+
+	return r
+}
@@ -4,12 +4,16 @@ package admin_role
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -58,6 +62,11 @@ func (r *adminRoleResource) Configure(_ context.Context, req resource.ConfigureR
 func (r *adminRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AdminRoleResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -119,6 +128,11 @@ func (r *adminRoleResource) Create(ctx context.Context, req resource.CreateReque
 func (r *adminRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state AdminRoleResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -147,6 +161,11 @@ func (r *adminRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 func (r *adminRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AdminRoleResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -199,6 +218,11 @@ func (r *adminRoleResource) Update(ctx context.Context, req resource.UpdateReque
 func (r *adminRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state AdminRoleResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -265,6 +289,11 @@ func (r *adminRoleResource) ValidateConfig(ctx context.Context, req resource.Val
 func (r *adminRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -287,5 +316,87 @@ func (r *adminRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 				resp.Diagnostics.AddError("CanLaunchMonitor", "CanLaunchMonitor can only be set to true for On-Premise deployments. Please either set the attribute to true or remove it from the configuration and try again.")
 			}
 		}
+
+		// Permissions that are not known yet are validated when the role is applied
+		if !plan.Permissions.IsUnknown() && !slices.ContainsFunc(plan.Permissions.Elements(), func(permission attr.Value) bool { return permission.IsUnknown() }) {
+			validatePermissions(ctx, r.client, &resp.Diagnostics, util.StringSetToStringArray(ctx, &resp.Diagnostics, plan.Permissions))
+		}
+	}
+}
+
+// validatePermissions fails the plan for permissions missing from the predefined permissions, and warns about permissions missing the read-only permissions of their group.
+func validatePermissions(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, permissions []string) {
+	var getPermissionsDiagnostics diag.Diagnostics
+	predefinedPermissions, err := getPredefinedPermissions(ctx, client, &getPermissionsDiagnostics)
+	if err != nil {
+		// Do not block the plan when the permissions cannot be listed, the permissions are validated again when the role is applied
+		diagnostics.AddWarning(
+			"Unable to validate Admin Role permissions",
+			"The predefined permissions could not be listed, so the permissions of the admin role are not validated before apply.\n"+util.ReadClientError(err),
+		)
+		return
+	}
+
+	checkPermissions(diagnostics, permissions, predefinedPermissions)
+}
+
+func checkPermissions(diagnostics *diag.Diagnostics, permissions []string, predefinedPermissions []citrixorchestration.PredefinedPermissionResponseModel) {
+	predefinedPermissionIds := []string{}
+	predefinedPermissionsById := map[string]citrixorchestration.PredefinedPermissionResponseModel{}
+	for _, predefinedPermission := range predefinedPermissions {
+		predefinedPermissionIds = append(predefinedPermissionIds, predefinedPermission.GetId())
+		predefinedPermissionsById[strings.ToLower(predefinedPermission.GetId())] = predefinedPermission
+	}
+
+	plannedPermissions := map[string]bool{}
+	for _, permission := range permissions {
+		plannedPermissions[strings.ToLower(permission)] = true
+	}
+
+	groupIds := []string{}
+	groupPermissions := map[string]string{} // Group ID -> permission that requires the read-only permissions of the group
+	for _, permission := range permissions {
+		predefinedPermission, exists := predefinedPermissionsById[strings.ToLower(permission)]
+		if !exists {
+			errorDetail := fmt.Sprintf("Permission %s is not a predefined permission.", permission)
+			if suggestion := util.GetClosestMatch(permission, predefinedPermissionIds); suggestion != "" {
+				errorDetail += fmt.Sprintf(" Did you mean %s?", suggestion)
+			} else {
+				errorDetail += " Use the citrix_admin_permissions data source to list the predefined permissions."
+			}
+			diagnostics.AddAttributeError(path.Root("permissions"), "Unknown Admin Role permission", errorDetail)
+			continue
+		}
+
+		groupId := predefinedPermission.GetGroupId()
+		if _, exists := groupPermissions[groupId]; !exists && !predefinedPermission.GetIsReadOnly() {
+			groupIds = append(groupIds, groupId)
+			groupPermissions[groupId] = predefinedPermission.GetId()
+		}
+	}
+
+	for _, groupId := range groupIds {
+		missingPermissions := []string{}
+		groupName := ""
+		for _, predefinedPermission := range predefinedPermissions {
+			if predefinedPermission.GetGroupId() != groupId || !predefinedPermission.GetIsReadOnly() {
+				continue
+			}
+			groupName = predefinedPermission.GetGroupName()
+			if plannedPermissions[strings.ToLower(predefinedPermission.GetId())] {
+				missingPermissions = nil
+				break
+			}
+			missingPermissions = append(missingPermissions, predefinedPermission.GetId())
+		}
+
+		if len(missingPermissions) > 0 {
+			slices.Sort(missingPermissions)
+			diagnostics.AddAttributeWarning(
+				path.Root("permissions"),
+				"Admin Role permission requires a missing permission",
+				fmt.Sprintf("Permission %s of group %s requires read access to the group, but none of the read-only permissions of the group is assigned to the admin role: %s.", groupPermissions[groupId], groupName, strings.Join(missingPermissions, ", ")),
+			)
+		}
 	}
 }
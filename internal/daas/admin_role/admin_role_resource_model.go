@@ -28,6 +28,7 @@ type AdminRoleResourceModel struct {
 	CanLaunchManage  types.Bool   `tfsdk:"can_launch_manage"`
 	CanLaunchMonitor types.Bool   `tfsdk:"can_launch_monitor"`
 	Permissions      types.Set    `tfsdk:"permissions"` //Set[string]
+	Site             types.String `tfsdk:"site"`
 }
 
 func (r AdminRoleResourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, adminRole *citrixorchestration.RoleResponseModel) AdminRoleResourceModel {
@@ -55,6 +56,7 @@ func (AdminRoleResourceModel) GetSchema() schema.Schema {
 		Description: "CVAD --- Manages an administrator role.",
 
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "ID of the admin role.",
 				Computed:    true,
@@ -92,8 +94,8 @@ func (AdminRoleResourceModel) GetSchema() schema.Schema {
 			},
 			"permissions": schema.SetAttribute{
 				ElementType: types.StringType,
-				Description: "Permissions to be associated with the admin role. " +
-					"\n\n-> **Note** To get a list of supported permissions, please refer to [Admin Predefined Permissions for Cloud](https://developer-docs.citrix.com/en-us/citrix-daas-service-apis/citrix-daas-rest-apis/apis/#/Admin-APIs/Admin-GetPredefinedPermissions) and [Admin Predefined Permissions for On-Premise](https://developer-docs.citrix.com/en-us/citrix-virtual-apps-desktops/citrix-cvad-rest-apis/apis/#/Admin-APIs/Admin-GetPredefinedPermissions).",
+				Description: "Permissions to be associated with the admin role. Unknown permissions fail the plan. " +
+					"\n\n-> **Note** To get a list of supported permissions, use the `citrix_admin_permissions` data source, or refer to [Admin Predefined Permissions for Cloud](https://developer-docs.citrix.com/en-us/citrix-daas-service-apis/citrix-daas-rest-apis/apis/#/Admin-APIs/Admin-GetPredefinedPermissions) and [Admin Predefined Permissions for On-Premise](https://developer-docs.citrix.com/en-us/citrix-virtual-apps-desktops/citrix-cvad-rest-apis/apis/#/Admin-APIs/Admin-GetPredefinedPermissions).",
 				Required: true,
 				Validators: []validator.Set{
 					setvalidator.SizeAtLeast(1),
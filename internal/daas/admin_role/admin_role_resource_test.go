@@ -0,0 +1,66 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package admin_role
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func newTestPredefinedPermission(id string, groupId string, isReadOnly bool) citrixorchestration.PredefinedPermissionResponseModel {
+	permission := citrixorchestration.PredefinedPermissionResponseModel{}
+	permission.SetId(id)
+	permission.SetGroupId(groupId)
+	permission.SetGroupName(groupId)
+	permission.SetIsReadOnly(isReadOnly)
+	return permission
+}
+
+func TestCheckPermissions(t *testing.T) {
+	predefinedPermissions := []citrixorchestration.PredefinedPermissionResponseModel{
+		newTestPredefinedPermission("Catalog_Read", "Catalog", true),
+		newTestPredefinedPermission("Catalog_Update", "Catalog", false),
+		newTestPredefinedPermission("Catalog_Delete", "Catalog", false),
+		newTestPredefinedPermission("Director_DismissAlerts", "Director", false),
+	}
+
+	tests := []struct {
+		name            string
+		permissions     []string
+		expectedError   string
+		expectedWarning string
+	}{
+		{name: "ValidPermissions", permissions: []string{"Catalog_Read", "Catalog_Update"}},
+		{name: "CaseInsensitive", permissions: []string{"catalog_read", "catalog_update"}},
+		{name: "UnknownPermission", permissions: []string{"Catalog_Updat", "Catalog_Read"}, expectedError: "Did you mean Catalog_Update?"},
+		{name: "MissingReadOnlyPermission", permissions: []string{"Catalog_Update", "Catalog_Delete"}, expectedWarning: "Permission Catalog_Update of group Catalog requires read access to the group"},
+		{name: "GroupWithoutReadOnlyPermission", permissions: []string{"Director_DismissAlerts"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diagnostics := diag.Diagnostics{}
+			checkPermissions(&diagnostics, test.permissions, predefinedPermissions)
+
+			checkDiagnostics := func(severity string, diagnostics diag.Diagnostics, expected string) {
+				if expected == "" {
+					if len(diagnostics) > 0 {
+						t.Errorf("expected no %s, got %v", severity, diagnostics)
+					}
+					return
+				}
+				if len(diagnostics) != 1 {
+					t.Fatalf("expected 1 %s, got %v", severity, diagnostics)
+				}
+				if !strings.Contains(diagnostics[0].Detail(), expected) {
+					t.Errorf("expected %s to contain %q, got %q", severity, expected, diagnostics[0].Detail())
+				}
+			}
+			checkDiagnostics("error", diagnostics.Errors(), test.expectedError)
+			checkDiagnostics("warning", diagnostics.Warnings(), test.expectedWarning)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package admin_role
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &AdminRolesDataSource{}
+)
+
+func NewAdminRolesDataSource() datasource.DataSource {
+	return &AdminRolesDataSource{}
+}
+
+// AdminRolesDataSource defines the data source implementation.
+type AdminRolesDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *AdminRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_roles"
+}
+
+func (d *AdminRolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AdminRolesDataSourceModel{}.GetSchema()
+}
+
+func (d *AdminRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *AdminRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data AdminRolesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := util.NewListFilter(data.NameRegex.ValueString(), "", "")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		return
+	}
+
+	getAdminRolesRequest := d.client.ApiClient.AdminAPIsDAAS.AdminGetAdminRoles(ctx).Limit(util.ListDataSourcePageSize)
+	adminRoles, err := util.GetAllPages[citrixorchestration.RoleResponseModel](&resp.Diagnostics, "Error listing Admin Roles", func(continuationToken string) (*citrixorchestration.RoleResponseModelCollection, *http.Response, error) {
+		if continuationToken != "" {
+			getAdminRolesRequest = getAdminRolesRequest.ContinuationToken(continuationToken)
+		}
+		return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.RoleResponseModelCollection](getAdminRolesRequest, d.client)
+	})
+	if err != nil {
+		return
+	}
+
+	result := []citrixorchestration.RoleResponseModel{}
+	for _, adminRole := range adminRoles {
+		if filter.MatchesName(adminRole.GetName()) {
+			result = append(result, adminRole)
+		}
+	}
+
+	data = data.RefreshPropertyValues(result)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,81 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package admin_role
+
+import (
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AdminRolesDataSourceModel defines the Admin Roles data source implementation.
+type AdminRolesDataSourceModel struct {
+	NameRegex  types.String            `tfsdk:"name_regex"`
+	AdminRoles []AdminRoleSummaryModel `tfsdk:"admin_roles"`
+	Site       types.String            `tfsdk:"site"`
+}
+
+func (AdminRolesDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source for the list of delegated admin roles, optionally filtered by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"site":       util.GetSiteDataSourceSchema(),
+			"name_regex": util.GetNameRegexFilterDataSourceSchema("admin roles"),
+			"admin_roles": schema.ListNestedAttribute{
+				Description:  "The admin roles matching the filters.",
+				Computed:     true,
+				NestedObject: AdminRoleSummaryModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+// AdminRoleSummaryModel defines the summary of an admin role returned by the Admin Roles data source.
+type AdminRoleSummaryModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsBuiltIn   types.Bool   `tfsdk:"is_built_in"`
+}
+
+func (AdminRoleSummaryModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the admin role.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the admin role.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the admin role.",
+				Computed:    true,
+			},
+			"is_built_in": schema.BoolAttribute{
+				Description: "Indicates whether the admin role is a built-in role.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r AdminRolesDataSourceModel) RefreshPropertyValues(adminRoles []citrixorchestration.RoleResponseModel) AdminRolesDataSourceModel {
+	res := []AdminRoleSummaryModel{}
+	for _, adminRole := range adminRoles {
+		res = append(res, AdminRoleSummaryModel{
+			Id:          types.StringValue(adminRole.GetId()),
+			Name:        types.StringValue(adminRole.GetName()),
+			Description: types.StringValue(adminRole.GetDescription()),
+			IsBuiltIn:   types.BoolValue(adminRole.GetIsBuiltIn()),
+		})
+	}
+
+	r.AdminRoles = res
+
+	return r
+}
@@ -4,6 +4,7 @@ package admin_scope
 
 import (
 	"context"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -23,6 +24,7 @@ type AdminScopeDataSourceModel struct {
 	IsTenantScope types.Bool   `tfsdk:"is_tenant_scope"`
 	TenantId      types.String `tfsdk:"tenant_id"`
 	TenantName    types.String `tfsdk:"tenant_name"`
+	Site          types.String `tfsdk:"site"`
 }
 
 func (r AdminScopeDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, adminScope *citrixorchestration.ScopeResponseModel) AdminScopeDataSourceModel {
@@ -45,6 +47,7 @@ func GetAdminScopeDataSourceSchema() schema.Schema {
 		Description: "CVAD --- Data source to get details regarding a specific Administrator scope.",
 
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
 			"id": schema.StringAttribute{
 				Description: "ID of the Admin Scope.",
 				Optional:    true,
@@ -58,6 +58,11 @@ func (r *adminScopeResource) Configure(_ context.Context, req resource.Configure
 func (r *adminScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AdminScopeResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -106,6 +111,11 @@ func (r *adminScopeResource) Create(ctx context.Context, req resource.CreateRequ
 func (r *adminScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state AdminScopeResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -134,6 +144,11 @@ func (r *adminScopeResource) Read(ctx context.Context, req resource.ReadRequest,
 func (r *adminScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AdminScopeResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -183,6 +198,11 @@ func (r *adminScopeResource) Update(ctx context.Context, req resource.UpdateRequ
 func (r *adminScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state AdminScopeResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -248,6 +268,11 @@ func (r *adminScopeResource) ValidateConfig(ctx context.Context, req resource.Va
 func (r *adminScopeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -4,6 +4,7 @@ package admin_scope
 
 import (
 	"context"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	citrixorchestration "github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 
@@ -20,6 +21,7 @@ type AdminScopeResourceModel struct {
 	Id          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
+	Site        types.String `tfsdk:"site"`
 }
 
 func (r AdminScopeResourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, adminScope *citrixorchestration.ScopeResponseModel) AdminScopeResourceModel {
@@ -37,6 +39,7 @@ func (AdminScopeResourceModel) GetSchema() schema.Schema {
 		// This description is used by the documentation generator and the language server.
 		Description: "CVAD --- Manages an administrator scope.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "ID of the admin scope.",
 				Computed:    true,
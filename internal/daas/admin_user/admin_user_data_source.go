@@ -0,0 +1,82 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package admin_user
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &AdminUserDataSource{}
+)
+
+func NewAdminUserDataSource() datasource.DataSource {
+	return &AdminUserDataSource{}
+}
+
+// AdminUserDataSource defines the data source implementation.
+type AdminUserDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *AdminUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_user"
+}
+
+func (d *AdminUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = AdminUserDataSourceModel{}.GetSchema()
+}
+
+func (d *AdminUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *AdminUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data AdminUserDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var adminUser *citrixorchestration.AdministratorResponseModel
+	var err error
+	if data.Id.ValueString() != "" {
+		adminUser, err = getAdminUser(ctx, d.client, &resp.Diagnostics, data.Id.ValueString())
+	} else {
+		adminUser, err = getAdminIfExists(ctx, d.client, &resp.Diagnostics, data.DomainName.ValueString(), data.Name.ValueString())
+	}
+	if err != nil {
+		return
+	}
+
+	data = data.RefreshPropertyValues(ctx, adminUser)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,107 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package admin_user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AdminUserDataSourceModel defines the Admin User data source implementation.
+type AdminUserDataSourceModel struct {
+	Id         types.String              `tfsdk:"id"`
+	Name       types.String              `tfsdk:"name"`
+	DomainName types.String              `tfsdk:"domain_name"`
+	Rights     []AdminUserRightDataModel `tfsdk:"rights"`
+	IsEnabled  types.Bool                `tfsdk:"is_enabled"`
+	Site       types.String              `tfsdk:"site"`
+}
+
+// AdminUserRightDataModel defines a right of the admin user returned by the Admin User data source.
+type AdminUserRightDataModel struct {
+	Role  types.String `tfsdk:"role"`
+	Scope types.String `tfsdk:"scope"`
+}
+
+func (AdminUserDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "CVAD --- Data source to get details regarding a specific administrator user.",
+
+		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
+			"id": schema.StringAttribute{
+				Description: "ID of the admin user.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")), // Ensures that only one of either Id or Name is provided. It will also cause a validation error if none are specified.
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the admin user.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("domain_name")),
+				},
+			},
+			"domain_name": schema.StringAttribute{
+				Description: "Name of the domain that the user is a part of. Required when the admin user is looked up by name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"rights": schema.ListNestedAttribute{
+				Description: "Rights associated with the admin user.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Description: "Name of the role associated with the admin user.",
+							Computed:    true,
+						},
+						"scope": schema.StringAttribute{
+							Description: "Name of the scope associated with the admin user.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"is_enabled": schema.BoolAttribute{
+				Description: "Flag to determine if the administrator is enabled or not.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r AdminUserDataSourceModel) RefreshPropertyValues(ctx context.Context, adminUser *citrixorchestration.AdministratorResponseModel) AdminUserDataSourceModel {
+	userDetails := adminUser.GetUser()
+	userFQDN := strings.Split(userDetails.GetSamName(), "\\")
+
+	r.Id = types.StringValue(userDetails.GetSid())
+	r.Name = types.StringValue(userFQDN[len(userFQDN)-1])
+	r.DomainName = types.StringValue(userDetails.GetDomain())
+	r.IsEnabled = types.BoolValue(adminUser.GetEnabled())
+
+	rights := []AdminUserRightDataModel{}
+	for _, right := range adminUser.GetScopesAndRoles() {
+		role := right.GetRole()
+		scope := right.GetScope()
+		rights = append(rights, AdminUserRightDataModel{
+			Role:  types.StringValue(role.GetName()),
+			Scope: types.StringValue(scope.GetName()),
+		})
+	}
+	r.Rights = rights
+
+	return r
+}
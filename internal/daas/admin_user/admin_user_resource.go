@@ -59,6 +59,11 @@ func (r *adminUserResource) Configure(_ context.Context, req resource.ConfigureR
 func (r *adminUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AdminUserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -117,6 +122,11 @@ func (r *adminUserResource) Create(ctx context.Context, req resource.CreateReque
 func (r *adminUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state AdminUserResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -145,6 +155,11 @@ func (r *adminUserResource) Read(ctx context.Context, req resource.ReadRequest,
 func (r *adminUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AdminUserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -203,6 +218,11 @@ func (r *adminUserResource) Update(ctx context.Context, req resource.UpdateReque
 func (r *adminUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state AdminUserResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -327,6 +347,11 @@ func (r *adminUserResource) ValidateConfig(ctx context.Context, req resource.Val
 func (r *adminUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
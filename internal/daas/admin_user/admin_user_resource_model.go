@@ -25,6 +25,7 @@ type AdminUserResourceModel struct {
 	DomainName types.String `tfsdk:"domain_name"`
 	Rights     types.List   `tfsdk:"rights"` //List[RightsModel]
 	IsEnabled  types.Bool   `tfsdk:"is_enabled"`
+	Site       types.String `tfsdk:"site"`
 }
 
 type RightsModel struct {
@@ -121,6 +122,7 @@ func (AdminUserResourceModel) GetSchema() schema.Schema {
 		Description: "CVAD --- Manages an administrator user for on-premise environment.",
 
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "ID of the admin user.",
 				Computed:    true,
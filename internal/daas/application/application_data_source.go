@@ -0,0 +1,85 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package application
+
+import (
+	"context"
+	"strings"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &ApplicationDataSource{}
+)
+
+func NewApplicationDataSource() datasource.DataSource {
+	return &ApplicationDataSource{}
+}
+
+// ApplicationDataSource defines the data source implementation.
+type ApplicationDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *ApplicationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application"
+}
+
+func (d *ApplicationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ApplicationDataSourceModel{}.GetSchema()
+}
+
+func (d *ApplicationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data ApplicationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationNameOrId := data.Id.ValueString()
+	if data.Name.ValueString() != "" {
+		// Applications in a folder are identified by the folder path followed by the application name
+		applicationNameOrId = data.Name.ValueString()
+		if applicationFolderPath := util.NormalizeFolderPath(data.ApplicationFolderPath.ValueString()); applicationFolderPath != "" {
+			applicationNameOrId = strings.ReplaceAll(applicationFolderPath, "\\", "|") + "|" + applicationNameOrId
+		}
+	}
+
+	application, err := getApplication(ctx, d.client, &resp.Diagnostics, applicationNameOrId)
+	if err != nil {
+		return
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, application)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,208 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package application
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ApplicationDataSourceModel defines the Application data source implementation.
+type ApplicationDataSourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	PublishedName          types.String `tfsdk:"published_name"`
+	Description            types.String `tfsdk:"description"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	InstalledAppProperties types.Object `tfsdk:"installed_app_properties"` // InstalledAppResponseModel
+	DeliveryGroups         types.Set    `tfsdk:"delivery_groups"`          // Set[string]
+	ApplicationFolderPath  types.String `tfsdk:"application_folder_path"`
+	Icon                   types.String `tfsdk:"icon"`
+	LimitVisibilityToUsers types.Set    `tfsdk:"limit_visibility_to_users"` // Set[string]
+	Site                   types.String `tfsdk:"site"`
+}
+
+func (ApplicationDataSourceModel) GetSchema() schema.Schema {
+	attributes := ApplicationDetailsModel{}.GetSchema().Attributes
+	attributes["site"] = util.GetSiteDataSourceSchema()
+	attributes["id"] = schema.StringAttribute{
+		Description: "GUID identifier of the application.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")), // Ensures that only one of either Id or Name is provided. It will also cause a validation error if none are specified.
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "Name of the application.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["application_folder_path"] = schema.StringAttribute{
+		Description: "Path of the application folder of the application. Required to look up an application by name when it is not in the root folder.",
+		Optional:    true,
+		Computed:    true,
+	}
+
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "CVAD --- Data source to get details regarding a specific application.",
+		Attributes:  attributes,
+	}
+}
+
+func (r ApplicationDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, application *citrixorchestration.ApplicationDetailResponseModel) ApplicationDataSourceModel {
+	details := NewApplicationDetailsModel(ctx, diagnostics, application)
+
+	r.Id = details.Id
+	r.Name = details.Name
+	r.PublishedName = details.PublishedName
+	r.Description = details.Description
+	r.Enabled = details.Enabled
+	r.InstalledAppProperties = details.InstalledAppProperties
+	r.DeliveryGroups = details.DeliveryGroups
+	r.ApplicationFolderPath = details.ApplicationFolderPath
+	r.Icon = details.Icon
+	r.LimitVisibilityToUsers = details.LimitVisibilityToUsers
+
+	return r
+}
+
+// ApplicationDetailsModel defines the details of an application returned by the application data sources.
+type ApplicationDetailsModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	PublishedName          types.String `tfsdk:"published_name"`
+	Description            types.String `tfsdk:"description"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	InstalledAppProperties types.Object `tfsdk:"installed_app_properties"` // InstalledAppResponseModel
+	DeliveryGroups         types.Set    `tfsdk:"delivery_groups"`          // Set[string]
+	ApplicationFolderPath  types.String `tfsdk:"application_folder_path"`
+	Icon                   types.String `tfsdk:"icon"`
+	LimitVisibilityToUsers types.Set    `tfsdk:"limit_visibility_to_users"` // Set[string]
+}
+
+func (ApplicationDetailsModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the application.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the application.",
+				Computed:    true,
+			},
+			"published_name": schema.StringAttribute{
+				Description: "The published name of the application.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the application.",
+				Computed:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Indicates whether the application is enabled.",
+				Computed:    true,
+			},
+			"installed_app_properties": schema.SingleNestedAttribute{
+				Description: "The installed application properties of the application.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"command_line_arguments": schema.StringAttribute{
+						Description: "The command-line arguments to use when launching the executable. Environment variables can be used.",
+						Computed:    true,
+					},
+					"command_line_executable": schema.StringAttribute{
+						Description: "The name of the executable file to launch. The full path need not be provided if it's already in the path. Environment variables can also be used.",
+						Computed:    true,
+					},
+					"working_directory": schema.StringAttribute{
+						Description: "The working directory which the executable is launched from. Environment variables can be used.",
+						Computed:    true,
+					},
+				},
+			},
+			"delivery_groups": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The delivery groups which the application is associated with.",
+				Computed:    true,
+			},
+			"application_folder_path": schema.StringAttribute{
+				Description: "The path of the folder which the application belongs to",
+				Computed:    true,
+			},
+			"icon": schema.StringAttribute{
+				Description: "The Id of the icon associated with the application.",
+				Computed:    true,
+			},
+			"limit_visibility_to_users": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The users to which the visibility of the application is limited. Empty when the application is visible to all users of its delivery groups.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// applicationResponse is implemented by both the application and the application detail response models.
+type applicationResponse interface {
+	GetId() string
+	GetName() string
+	GetPublishedName() string
+	GetDescription() string
+	GetEnabled() bool
+	GetInstalledAppProperties() citrixorchestration.InstalledAppResponseModel
+	GetAssociatedDeliveryGroupUuids() []string
+	GetApplicationFolder() citrixorchestration.RefResponseModel
+	GetIconId() string
+}
+
+func NewApplicationDetailsModel(ctx context.Context, diagnostics *diag.Diagnostics, application *citrixorchestration.ApplicationDetailResponseModel) ApplicationDetailsModel {
+	limitVisibilityToUsers := []string{}
+	if application.GetIncludedUserFilterEnabled() {
+		for _, user := range application.GetIncludedUsers() {
+			if user.GetSamName() != "" {
+				limitVisibilityToUsers = append(limitVisibilityToUsers, user.GetSamName())
+			} else {
+				limitVisibilityToUsers = append(limitVisibilityToUsers, user.GetPrincipalName())
+			}
+		}
+	}
+
+	details := newApplicationDetailsModel(ctx, diagnostics, application)
+	details.LimitVisibilityToUsers = util.StringArrayToStringSet(ctx, diagnostics, limitVisibilityToUsers)
+	return details
+}
+
+// newApplicationDetailsModel builds the details of an application without limit_visibility_to_users, which is only returned by the application detail response.
+func newApplicationDetailsModel(ctx context.Context, diagnostics *diag.Diagnostics, application applicationResponse) ApplicationDetailsModel {
+	installedAppProperties := application.GetInstalledAppProperties()
+	installedApp := InstalledAppResponseModel{
+		CommandLineArguments:  types.StringValue(installedAppProperties.GetCommandLineArguments()),
+		CommandLineExecutable: types.StringValue(installedAppProperties.GetCommandLineExecutable()),
+		WorkingDirectory:      types.StringValue(installedAppProperties.GetWorkingDirectory()),
+	}
+
+	applicationFolder := application.GetApplicationFolder()
+	return ApplicationDetailsModel{
+		Id:                     types.StringValue(application.GetId()),
+		Name:                   types.StringValue(application.GetName()),
+		PublishedName:          types.StringValue(application.GetPublishedName()),
+		Description:            types.StringValue(application.GetDescription()),
+		Enabled:                types.BoolValue(application.GetEnabled()),
+		InstalledAppProperties: util.TypedObjectToObjectValue(ctx, diagnostics, installedApp),
+		DeliveryGroups:         util.StringArrayToStringSet(ctx, diagnostics, application.GetAssociatedDeliveryGroupUuids()),
+		ApplicationFolderPath:  types.StringValue(applicationFolder.GetName()),
+		Icon:                   types.StringValue(application.GetIconId()),
+		LimitVisibilityToUsers: types.SetNull(types.StringType),
+	}
+}
@@ -4,98 +4,67 @@ package application
 
 import (
 	"context"
+	"net/http"
 	"strings"
 
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ datasource.DataSource = &ApplicationDataSource{}
+	_ datasource.DataSource = &ApplicationFolderDetailsDataSource{}
 )
 
-func NewApplicationDataSourceSource() datasource.DataSource {
-	return &ApplicationDataSource{}
+func NewApplicationFolderDetailsDataSource() datasource.DataSource {
+	return &ApplicationFolderDetailsDataSource{}
 }
 
-// ApplicationDataSource defines the data source implementation.
-type ApplicationDataSource struct {
+// ApplicationFolderDetailsDataSource defines the data source implementation.
+type ApplicationFolderDetailsDataSource struct {
 	client *citrixdaasclient.CitrixDaasClient
 }
 
-func (d *ApplicationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+func (d *ApplicationFolderDetailsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_application_folder_details"
 }
 
 // Schema defines the data source schema.
-func (d *ApplicationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+func (d *ApplicationFolderDetailsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "CVAD --- Data source for retrieving details of applications belonging to a specific folder.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
 			"path": schema.StringAttribute{
 				Description: "The path of the folder to get the applications from.",
 				Required:    true,
 			},
 			"total_applications": schema.Int64Attribute{
-				Description: "The total number of applications in the folder.",
+				Description: "The total number of applications in the folder, including the applications of its subfolders when `recursive` is `true`.",
 				Computed:    true,
 			},
+			"recursive": schema.BoolAttribute{
+				Description: "Indicates whether the applications of the subfolders of the folder are also returned. Defaults to `false`.",
+				Optional:    true,
+			},
+			"include_limit_visibility_to_users": schema.BoolAttribute{
+				Description: "Indicates whether `limit_visibility_to_users` is read for each application. Reading it requires one additional request per application. When `false`, `limit_visibility_to_users` is null. Defaults to `false`.",
+				Optional:    true,
+			},
 			"applications_list": schema.ListNestedAttribute{
-				Description: "The applications list associated with the specified folder.",
-				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Description: "The name of the application.",
-							Computed:    true,
-						},
-						"published_name": schema.StringAttribute{
-							Description: "The published name of the application.",
-							Computed:    true,
-						},
-						"description": schema.StringAttribute{
-							Description: "The description of the application.",
-							Computed:    true,
-						},
-						"installed_app_properties": schema.SingleNestedAttribute{
-							Description: "The installed application properties of the application.",
-							Computed:    true,
-							Attributes: map[string]schema.Attribute{
-								"command_line_arguments": schema.StringAttribute{
-									Description: "The command-line arguments to use when launching the executable. Environment variables can be used.",
-									Computed:    true,
-								},
-								"command_line_executable": schema.StringAttribute{
-									Description: "The name of the executable file to launch. The full path need not be provided if it's already in the path. Environment variables can also be used.",
-									Computed:    true,
-								},
-								"working_directory": schema.StringAttribute{
-									Description: "The working directory which the executable is launched from. Environment variables can be used.",
-									Computed:    true,
-								},
-							},
-						},
-						"delivery_groups": schema.SetAttribute{
-							ElementType: types.StringType,
-							Description: "The delivery groups which the application is associated with.",
-							Computed:    true,
-						},
-						"application_folder_path": schema.StringAttribute{
-							Description: "The path of the folder which the application belongs to",
-							Computed:    true,
-						},
-					},
-				},
+				Description:  "The applications list associated with the specified folder.",
+				Computed:     true,
+				NestedObject: ApplicationDetailsModel{}.GetSchema(),
 			},
 		},
 	}
 }
 
-func (d *ApplicationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+func (d *ApplicationFolderDetailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
@@ -104,9 +73,14 @@ func (d *ApplicationDataSource) Configure(ctx context.Context, req datasource.Co
 	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
 }
 
-func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *ApplicationFolderDetailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if d.client != nil && d.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -124,20 +98,61 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	// Get the list of applications using the path
 	path := data.Path.ValueString()
 	if path != "" {
-		applicationFolderPath := strings.ReplaceAll(path, "\\", "|")
-		getApplicationsRequest := d.client.ApiClient.AdminFoldersAPIsDAAS.AdminFoldersGetAdminFolderApplications(ctx, applicationFolderPath)
-		apps, httpResp, err := citrixdaasclient.AddRequestData(getApplicationsRequest, d.client).Execute()
+		applicationFolderPath := strings.ReplaceAll(util.NormalizeFolderPath(path), "\\", "|")
+		apps, err := getApplicationFolderApplications(ctx, d.client, &resp.Diagnostics, applicationFolderPath, data.Recursive.ValueBool())
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error getting Applications from folder "+path,
-				"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
-					"\nError message: "+util.ReadClientError(err),
-			)
 			return // Stop processing
 		}
-		data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, apps)
+
+		// The applications of a folder do not include the users to which they are visible, so only read the details of each application when requested
+		appDetails := map[string]*citrixorchestration.ApplicationDetailResponseModel{}
+		if data.IncludeLimitVisibilityToUsers.ValueBool() {
+			for _, app := range apps {
+				appDetail, err := getApplication(ctx, d.client, &resp.Diagnostics, app.GetId())
+				if err != nil {
+					return
+				}
+				appDetails[app.GetId()] = appDetail
+			}
+		}
+		data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, apps, appDetails)
 	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// getApplicationFolderApplications gets the applications of an application folder, walking its subfolders when recursive is true.
+func getApplicationFolderApplications(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, applicationFolderPathOrId string, recursive bool) ([]citrixorchestration.ApplicationResponseModel, error) {
+	getApplicationsRequest := client.ApiClient.AdminFoldersAPIsDAAS.AdminFoldersGetAdminFolderApplications(ctx, applicationFolderPathOrId).Limit(util.ListDataSourcePageSize)
+	apps, err := util.GetAllPages[citrixorchestration.ApplicationResponseModel](diagnostics, "Error getting Applications from folder "+applicationFolderPathOrId, func(continuationToken string) (*citrixorchestration.ApplicationResponseModelCollection, *http.Response, error) {
+		if continuationToken != "" {
+			getApplicationsRequest = getApplicationsRequest.ContinuationToken(continuationToken)
+		}
+		return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.ApplicationResponseModelCollection](getApplicationsRequest, client)
+	})
+	if err != nil || !recursive {
+		return apps, err
+	}
+
+	getApplicationFolderRequest := client.ApiClient.AdminFoldersAPIsDAAS.AdminFoldersGetAdminFolder(ctx, applicationFolderPathOrId)
+	applicationFolder, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.AdminFolderResponseModel](getApplicationFolderRequest, client)
+	if err != nil {
+		diagnostics.AddError(
+			"Error reading Application Folder "+applicationFolderPathOrId,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return nil, err
+	}
+
+	for _, subfolder := range applicationFolder.GetChildren() {
+		subfolderApps, err := getApplicationFolderApplications(ctx, client, diagnostics, subfolder.GetId(), recursive)
+		if err != nil {
+			return nil, err
+		}
+		apps = append(apps, subfolderApps...)
+	}
+
+	return apps, nil
+}
@@ -57,6 +57,11 @@ func (r *applicationFolderResource) Schema(_ context.Context, _ resource.SchemaR
 func (r *applicationFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan ApplicationFolderResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -100,6 +105,11 @@ func (r *applicationFolderResource) Create(ctx context.Context, req resource.Cre
 func (r *applicationFolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state ApplicationFolderResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -128,6 +138,11 @@ func (r *applicationFolderResource) Read(ctx context.Context, req resource.ReadR
 func (r *applicationFolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan ApplicationFolderResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -177,6 +192,11 @@ func (r *applicationFolderResource) Update(ctx context.Context, req resource.Upd
 func (r *applicationFolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state ApplicationFolderResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -229,6 +249,11 @@ func (r *applicationFolderResource) ValidateConfig(ctx context.Context, req reso
 func (r *applicationFolderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
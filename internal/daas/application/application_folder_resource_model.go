@@ -3,6 +3,7 @@
 package application
 
 import (
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"strings"
 
 	citrixorchestration "github.com/citrix/citrix-daas-rest-go/citrixorchestration"
@@ -18,12 +19,14 @@ type ApplicationFolderResourceModel struct {
 	Name       types.String `tfsdk:"name"`
 	Path       types.String `tfsdk:"path"`
 	ParentPath types.String `tfsdk:"parent_path"`
+	Site       types.String `tfsdk:"site"`
 }
 
 func (ApplicationFolderResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages an application folder.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the application folder.",
 				Computed:    true,
@@ -0,0 +1,93 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package application
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &ApplicationGroupDataSource{}
+)
+
+func NewApplicationGroupDataSource() datasource.DataSource {
+	return &ApplicationGroupDataSource{}
+}
+
+// ApplicationGroupDataSource defines the data source implementation.
+type ApplicationGroupDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *ApplicationGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_group"
+}
+
+func (d *ApplicationGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ApplicationGroupDataSourceModel{}.GetSchema()
+}
+
+func (d *ApplicationGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *ApplicationGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data ApplicationGroupDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationGroupNameOrId := data.Id.ValueString()
+	if data.Name.ValueString() != "" {
+		applicationGroupNameOrId = data.Name.ValueString()
+	}
+
+	applicationGroup, err := getApplicationGroup(ctx, d.client, &resp.Diagnostics, applicationGroupNameOrId)
+	if err != nil {
+		return
+	}
+
+	getApplicationsRequest := d.client.ApiClient.ApplicationGroupsAPIsDAAS.ApplicationGroupsGetApplicationGroupApplications(ctx, applicationGroup.GetId()).Limit(util.ListDataSourcePageSize)
+	applications, err := util.GetAllPages[citrixorchestration.ApplicationResponseModel](&resp.Diagnostics, "Error listing Applications of Application Group "+applicationGroup.GetName(), func(continuationToken string) (*citrixorchestration.ApplicationResponseModelCollection, *http.Response, error) {
+		if continuationToken != "" {
+			getApplicationsRequest = getApplicationsRequest.ContinuationToken(continuationToken)
+		}
+		return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.ApplicationResponseModelCollection](getApplicationsRequest, d.client)
+	})
+	if err != nil {
+		return
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, applicationGroup, applications)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,123 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package application
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ApplicationGroupDataSourceModel defines the Application Group data source implementation.
+type ApplicationGroupDataSourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	RestrictToTag  types.String `tfsdk:"restrict_to_tag"`
+	IncludedUsers  types.Set    `tfsdk:"included_users"`  // Set[string]
+	DeliveryGroups types.Set    `tfsdk:"delivery_groups"` // Set[string]
+	Scopes         types.Set    `tfsdk:"scopes"`          // Set[string]
+	Applications   types.Set    `tfsdk:"applications"`    // Set[string]
+	Site           types.String `tfsdk:"site"`
+}
+
+func (ApplicationGroupDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "CVAD --- Data source to get details regarding a specific application group.",
+
+		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the application group.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")), // Ensures that only one of either Id or Name is provided. It will also cause a validation error if none are specified.
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the application group.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the application group.",
+				Computed:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Indicates whether the application group is enabled.",
+				Computed:    true,
+			},
+			"restrict_to_tag": schema.StringAttribute{
+				Description: "The tag the application group is restricted to.",
+				Computed:    true,
+			},
+			"included_users": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "Users who can use the application group. Empty when the application group is available to all users of its delivery groups.",
+				Computed:    true,
+			},
+			"delivery_groups": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "GUID identifiers of the delivery groups associated with the application group.",
+				Computed:    true,
+			},
+			"scopes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "GUID identifiers of the delegated admin scopes of the application group.",
+				Computed:    true,
+			},
+			"applications": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "GUID identifiers of the applications in the application group.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r ApplicationGroupDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, applicationGroup *citrixorchestration.ApplicationGroupDetailResponseModel, applications []citrixorchestration.ApplicationResponseModel) ApplicationGroupDataSourceModel {
+	r.Id = types.StringValue(applicationGroup.GetId())
+	r.Name = types.StringValue(applicationGroup.GetName())
+	r.Description = types.StringValue(applicationGroup.GetDescription())
+	r.Enabled = types.BoolValue(applicationGroup.GetEnabled())
+
+	restrictToTag := applicationGroup.GetRestrictToTag()
+	r.RestrictToTag = types.StringValue(restrictToTag.GetName())
+
+	includedUsers := []string{}
+	if applicationGroup.GetIncludedUsersFilterEnabled() {
+		for _, user := range applicationGroup.GetIncludedUsers() {
+			if user.GetSamName() != "" {
+				includedUsers = append(includedUsers, user.GetSamName())
+			} else {
+				includedUsers = append(includedUsers, user.GetPrincipalName())
+			}
+		}
+	}
+	r.IncludedUsers = util.StringArrayToStringSet(ctx, diagnostics, includedUsers)
+
+	deliveryGroupIds := []string{}
+	for _, deliveryGroup := range applicationGroup.GetDeliveryGroups() {
+		deliveryGroupIds = append(deliveryGroupIds, deliveryGroup.GetId())
+	}
+	r.DeliveryGroups = util.StringArrayToStringSet(ctx, diagnostics, deliveryGroupIds)
+	r.Scopes = util.StringArrayToStringSet(ctx, diagnostics, util.GetIdsForScopeObjects(applicationGroup.GetScopes()))
+
+	applicationIds := []string{}
+	for _, application := range applications {
+		applicationIds = append(applicationIds, application.GetId())
+	}
+	r.Applications = util.StringArrayToStringSet(ctx, diagnostics, applicationIds)
+
+	return r
+}
@@ -58,6 +58,11 @@ func (r *applicationGroupResource) Schema(_ context.Context, _ resource.SchemaRe
 func (r *applicationGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan ApplicationGroupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -134,6 +139,11 @@ func (r *applicationGroupResource) Create(ctx context.Context, req resource.Crea
 func (r *applicationGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state ApplicationGroupResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -167,6 +177,11 @@ func (r *applicationGroupResource) Read(ctx context.Context, req resource.ReadRe
 func (r *applicationGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan ApplicationGroupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -260,6 +275,11 @@ func (r *applicationGroupResource) Update(ctx context.Context, req resource.Upda
 func (r *applicationGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state ApplicationGroupResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -339,6 +359,11 @@ func (r *applicationGroupResource) ValidateConfig(ctx context.Context, req resou
 func (r *applicationGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -31,12 +31,14 @@ type ApplicationGroupResourceModel struct {
 	IncludedUsers  types.Set    `tfsdk:"included_users"`  // Set[string]
 	DeliveryGroups types.Set    `tfsdk:"delivery_groups"` // Set[string]
 	Scopes         types.Set    `tfsdk:"scopes"`          // Set[string]
+	Site           types.String `tfsdk:"site"`
 }
 
 func (ApplicationGroupResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Resource for creating and managing application group.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the application group.",
 				Computed:    true,
@@ -56,6 +56,11 @@ func (r *applicationIconResource) Schema(ctx context.Context, _ resource.SchemaR
 func (r *applicationIconResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan ApplicationIconResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -99,6 +104,11 @@ func (r *applicationIconResource) Create(ctx context.Context, req resource.Creat
 func (r *applicationIconResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state ApplicationIconResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -133,6 +143,11 @@ func (r *applicationIconResource) Update(_ context.Context, _ resource.UpdateReq
 func (r *applicationIconResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state ApplicationIconResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -187,6 +202,11 @@ func (r *applicationIconResource) ValidateConfig(ctx context.Context, req resour
 func (r *applicationIconResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
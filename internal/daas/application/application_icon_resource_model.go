@@ -4,6 +4,7 @@ package application
 
 import (
 	citrixorchestration "github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -14,12 +15,14 @@ import (
 type ApplicationIconResourceModel struct {
 	Id      types.String `tfsdk:"id"`
 	RawData types.String `tfsdk:"raw_data"`
+	Site    types.String `tfsdk:"site"`
 }
 
 func (ApplicationIconResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Resource for managing application icons.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the application icon.",
 				Computed:    true,
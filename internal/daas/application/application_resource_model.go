@@ -75,6 +75,7 @@ type ApplicationResourceModel struct {
 	ApplicationFolderPath  types.String `tfsdk:"application_folder_path"`
 	Icon                   types.String `tfsdk:"icon"`
 	LimitVisibilityToUsers types.Set    `tfsdk:"limit_visibility_to_users"` //Set[string]
+	Site                   types.String `tfsdk:"site"`
 }
 
 // Schema defines the schema for the data source.
@@ -82,6 +83,7 @@ func (ApplicationResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Resource for creating and managing applications.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the application.",
 				Computed:    true,
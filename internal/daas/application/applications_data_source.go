@@ -0,0 +1,117 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package application
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &ApplicationsDataSource{}
+)
+
+func NewApplicationsDataSource() datasource.DataSource {
+	return &ApplicationsDataSource{}
+}
+
+// ApplicationsDataSource defines the data source implementation.
+type ApplicationsDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *ApplicationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_applications"
+}
+
+func (d *ApplicationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ApplicationsDataSourceModel{}.GetSchema()
+}
+
+func (d *ApplicationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data ApplicationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := util.NewListFilter(data.NameRegex.ValueString(), data.ApplicationFolderPath.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		return
+	}
+
+	applications, err := getApplications(ctx, d.client, &resp.Diagnostics, data)
+	if err != nil {
+		return
+	}
+
+	result := []citrixorchestration.ApplicationResponseModel{}
+	for _, application := range applications {
+		if filter.MatchesName(application.GetName()) && filter.MatchesFolder(application.GetApplicationFolder()) {
+			result = append(result, application)
+		}
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, result)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getApplications retrieves all pages of applications with the tag of the data source, or in the application folder of the data source when no tag is specified
+func getApplications(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, data ApplicationsDataSourceModel) ([]citrixorchestration.ApplicationResponseModel, error) {
+	if tag := data.Tag.ValueString(); tag != "" {
+		request := client.ApiClient.TagsAPIsDAAS.TagsGetTagApplications(ctx, tag).Limit(util.ListDataSourcePageSize)
+		return util.GetAllPages[citrixorchestration.ApplicationResponseModel](diagnostics, "Error listing Applications with tag "+tag, func(continuationToken string) (*citrixorchestration.ApplicationResponseModelCollection, *http.Response, error) {
+			if continuationToken != "" {
+				request = request.ContinuationToken(continuationToken)
+			}
+			return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.ApplicationResponseModelCollection](request, client)
+		})
+	}
+
+	request := client.ApiClient.ApplicationsAPIsDAAS.ApplicationsGetApplications(ctx).Limit(util.ListDataSourcePageSize)
+	if applicationFolderPath := util.NormalizeFolderPath(data.ApplicationFolderPath.ValueString()); applicationFolderPath != "" {
+		request = request.ApplicationFolder(applicationFolderPath)
+	}
+	return util.GetAllPages[citrixorchestration.ApplicationResponseModel](diagnostics, "Error listing Applications", func(continuationToken string) (*citrixorchestration.ApplicationResponseModelCollection, *http.Response, error) {
+		if continuationToken != "" {
+			request = request.ContinuationToken(continuationToken)
+		}
+		return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.ApplicationResponseModelCollection](request, client)
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package application
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ApplicationsDataSourceModel defines the Applications data source implementation.
+type ApplicationsDataSourceModel struct {
+	NameRegex             types.String              `tfsdk:"name_regex"`
+	ApplicationFolderPath types.String              `tfsdk:"application_folder_path"`
+	Tag                   types.String              `tfsdk:"tag"`
+	Applications          []ApplicationSummaryModel `tfsdk:"applications"`
+	Site                  types.String              `tfsdk:"site"`
+}
+
+func (ApplicationsDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source for the list of applications, optionally filtered by name, application folder and tag.",
+
+		Attributes: map[string]schema.Attribute{
+			"site":                    util.GetSiteDataSourceSchema(),
+			"name_regex":              util.GetNameRegexFilterDataSourceSchema("applications"),
+			"application_folder_path": util.GetFolderPathFilterDataSourceSchema("applications", "application folder"),
+			"tag":                     util.GetTagFilterDataSourceSchema("applications"),
+			"applications": schema.ListNestedAttribute{
+				Description:  "The applications matching the filters.",
+				Computed:     true,
+				NestedObject: ApplicationSummaryModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+// ApplicationSummaryModel defines the summary of an application returned by the Applications data source.
+type ApplicationSummaryModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	PublishedName         types.String `tfsdk:"published_name"`
+	Description           types.String `tfsdk:"description"`
+	ApplicationFolderPath types.String `tfsdk:"application_folder_path"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	Tags                  types.List   `tfsdk:"tags"` // List[string]
+}
+
+func (ApplicationSummaryModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the application.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the application.",
+				Computed:    true,
+			},
+			"published_name": schema.StringAttribute{
+				Description: "Name of the application as shown to users.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the application.",
+				Computed:    true,
+			},
+			"application_folder_path": schema.StringAttribute{
+				Description: "Path of the application folder of the application. Empty when the application is in the root folder.",
+				Computed:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Indicates whether the application is enabled.",
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Tags associated with the application.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r ApplicationsDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, applications []citrixorchestration.ApplicationResponseModel) ApplicationsDataSourceModel {
+	res := []ApplicationSummaryModel{}
+	for _, application := range applications {
+		applicationFolder := application.GetApplicationFolder()
+		res = append(res, ApplicationSummaryModel{
+			Id:                    types.StringValue(application.GetId()),
+			Name:                  types.StringValue(application.GetName()),
+			PublishedName:         types.StringValue(application.GetPublishedName()),
+			Description:           types.StringValue(application.GetDescription()),
+			ApplicationFolderPath: types.StringValue(util.NormalizeFolderPath(applicationFolder.GetName())),
+			Enabled:               types.BoolValue(application.GetEnabled()),
+			Tags:                  util.StringArrayToStringList(ctx, diagnostics, application.GetTags()),
+		})
+	}
+
+	r.Applications = res
+
+	return r
+}
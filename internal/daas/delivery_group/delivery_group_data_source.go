@@ -45,6 +45,11 @@ func (d *DeliveryGroupDataSource) Configure(ctx context.Context, req datasource.
 func (d *DeliveryGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if d.client != nil && d.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -83,7 +88,7 @@ func (d *DeliveryGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 		)
 	}
 
-	data = data.RefreshPropertyValues(deliveryGroup, deliveryGroupVdas)
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, deliveryGroup, deliveryGroupVdas)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -3,9 +3,13 @@
 package delivery_group
 
 import (
+	"context"
+
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/vda"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -14,12 +18,14 @@ type DeliveryGroupDataSourceModel struct {
 	Id   types.String   `tfsdk:"id"`
 	Name types.String   `tfsdk:"name"`
 	Vdas []vda.VdaModel `tfsdk:"vdas"` // List[VdaModel]
+	Site types.String   `tfsdk:"site"`
 }
 
 func (DeliveryGroupDataSourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Read data of an existing delivery group.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the delivery group.",
 				Computed:    true,
@@ -37,26 +43,13 @@ func (DeliveryGroupDataSourceModel) GetSchema() schema.Schema {
 	}
 }
 
-func (r DeliveryGroupDataSourceModel) RefreshPropertyValues(deliveryGroup *citrixorchestration.DeliveryGroupDetailResponseModel, vdas *citrixorchestration.MachineResponseModelCollection) DeliveryGroupDataSourceModel {
+func (r DeliveryGroupDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, deliveryGroup *citrixorchestration.DeliveryGroupDetailResponseModel, vdas *citrixorchestration.MachineResponseModelCollection) DeliveryGroupDataSourceModel {
 	r.Id = types.StringValue(deliveryGroup.GetId())
 	r.Name = types.StringValue(deliveryGroup.GetName())
 
 	res := []vda.VdaModel{}
 	for _, model := range vdas.GetItems() {
-		machineName := model.GetName()
-		hosting := model.GetHosting()
-		hostedMachineId := hosting.GetHostedMachineId()
-		machineCatalog := model.GetMachineCatalog()
-		machineCatalogId := machineCatalog.GetId()
-		deliveryGroup := model.GetDeliveryGroup()
-		deliveryGroupId := deliveryGroup.GetId()
-
-		res = append(res, vda.VdaModel{
-			MachineName:              types.StringValue(machineName),
-			HostedMachineId:          types.StringValue(hostedMachineId),
-			AssociatedMachineCatalog: types.StringValue(machineCatalogId),
-			AssociatedDeliveryGroup:  types.StringValue(deliveryGroupId),
-		})
+		res = append(res, vda.NewVdaModel(ctx, diagnostics, model))
 	}
 
 	r.Vdas = res
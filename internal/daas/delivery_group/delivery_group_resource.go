@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	citrixorchestration "github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
@@ -58,6 +59,11 @@ func (r *deliveryGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 func (r *deliveryGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan DeliveryGroupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -65,6 +71,11 @@ func (r *deliveryGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	// Delivery group operations are synchronous, bound the whole operation with the configured timeout
+	timeouts := util.ObjectValueToTypedObject[DeliveryGroupTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(util.GetTimeoutValue(timeouts.Create, util.DefaultDeliveryGroupCreateTimeout))*time.Minute)
+	defer cancel()
+
 	// Get machine catalogs and verify all of them have the same session support
 	associatedMachineCatalogs := util.ObjectListToTypedArray[DeliveryGroupMachineCatalogModel](ctx, &resp.Diagnostics, plan.AssociatedMachineCatalogs)
 	associatedMachineCatalogProperties, err := validateAndReturnMachineCatalogSessionSupport(ctx, *r.client, &resp.Diagnostics, associatedMachineCatalogs, true)
@@ -195,6 +206,11 @@ func (r *deliveryGroupResource) Create(ctx context.Context, req resource.CreateR
 func (r *deliveryGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state DeliveryGroupResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -256,6 +272,11 @@ func (r *deliveryGroupResource) Read(ctx context.Context, req resource.ReadReque
 func (r *deliveryGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan DeliveryGroupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -263,6 +284,10 @@ func (r *deliveryGroupResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	timeouts := util.ObjectValueToTypedObject[DeliveryGroupTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(util.GetTimeoutValue(timeouts.Update, util.DefaultDeliveryGroupUpdateTimeout))*time.Minute)
+	defer cancel()
+
 	// Get refreshed delivery group properties from Orchestration
 	deliveryGroupId := plan.Id.ValueString()
 	deliveryGroupName := plan.Name.ValueString()
@@ -354,6 +379,11 @@ func (r *deliveryGroupResource) Update(ctx context.Context, req resource.UpdateR
 func (r *deliveryGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state DeliveryGroupResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -362,6 +392,10 @@ func (r *deliveryGroupResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	timeouts := util.ObjectValueToTypedObject[DeliveryGroupTimeouts](ctx, &resp.Diagnostics, state.Timeouts)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(util.GetTimeoutValue(timeouts.Delete, util.DefaultDeliveryGroupDeleteTimeout))*time.Minute)
+	defer cancel()
+
 	// Delete existing delivery group
 	deliveryGroupId := state.Id.ValueString()
 	deliveryGroupName := state.Name.ValueString()
@@ -436,6 +470,11 @@ func (r *deliveryGroupResource) ValidateConfig(ctx context.Context, req resource
 func (r *deliveryGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -673,12 +673,41 @@ type DeliveryGroupResourceModel struct {
 	Scopes                      types.Set    `tfsdk:"scopes"`             //Set[String]
 	MakeResourcesAvailableInLHC types.Bool   `tfsdk:"make_resources_available_in_lhc"`
 	AppProtection               types.Object `tfsdk:"app_protection"` //DeliveryGroupAppProtection
+	Timeouts                    types.Object `tfsdk:"timeouts"`       //DeliveryGroupTimeouts
+	Site                        types.String `tfsdk:"site"`
+}
+
+type DeliveryGroupTimeouts struct {
+	Create types.Int64 `tfsdk:"create"`
+	Update types.Int64 `tfsdk:"update"`
+	Delete types.Int64 `tfsdk:"delete"`
+}
+
+func (DeliveryGroupTimeouts) GetSchema() schema.SingleNestedAttribute {
+	return util.GetTimeoutsSchema("delivery group", util.TimeoutConfigs{
+		Create:        true,
+		CreateDefault: util.DefaultDeliveryGroupCreateTimeout,
+		CreateMin:     1,
+
+		Update:        true,
+		UpdateDefault: util.DefaultDeliveryGroupUpdateTimeout,
+		UpdateMin:     1,
+
+		Delete:        true,
+		DeleteDefault: util.DefaultDeliveryGroupDeleteTimeout,
+		DeleteMin:     1,
+	})
+}
+
+func (DeliveryGroupTimeouts) GetAttributes() map[string]schema.Attribute {
+	return DeliveryGroupTimeouts{}.GetSchema().Attributes
 }
 
 func (DeliveryGroupResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages a delivery group.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the delivery group.",
 				Computed:    true,
@@ -816,6 +845,7 @@ func (DeliveryGroupResourceModel) GetSchema() schema.Schema {
 				Optional: true,
 			},
 			"app_protection": DeliveryGroupAppProtection{}.GetSchema(),
+			"timeouts":       DeliveryGroupTimeouts{}.GetSchema(),
 		},
 	}
 }
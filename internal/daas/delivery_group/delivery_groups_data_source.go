@@ -0,0 +1,117 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package delivery_group
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &DeliveryGroupsDataSource{}
+)
+
+func NewDeliveryGroupsDataSource() datasource.DataSource {
+	return &DeliveryGroupsDataSource{}
+}
+
+// DeliveryGroupsDataSource defines the data source implementation.
+type DeliveryGroupsDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *DeliveryGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delivery_groups"
+}
+
+func (d *DeliveryGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DeliveryGroupsDataSourceModel{}.GetSchema()
+}
+
+func (d *DeliveryGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *DeliveryGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data DeliveryGroupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := util.NewListFilter(data.NameRegex.ValueString(), data.AdminFolderPath.ValueString(), data.Scope.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		return
+	}
+
+	deliveryGroups, err := getDeliveryGroups(ctx, d.client, &resp.Diagnostics, data)
+	if err != nil {
+		return
+	}
+
+	result := []citrixorchestration.DeliveryGroupResponseModel{}
+	for _, deliveryGroup := range deliveryGroups {
+		if filter.MatchesName(deliveryGroup.GetName()) && filter.MatchesFolder(deliveryGroup.GetAdminFolder()) && filter.MatchesScopes(deliveryGroup.GetScopes()) {
+			result = append(result, deliveryGroup)
+		}
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, result)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getDeliveryGroups retrieves all pages of delivery groups with the tag of the data source, or in the admin folder of the data source when no tag is specified
+func getDeliveryGroups(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, data DeliveryGroupsDataSourceModel) ([]citrixorchestration.DeliveryGroupResponseModel, error) {
+	if tag := data.Tag.ValueString(); tag != "" {
+		request := client.ApiClient.TagsAPIsDAAS.TagsGetTagDeliveryGroups(ctx, tag).Limit(util.ListDataSourcePageSize)
+		return util.GetAllPages[citrixorchestration.DeliveryGroupResponseModel](diagnostics, "Error listing Delivery Groups with tag "+tag, func(continuationToken string) (*citrixorchestration.DeliveryGroupResponseModelCollection, *http.Response, error) {
+			if continuationToken != "" {
+				request = request.ContinuationToken(continuationToken)
+			}
+			return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.DeliveryGroupResponseModelCollection](request, client)
+		})
+	}
+
+	request := client.ApiClient.DeliveryGroupsAPIsDAAS.DeliveryGroupsGetDeliveryGroups(ctx).Limit(util.ListDataSourcePageSize)
+	if adminFolderPath := util.NormalizeFolderPath(data.AdminFolderPath.ValueString()); adminFolderPath != "" {
+		request = request.AdminFolder(adminFolderPath)
+	}
+	return util.GetAllPages[citrixorchestration.DeliveryGroupResponseModel](diagnostics, "Error listing Delivery Groups", func(continuationToken string) (*citrixorchestration.DeliveryGroupResponseModelCollection, *http.Response, error) {
+		if continuationToken != "" {
+			request = request.ContinuationToken(continuationToken)
+		}
+		return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.DeliveryGroupResponseModelCollection](request, client)
+	})
+}
@@ -0,0 +1,123 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package delivery_group
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DeliveryGroupsDataSourceModel defines the Delivery Groups data source implementation.
+type DeliveryGroupsDataSourceModel struct {
+	NameRegex       types.String                `tfsdk:"name_regex"`
+	AdminFolderPath types.String                `tfsdk:"admin_folder_path"`
+	Scope           types.String                `tfsdk:"scope"`
+	Tag             types.String                `tfsdk:"tag"`
+	DeliveryGroups  []DeliveryGroupSummaryModel `tfsdk:"delivery_groups"`
+	Site            types.String                `tfsdk:"site"`
+}
+
+func (DeliveryGroupsDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source for the list of delivery groups, optionally filtered by name, admin folder, delegated admin scope and tag.",
+
+		Attributes: map[string]schema.Attribute{
+			"site":              util.GetSiteDataSourceSchema(),
+			"name_regex":        util.GetNameRegexFilterDataSourceSchema("delivery groups"),
+			"admin_folder_path": util.GetFolderPathFilterDataSourceSchema("delivery groups", "admin folder"),
+			"scope":             util.GetScopeFilterDataSourceSchema("delivery groups"),
+			"tag":               util.GetTagFilterDataSourceSchema("delivery groups"),
+			"delivery_groups": schema.ListNestedAttribute{
+				Description:  "The delivery groups matching the filters.",
+				Computed:     true,
+				NestedObject: DeliveryGroupSummaryModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+// DeliveryGroupSummaryModel defines the summary of a delivery group returned by the Delivery Groups data source.
+type DeliveryGroupSummaryModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	AdminFolderPath types.String `tfsdk:"admin_folder_path"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	SessionSupport  types.String `tfsdk:"session_support"`
+	DeliveryType    types.String `tfsdk:"delivery_type"`
+	Scopes          types.List   `tfsdk:"scopes"` // List[string]
+	Tags            types.List   `tfsdk:"tags"`   // List[string]
+}
+
+func (DeliveryGroupSummaryModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the delivery group.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the delivery group.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the delivery group.",
+				Computed:    true,
+			},
+			"admin_folder_path": schema.StringAttribute{
+				Description: "Path of the admin folder of the delivery group. Empty when the delivery group is in the root folder.",
+				Computed:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Indicates whether the delivery group is enabled.",
+				Computed:    true,
+			},
+			"session_support": schema.StringAttribute{
+				Description: "Session support of the delivery group.",
+				Computed:    true,
+			},
+			"delivery_type": schema.StringAttribute{
+				Description: "Type of resources delivered by the delivery group, i.e. desktops, applications or both.",
+				Computed:    true,
+			},
+			"scopes": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "GUID identifiers of the delegated admin scopes of the delivery group.",
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Tags associated with the delivery group.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r DeliveryGroupsDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, deliveryGroups []citrixorchestration.DeliveryGroupResponseModel) DeliveryGroupsDataSourceModel {
+	res := []DeliveryGroupSummaryModel{}
+	for _, deliveryGroup := range deliveryGroups {
+		adminFolder := deliveryGroup.GetAdminFolder()
+		res = append(res, DeliveryGroupSummaryModel{
+			Id:              types.StringValue(deliveryGroup.GetId()),
+			Name:            types.StringValue(deliveryGroup.GetName()),
+			Description:     types.StringValue(deliveryGroup.GetDescription()),
+			AdminFolderPath: types.StringValue(util.NormalizeFolderPath(adminFolder.GetName())),
+			Enabled:         types.BoolValue(deliveryGroup.GetEnabled()),
+			SessionSupport:  types.StringValue(string(deliveryGroup.GetSessionSupport())),
+			DeliveryType:    types.StringValue(string(deliveryGroup.GetDeliveryType())),
+			Scopes:          util.StringArrayToStringList(ctx, diagnostics, util.GetIdsForScopeObjects(deliveryGroup.GetScopes())),
+			Tags:            util.StringArrayToStringList(ctx, diagnostics, deliveryGroup.GetTags()),
+		})
+	}
+
+	r.DeliveryGroups = res
+
+	return r
+}
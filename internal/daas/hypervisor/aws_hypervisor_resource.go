@@ -57,6 +57,11 @@ func (r *awsHypervisorResource) Configure(_ context.Context, req resource.Config
 func (r *awsHypervisorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AwsHypervisorResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -89,7 +94,8 @@ func (r *awsHypervisorResource) Create(ctx context.Context, req resource.CreateR
 	body.SetConnectionDetails(connectionDetails)
 
 	// Create new hypervisor
-	hypervisor, err := CreateHypervisor(ctx, r.client, &resp.Diagnostics, body)
+	timeouts := util.ObjectValueToTypedObject[HypervisorTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	hypervisor, err := CreateHypervisor(ctx, r.client, &resp.Diagnostics, body, util.GetTimeoutValue(timeouts.Create, util.DefaultHypervisorCreateTimeout))
 	if err != nil {
 		// Directly return. Error logs have been populated in common function
 		return
@@ -110,6 +116,11 @@ func (r *awsHypervisorResource) Create(ctx context.Context, req resource.CreateR
 func (r *awsHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state AwsHypervisorResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -148,6 +159,11 @@ func (r *awsHypervisorResource) Read(ctx context.Context, req resource.ReadReque
 func (r *awsHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AwsHypervisorResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -175,7 +191,8 @@ func (r *awsHypervisorResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	// Patch hypervisor
-	updatedHypervisor, err := UpdateHypervisor(ctx, r.client, &resp.Diagnostics, editHypervisorRequestBody, state.Id.ValueString(), state.Name.ValueString())
+	timeouts := util.ObjectValueToTypedObject[HypervisorTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	updatedHypervisor, err := UpdateHypervisor(ctx, r.client, &resp.Diagnostics, editHypervisorRequestBody, state.Id.ValueString(), state.Name.ValueString(), util.GetTimeoutValue(timeouts.Update, util.DefaultHypervisorUpdateTimeout))
 	if err != nil {
 		return
 	}
@@ -194,6 +211,11 @@ func (r *awsHypervisorResource) Update(ctx context.Context, req resource.UpdateR
 func (r *awsHypervisorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state AwsHypervisorResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -239,6 +261,11 @@ func (r *awsHypervisorResource) ValidateConfig(ctx context.Context, req resource
 func (r *awsHypervisorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
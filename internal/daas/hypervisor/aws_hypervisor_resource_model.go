@@ -32,12 +32,15 @@ type AwsHypervisorResourceModel struct {
 	Region    types.String `tfsdk:"region"`
 	ApiKey    types.String `tfsdk:"api_key"`
 	SecretKey types.String `tfsdk:"secret_key"`
+	Timeouts  types.Object `tfsdk:"timeouts"` // HypervisorTimeouts
+	Site      types.String `tfsdk:"site"`
 }
 
 func (AwsHypervisorResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages an AWS EC2 hypervisor.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the hypervisor.",
 				Computed:    true,
@@ -90,6 +93,7 @@ func (AwsHypervisorResourceModel) GetSchema() schema.Schema {
 					),
 				},
 			},
+			"timeouts": HypervisorTimeouts{}.GetSchema(),
 		},
 	}
 }
@@ -63,6 +63,11 @@ func (r *azureHypervisorResource) Configure(_ context.Context, req resource.Conf
 func (r *azureHypervisorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AzureHypervisorResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -106,7 +111,8 @@ func (r *azureHypervisorResource) Create(ctx context.Context, req resource.Creat
 	var body citrixorchestration.CreateHypervisorRequestModel
 	body.SetConnectionDetails(connectionDetails)
 
-	hypervisor, err := CreateHypervisor(ctx, r.client, &resp.Diagnostics, body)
+	timeouts := util.ObjectValueToTypedObject[HypervisorTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	hypervisor, err := CreateHypervisor(ctx, r.client, &resp.Diagnostics, body, util.GetTimeoutValue(timeouts.Create, util.DefaultHypervisorCreateTimeout))
 	if err != nil {
 		// Directly return. Error logs have been populated in common function.
 		return
@@ -127,6 +133,11 @@ func (r *azureHypervisorResource) Create(ctx context.Context, req resource.Creat
 func (r *azureHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state AzureHypervisorResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -165,6 +176,11 @@ func (r *azureHypervisorResource) Read(ctx context.Context, req resource.ReadReq
 func (r *azureHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AzureHypervisorResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -225,7 +241,8 @@ func (r *azureHypervisorResource) Update(ctx context.Context, req resource.Updat
 	editHypervisorRequestBody.SetCustomProperties(string(customPropertiesByte))
 
 	// Fetch updated hypervisor from GetHypervisor
-	updatedHypervisor, err := UpdateHypervisor(ctx, r.client, &resp.Diagnostics, editHypervisorRequestBody, state.Id.ValueString(), state.Name.ValueString())
+	timeouts := util.ObjectValueToTypedObject[HypervisorTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	updatedHypervisor, err := UpdateHypervisor(ctx, r.client, &resp.Diagnostics, editHypervisorRequestBody, state.Id.ValueString(), state.Name.ValueString(), util.GetTimeoutValue(timeouts.Update, util.DefaultHypervisorUpdateTimeout))
 	if err != nil {
 		return
 	}
@@ -244,6 +261,11 @@ func (r *azureHypervisorResource) Update(ctx context.Context, req resource.Updat
 func (r *azureHypervisorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state AzureHypervisorResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -308,6 +330,11 @@ func (r *azureHypervisorResource) ValidateConfig(ctx context.Context, req resour
 func (r *azureHypervisorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -38,12 +38,15 @@ type AzureHypervisorResourceModel struct {
 	SubscriptionId                  types.String `tfsdk:"subscription_id"`
 	ActiveDirectoryId               types.String `tfsdk:"active_directory_id"`
 	EnableAzureADDeviceManagement   types.Bool   `tfsdk:"enable_azure_ad_device_management"`
+	Timeouts                        types.Object `tfsdk:"timeouts"` // HypervisorTimeouts
+	Site                            types.String `tfsdk:"site"`
 }
 
 func (AzureHypervisorResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages an Azure hypervisor.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the hypervisor.",
 				Computed:    true,
@@ -117,6 +120,7 @@ func (AzureHypervisorResourceModel) GetSchema() schema.Schema {
 					),
 				},
 			},
+			"timeouts": HypervisorTimeouts{}.GetSchema(),
 		},
 	}
 }
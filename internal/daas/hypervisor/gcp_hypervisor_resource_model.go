@@ -31,12 +31,15 @@ type GcpHypervisorResourceModel struct {
 	/** GCP Connection **/
 	ServiceAccountId          types.String `tfsdk:"service_account_id"`
 	ServiceAccountCredentials types.String `tfsdk:"service_account_credentials"`
+	Timeouts                  types.Object `tfsdk:"timeouts"` // HypervisorTimeouts
+	Site                      types.String `tfsdk:"site"`
 }
 
 func (GcpHypervisorResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages a GCP hypervisor.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the hypervisor.",
 				Computed:    true,
@@ -82,6 +85,7 @@ func (GcpHypervisorResourceModel) GetSchema() schema.Schema {
 					),
 				},
 			},
+			"timeouts": HypervisorTimeouts{}.GetSchema(),
 		},
 	}
 }
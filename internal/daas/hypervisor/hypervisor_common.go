@@ -13,6 +13,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type HYPERVISOR_FAULT_STATE string
@@ -23,8 +25,29 @@ const (
 
 const base_delay_in_seconds = time.Duration(10) * time.Second
 
+type HypervisorTimeouts struct {
+	Create types.Int64 `tfsdk:"create"`
+	Update types.Int64 `tfsdk:"update"`
+}
+
+func (HypervisorTimeouts) GetSchema() schema.SingleNestedAttribute {
+	return util.GetTimeoutsSchema("hypervisor", util.TimeoutConfigs{
+		Create:        true,
+		CreateDefault: util.DefaultHypervisorCreateTimeout,
+		CreateMin:     1,
+
+		Update:        true,
+		UpdateDefault: util.DefaultHypervisorUpdateTimeout,
+		UpdateMin:     1,
+	})
+}
+
+func (HypervisorTimeouts) GetAttributes() map[string]schema.Attribute {
+	return HypervisorTimeouts{}.GetSchema().Attributes
+}
+
 // Create creates the resource and sets the initial Terraform state.
-func CreateHypervisor(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, createHypervisorRequestBody citrixorchestration.CreateHypervisorRequestModel) (*citrixorchestration.HypervisorDetailResponseModel, error) {
+func CreateHypervisor(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, createHypervisorRequestBody citrixorchestration.CreateHypervisorRequestModel, maxTimeout int) (*citrixorchestration.HypervisorDetailResponseModel, error) {
 	// Create new hypervisor
 	createHypervisorRequest := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsCreateHypervisor(ctx)
 	createHypervisorRequest = createHypervisorRequest.CreateHypervisorRequestModel(createHypervisorRequestBody).Async(true)
@@ -39,7 +62,7 @@ func CreateHypervisor(ctx context.Context, client *citrixdaasclient.CitrixDaasCl
 		return nil, err
 	}
 
-	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error creating Hypervisor", diagnostics, 10, true)
+	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error creating Hypervisor", diagnostics, maxTimeout, true)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +96,7 @@ func CreateHypervisor(ctx context.Context, client *citrixdaasclient.CitrixDaasCl
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
-func UpdateHypervisor(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, editHypervisorRequestBody citrixorchestration.EditHypervisorConnectionRequestModel, hypervisorId, hypervisorName string) (*citrixorchestration.HypervisorDetailResponseModel, error) {
+func UpdateHypervisor(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, editHypervisorRequestBody citrixorchestration.EditHypervisorConnectionRequestModel, hypervisorId, hypervisorName string, maxTimeout int) (*citrixorchestration.HypervisorDetailResponseModel, error) {
 	// Patch hypervisor
 	patchHypervisorRequest := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsPatchHypervisor(ctx, hypervisorId)
 	patchHypervisorRequest = patchHypervisorRequest.EditHypervisorConnectionRequestModel(editHypervisorRequestBody).Async(true)
@@ -86,7 +109,7 @@ func UpdateHypervisor(ctx context.Context, client *citrixdaasclient.CitrixDaasCl
 		)
 	}
 
-	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating Hypervisor "+hypervisorName, diagnostics, 10, true)
+	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating Hypervisor "+hypervisorName, diagnostics, maxTimeout, true)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,101 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package hypervisor
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &HypervisorResourcesDataSource{}
+)
+
+func NewHypervisorResourcesDataSource() datasource.DataSource {
+	return &HypervisorResourcesDataSource{}
+}
+
+// HypervisorResourcesDataSource defines the data source implementation.
+type HypervisorResourcesDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *HypervisorResourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hypervisor_resources"
+}
+
+func (d *HypervisorResourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = HypervisorResourcesDataSourceModel{}.GetSchema()
+}
+
+func (d *HypervisorResourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *HypervisorResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data HypervisorResourcesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := util.NewListFilter(data.NameRegex.ValueString(), "", "")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		return
+	}
+
+	hypervisor, err := util.GetHypervisor(ctx, d.client, &resp.Diagnostics, data.HypervisorName.ValueString())
+	if err != nil {
+		return
+	}
+
+	resources, httpResp, err := util.GetHypervisorResources(ctx, d.client, hypervisor, data.HypervisorResourcePoolName.ValueString(), data.FolderPath.ValueString(), data.ResourceType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing resources of Hypervisor "+hypervisor.GetName(),
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	result := []citrixorchestration.HypervisorResourceResponseModel{}
+	for _, resource := range resources {
+		if filter.MatchesName(resource.GetName()) {
+			result = append(result, resource)
+		}
+	}
+
+	data = data.RefreshPropertyValues(result)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,135 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package hypervisor
+
+import (
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HypervisorResourcesDataSourceModel defines the Hypervisor Resources data source implementation.
+type HypervisorResourcesDataSourceModel struct {
+	HypervisorName             types.String              `tfsdk:"hypervisor_name"`
+	HypervisorResourcePoolName types.String              `tfsdk:"hypervisor_resource_pool_name"`
+	FolderPath                 types.String              `tfsdk:"folder_path"`
+	ResourceType               types.String              `tfsdk:"resource_type"`
+	NameRegex                  types.String              `tfsdk:"name_regex"`
+	Resources                  []HypervisorResourceModel `tfsdk:"resources"`
+	Site                       types.String              `tfsdk:"site"`
+}
+
+var hypervisorResourceTypes = []string{
+	util.VirtualMachineResourceType,
+	util.SnapshotResourceType,
+	util.NetworkResourceType,
+	util.ServiceOfferingResourceType,
+	util.StorageResourceType,
+	util.TemplateSpecResourceType,
+	util.DiskEncryptionSetResourceType,
+	util.TemplateResourceType,
+	util.ImageVersionResourceType,
+	util.VhdResourceType,
+	util.RegionResourceType,
+	util.VirtualPrivateCloudResourceType,
+	util.SecurityGroupResourceType,
+	util.HostResourceType,
+}
+
+func (HypervisorResourcesDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source for the list of resources in a folder of the inventory of a hypervisor, such as virtual machines, snapshots, networks, service offerings, storage, template specs and disk encryption sets.",
+
+		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
+			"hypervisor_name": schema.StringAttribute{
+				Description: "Name or GUID identifier of the hypervisor whose inventory is listed.",
+				Required:    true,
+			},
+			"hypervisor_resource_pool_name": schema.StringAttribute{
+				Description: "Name or GUID identifier of the hypervisor resource pool whose inventory is listed. When omitted, the inventory of the whole hypervisor is listed.",
+				Optional:    true,
+			},
+			"folder_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the folder to list, such as `image.folder\\\\MyResourceGroup.resourcegroup` for the images of an Azure resource group, `machineprofile.folder\\\\MyResourceGroup.resourcegroup\\\\MyTemplateSpec.templatespec` for the versions of an Azure template spec, or `diskencryptionset.folder` for Azure disk encryption sets. When omitted, the root of the inventory is listed.",
+				Optional:            true,
+			},
+			"resource_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the resources to list. When omitted, resources of all types are listed. Choose between `Vm`, `Snapshot`, `Network`, `ServiceOffering`, `Storage`, `TemplateSpec`, `DiskEncryptionSet`, `Template`, `ImageVersion`, `Vhd`, `Region`, `VirtualPrivateCloud`, `SecurityGroup` and `Host`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(hypervisorResourceTypes...),
+				},
+			},
+			"name_regex": util.GetNameRegexFilterDataSourceSchema("resources"),
+			"resources": schema.ListNestedAttribute{
+				Description:  "The resources matching the filters.",
+				Computed:     true,
+				NestedObject: HypervisorResourceModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+// HypervisorResourceModel defines a resource in the inventory of a hypervisor returned by the Hypervisor Resources data source.
+type HypervisorResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Path         types.String `tfsdk:"path"`
+	RelativePath types.String `tfsdk:"relative_path"`
+	IsContainer  types.Bool   `tfsdk:"is_container"`
+}
+
+func (HypervisorResourceModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the resource on the hypervisor.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the resource.",
+				Computed:    true,
+			},
+			"resource_type": schema.StringAttribute{
+				Description: "Type of the resource.",
+				Computed:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Full path of the resource in the inventory of the hypervisor.",
+				Computed:    true,
+			},
+			"relative_path": schema.StringAttribute{
+				Description: "Path of the resource relative to the hypervisor.",
+				Computed:    true,
+			},
+			"is_container": schema.BoolAttribute{
+				Description: "Indicates whether the resource is a folder containing other resources.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r HypervisorResourcesDataSourceModel) RefreshPropertyValues(resources []citrixorchestration.HypervisorResourceResponseModel) HypervisorResourcesDataSourceModel {
+	res := []HypervisorResourceModel{}
+	for _, resource := range resources {
+		res = append(res, HypervisorResourceModel{
+			Id:           types.StringValue(resource.GetId()),
+			Name:         types.StringValue(resource.GetName()),
+			ResourceType: types.StringValue(resource.GetResourceType()),
+			Path:         types.StringValue(resource.GetXDPath()),
+			RelativePath: types.StringValue(resource.GetRelativePath()),
+			IsContainer:  types.BoolValue(resource.GetIsContainer()),
+		})
+	}
+
+	r.Resources = res
+
+	return r
+}
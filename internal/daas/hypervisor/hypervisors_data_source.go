@@ -0,0 +1,97 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package hypervisor
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &HypervisorsDataSource{}
+)
+
+func NewHypervisorsDataSource() datasource.DataSource {
+	return &HypervisorsDataSource{}
+}
+
+// HypervisorsDataSource defines the data source implementation.
+type HypervisorsDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *HypervisorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hypervisors"
+}
+
+func (d *HypervisorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = HypervisorsDataSourceModel{}.GetSchema()
+}
+
+func (d *HypervisorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *HypervisorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data HypervisorsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := util.NewListFilter(data.NameRegex.ValueString(), "", data.Scope.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		return
+	}
+
+	getHypervisorsRequest := d.client.ApiClient.HypervisorsAPIsDAAS.HypervisorsGetHypervisors(ctx)
+	hypervisors, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.HypervisorResponseModelCollection](getHypervisorsRequest, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Hypervisors",
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	result := []citrixorchestration.HypervisorResponseModel{}
+	for _, hypervisor := range hypervisors.GetItems() {
+		if filter.MatchesName(hypervisor.GetName()) && filter.MatchesScopes(hypervisor.GetScopes()) {
+			result = append(result, hypervisor)
+		}
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, result)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,94 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package hypervisor
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HypervisorsDataSourceModel defines the Hypervisors data source implementation.
+type HypervisorsDataSourceModel struct {
+	NameRegex   types.String             `tfsdk:"name_regex"`
+	Scope       types.String             `tfsdk:"scope"`
+	Hypervisors []HypervisorSummaryModel `tfsdk:"hypervisors"`
+	Site        types.String             `tfsdk:"site"`
+}
+
+func (HypervisorsDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source for the list of hypervisors, optionally filtered by name and delegated admin scope.",
+
+		Attributes: map[string]schema.Attribute{
+			"site":       util.GetSiteDataSourceSchema(),
+			"name_regex": util.GetNameRegexFilterDataSourceSchema("hypervisors"),
+			"scope":      util.GetScopeFilterDataSourceSchema("hypervisors"),
+			"hypervisors": schema.ListNestedAttribute{
+				Description:  "The hypervisors matching the filters.",
+				Computed:     true,
+				NestedObject: HypervisorSummaryModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+// HypervisorSummaryModel defines the summary of a hypervisor returned by the Hypervisors data source.
+type HypervisorSummaryModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	ConnectionType types.String `tfsdk:"connection_type"`
+	Zone           types.String `tfsdk:"zone"`
+	Scopes         types.List   `tfsdk:"scopes"` // List[string]
+}
+
+func (HypervisorSummaryModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the hypervisor.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the hypervisor.",
+				Computed:    true,
+			},
+			"connection_type": schema.StringAttribute{
+				Description: "Connection type of the hypervisor, e.g. `AzureRM`, `VCenter` or `XenServer`.",
+				Computed:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "GUID identifier of the zone of the hypervisor.",
+				Computed:    true,
+			},
+			"scopes": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "GUID identifiers of the delegated admin scopes of the hypervisor.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r HypervisorsDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, hypervisors []citrixorchestration.HypervisorResponseModel) HypervisorsDataSourceModel {
+	res := []HypervisorSummaryModel{}
+	for _, hypervisor := range hypervisors {
+		zone := hypervisor.GetZone()
+		res = append(res, HypervisorSummaryModel{
+			Id:             types.StringValue(hypervisor.GetId()),
+			Name:           types.StringValue(hypervisor.GetName()),
+			ConnectionType: types.StringValue(string(hypervisor.GetConnectionType())),
+			Zone:           types.StringValue(zone.GetId()),
+			Scopes:         util.StringArrayToStringList(ctx, diagnostics, util.GetIdsForScopeObjects(hypervisor.GetScopes())),
+		})
+	}
+
+	r.Hypervisors = res
+
+	return r
+}
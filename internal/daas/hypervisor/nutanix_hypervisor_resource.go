@@ -61,6 +61,11 @@ func (*nutanixHypervisorResource) ImportState(ctx context.Context, req resource.
 func (r *nutanixHypervisorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan NutanixHypervisorResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -98,7 +103,8 @@ func (r *nutanixHypervisorResource) Create(ctx context.Context, req resource.Cre
 	var body citrixorchestration.CreateHypervisorRequestModel
 	body.SetConnectionDetails(connectionDetails)
 
-	hypervisor, err := CreateHypervisor(ctx, r.client, &resp.Diagnostics, body)
+	timeouts := util.ObjectValueToTypedObject[HypervisorTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	hypervisor, err := CreateHypervisor(ctx, r.client, &resp.Diagnostics, body, util.GetTimeoutValue(timeouts.Create, util.DefaultHypervisorCreateTimeout))
 	if err != nil {
 		// Directly return. Error logs have been populated in common function.
 		return
@@ -119,6 +125,11 @@ func (r *nutanixHypervisorResource) Create(ctx context.Context, req resource.Cre
 func (r *nutanixHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state NutanixHypervisorResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -157,6 +168,11 @@ func (r *nutanixHypervisorResource) Read(ctx context.Context, req resource.ReadR
 func (r *nutanixHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan NutanixHypervisorResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -199,7 +215,8 @@ func (r *nutanixHypervisorResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Patch hypervisor
-	updatedHypervisor, err := UpdateHypervisor(ctx, r.client, &resp.Diagnostics, editHypervisorRequestBody, state.Id.ValueString(), state.Name.ValueString())
+	timeouts := util.ObjectValueToTypedObject[HypervisorTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	updatedHypervisor, err := UpdateHypervisor(ctx, r.client, &resp.Diagnostics, editHypervisorRequestBody, state.Id.ValueString(), state.Name.ValueString(), util.GetTimeoutValue(timeouts.Update, util.DefaultHypervisorUpdateTimeout))
 	if err != nil {
 		return
 	}
@@ -218,6 +235,11 @@ func (r *nutanixHypervisorResource) Update(ctx context.Context, req resource.Upd
 func (r *nutanixHypervisorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state NutanixHypervisorResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -258,6 +280,11 @@ func (r *nutanixHypervisorResource) ValidateConfig(ctx context.Context, req reso
 func (r *nutanixHypervisorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -74,6 +74,11 @@ func (r *scvmmHypervisorResource) ValidateConfig(ctx context.Context, req resour
 func (r *scvmmHypervisorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan SCVMMMHypervisorResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -110,7 +115,8 @@ func (r *scvmmHypervisorResource) Create(ctx context.Context, req resource.Creat
 	var body citrixorchestration.CreateHypervisorRequestModel
 	body.SetConnectionDetails(connectionDetails)
 
-	hypervisor, err := CreateHypervisor(ctx, r.client, &resp.Diagnostics, body)
+	timeouts := util.ObjectValueToTypedObject[HypervisorTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	hypervisor, err := CreateHypervisor(ctx, r.client, &resp.Diagnostics, body, util.GetTimeoutValue(timeouts.Create, util.DefaultHypervisorCreateTimeout))
 	if err != nil {
 		// Directly return. Error logs have been populated in common function.
 		return
@@ -131,6 +137,11 @@ func (r *scvmmHypervisorResource) Create(ctx context.Context, req resource.Creat
 func (r *scvmmHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state SCVMMMHypervisorResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -169,6 +180,11 @@ func (r *scvmmHypervisorResource) Read(ctx context.Context, req resource.ReadReq
 func (r *scvmmHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan SCVMMMHypervisorResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -211,7 +227,8 @@ func (r *scvmmHypervisorResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Patch hypervisor
-	updatedHypervisor, err := UpdateHypervisor(ctx, r.client, &resp.Diagnostics, editHypervisorRequestBody, state.Id.ValueString(), state.Name.ValueString())
+	timeouts := util.ObjectValueToTypedObject[HypervisorTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	updatedHypervisor, err := UpdateHypervisor(ctx, r.client, &resp.Diagnostics, editHypervisorRequestBody, state.Id.ValueString(), state.Name.ValueString(), util.GetTimeoutValue(timeouts.Update, util.DefaultHypervisorUpdateTimeout))
 	if err != nil {
 		return
 	}
@@ -230,6 +247,11 @@ func (r *scvmmHypervisorResource) Update(ctx context.Context, req resource.Updat
 func (r *scvmmHypervisorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state SCVMMMHypervisorResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -256,6 +278,11 @@ func (r *scvmmHypervisorResource) Delete(ctx context.Context, req resource.Delet
 func (r *scvmmHypervisorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -36,12 +36,15 @@ type SCVMMMHypervisorResourceModel struct {
 	MaxAbsoluteActiveActions            types.Int64  `tfsdk:"max_absolute_active_actions"`
 	MaxAbsoluteNewActionsPerMinute      types.Int64  `tfsdk:"max_absolute_new_actions_per_minute"`
 	MaxPowerActionsPercentageOfMachines types.Int64  `tfsdk:"max_power_actions_percentage_of_machines"`
+	Timeouts                            types.Object `tfsdk:"timeouts"` // HypervisorTimeouts
+	Site                                types.String `tfsdk:"site"`
 }
 
 func (SCVMMMHypervisorResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages a Microsoft System Virtual Machines Manager hypervisor.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the hypervisor.",
 				Computed:    true,
@@ -132,6 +135,7 @@ func (SCVMMMHypervisorResourceModel) GetSchema() schema.Schema {
 					),
 				},
 			},
+			"timeouts": HypervisorTimeouts{}.GetSchema(),
 		},
 	}
 }
@@ -40,12 +40,15 @@ type VsphereHypervisorResourceModel struct {
 	MaxAbsoluteActiveActions            types.Int64  `tfsdk:"max_absolute_active_actions"`
 	MaxAbsoluteNewActionsPerMinute      types.Int64  `tfsdk:"max_absolute_new_actions_per_minute"`
 	MaxPowerActionsPercentageOfMachines types.Int64  `tfsdk:"max_power_actions_percentage_of_machines"`
+	Timeouts                            types.Object `tfsdk:"timeouts"` // HypervisorTimeouts
+	Site                                types.String `tfsdk:"site"`
 }
 
 func (VsphereHypervisorResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages a VMware vSphere hypervisor.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the hypervisor.",
 				Computed:    true,
@@ -150,6 +153,7 @@ func (VsphereHypervisorResourceModel) GetSchema() schema.Schema {
 					),
 				},
 			},
+			"timeouts": HypervisorTimeouts{}.GetSchema(),
 		},
 	}
 }
@@ -40,12 +40,15 @@ type XenserverHypervisorResourceModel struct {
 	MaxAbsoluteActiveActions            types.Int64  `tfsdk:"max_absolute_active_actions"`
 	MaxAbsoluteNewActionsPerMinute      types.Int64  `tfsdk:"max_absolute_new_actions_per_minute"`
 	MaxPowerActionsPercentageOfMachines types.Int64  `tfsdk:"max_power_actions_percentage_of_machines"`
+	Timeouts                            types.Object `tfsdk:"timeouts"` // HypervisorTimeouts
+	Site                                types.String `tfsdk:"site"`
 }
 
 func (XenserverHypervisorResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages a XenServer hypervisor.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the hypervisor.",
 				Computed:    true,
@@ -153,6 +156,7 @@ func (XenserverHypervisorResourceModel) GetSchema() schema.Schema {
 					),
 				},
 			},
+			"timeouts": HypervisorTimeouts{}.GetSchema(),
 		},
 	}
 }
@@ -56,6 +56,11 @@ func (r *awsHypervisorResourcePoolResource) Configure(_ context.Context, req res
 func (r *awsHypervisorResourcePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan AwsHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -114,7 +119,8 @@ func (r *awsHypervisorResourcePoolResource) Create(ctx context.Context, req reso
 
 	resourcePoolDetails.SetNetworks(subnets)
 
-	resourcePool, err := CreateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, *hypervisor, resourcePoolDetails)
+	timeouts := util.ObjectValueToTypedObject[HypervisorResourcePoolTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	resourcePool, err := CreateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, *hypervisor, resourcePoolDetails, util.GetTimeoutValue(timeouts.Create, util.DefaultHypervisorResourcePoolCreateTimeout))
 	if err != nil {
 		// Directly return. Error logs have been populated in common function
 		return
@@ -134,6 +140,11 @@ func (r *awsHypervisorResourcePoolResource) Create(ctx context.Context, req reso
 func (r *awsHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state AwsHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -165,6 +176,11 @@ func (r *awsHypervisorResourcePoolResource) Read(ctx context.Context, req resour
 func (r *awsHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan AwsHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -184,7 +200,8 @@ func (r *awsHypervisorResourcePoolResource) Update(ctx context.Context, req reso
 	}
 	editHypervisorResourcePool.SetNetworks(subnets)
 
-	updatedResourcePool, err := UpdateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, plan.Hypervisor.ValueString(), plan.Id.ValueString(), editHypervisorResourcePool)
+	timeouts := util.ObjectValueToTypedObject[HypervisorResourcePoolTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	updatedResourcePool, err := UpdateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, plan.Hypervisor.ValueString(), plan.Id.ValueString(), editHypervisorResourcePool, util.GetTimeoutValue(timeouts.Update, util.DefaultHypervisorResourcePoolUpdateTimeout))
 	if err != nil {
 		return
 	}
@@ -221,6 +238,11 @@ func (r *awsHypervisorResourcePoolResource) ImportState(ctx context.Context, req
 func (r *awsHypervisorResourcePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state AwsHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -259,6 +281,11 @@ func (r *awsHypervisorResourcePoolResource) ValidateConfig(ctx context.Context,
 func (r *awsHypervisorResourcePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
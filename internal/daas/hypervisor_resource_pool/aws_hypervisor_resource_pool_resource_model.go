@@ -28,12 +28,15 @@ type AwsHypervisorResourcePoolResourceModel struct {
 	Subnets types.List   `tfsdk:"subnets"` // List[string]
 	/** AWS Resource Pool **/
 	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	Timeouts         types.Object `tfsdk:"timeouts"` // HypervisorResourcePoolTimeouts
+	Site             types.String `tfsdk:"site"`
 }
 
 func (AwsHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages an AWS EC2 hypervisor resource pool.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the resource pool.",
 				Computed:    true,
@@ -77,6 +80,7 @@ func (AwsHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"timeouts": HypervisorResourcePoolTimeouts{}.GetSchema(),
 		},
 	}
 }
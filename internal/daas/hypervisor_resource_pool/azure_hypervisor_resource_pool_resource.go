@@ -56,6 +56,11 @@ func (r *azureHypervisorResourcePoolResource) Configure(_ context.Context, req r
 func (r *azureHypervisorResourcePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan AzureHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -140,7 +145,8 @@ func (r *azureHypervisorResourcePoolResource) Create(ctx context.Context, req re
 	}
 	resourcePoolDetails.SetSubnets(subnets)
 
-	resourcePool, err := CreateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, *hypervisor, resourcePoolDetails)
+	timeouts := util.ObjectValueToTypedObject[HypervisorResourcePoolTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	resourcePool, err := CreateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, *hypervisor, resourcePoolDetails, util.GetTimeoutValue(timeouts.Create, util.DefaultHypervisorResourcePoolCreateTimeout))
 	if err != nil {
 		// Directly return. Error logs have been populated in common function
 		return
@@ -160,6 +166,11 @@ func (r *azureHypervisorResourcePoolResource) Create(ctx context.Context, req re
 func (r *azureHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state AzureHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -191,6 +202,11 @@ func (r *azureHypervisorResourcePoolResource) Read(ctx context.Context, req reso
 func (r *azureHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan AzureHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -212,7 +228,8 @@ func (r *azureHypervisorResourcePoolResource) Update(ctx context.Context, req re
 	}
 	editHypervisorResourcePool.SetSubnets(subnets)
 
-	updatedResourcePool, err := UpdateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, plan.Hypervisor.ValueString(), plan.Id.ValueString(), editHypervisorResourcePool)
+	timeouts := util.ObjectValueToTypedObject[HypervisorResourcePoolTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	updatedResourcePool, err := UpdateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, plan.Hypervisor.ValueString(), plan.Id.ValueString(), editHypervisorResourcePool, util.GetTimeoutValue(timeouts.Update, util.DefaultHypervisorResourcePoolUpdateTimeout))
 	if err != nil {
 		return
 	}
@@ -249,6 +266,11 @@ func (r *azureHypervisorResourcePoolResource) ImportState(ctx context.Context, r
 func (r *azureHypervisorResourcePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state AzureHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -287,6 +309,11 @@ func (r *azureHypervisorResourcePoolResource) ValidateConfig(ctx context.Context
 func (r *azureHypervisorResourcePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -30,12 +30,15 @@ type AzureHypervisorResourcePoolResourceModel struct {
 	Subnets        types.List   `tfsdk:"subnets"` // List[string]
 	/** Azure Resource Pool **/
 	VirtualNetworkResourceGroup types.String `tfsdk:"virtual_network_resource_group"`
+	Timeouts                    types.Object `tfsdk:"timeouts"` // HypervisorResourcePoolTimeouts
+	Site                        types.String `tfsdk:"site"`
 }
 
 func (AzureHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages an Azure hypervisor resource pool.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the resource pool.",
 				Computed:    true,
@@ -93,6 +96,7 @@ func (AzureHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 					),
 				},
 			},
+			"timeouts": HypervisorResourcePoolTimeouts{}.GetSchema(),
 		},
 	}
 }
@@ -56,6 +56,11 @@ func (r *gcpHypervisorResourcePoolResource) Configure(_ context.Context, req res
 func (r *gcpHypervisorResourcePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan GcpHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -126,7 +131,8 @@ func (r *gcpHypervisorResourcePoolResource) Create(ctx context.Context, req reso
 	}
 	resourcePoolDetails.SetNetworks(subnets)
 
-	resourcePool, err := CreateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, *hypervisor, resourcePoolDetails)
+	timeouts := util.ObjectValueToTypedObject[HypervisorResourcePoolTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	resourcePool, err := CreateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, *hypervisor, resourcePoolDetails, util.GetTimeoutValue(timeouts.Create, util.DefaultHypervisorResourcePoolCreateTimeout))
 	if err != nil {
 		// Directly return. Error logs have been populated in common function
 		return
@@ -146,6 +152,11 @@ func (r *gcpHypervisorResourcePoolResource) Create(ctx context.Context, req reso
 func (r *gcpHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state GcpHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -177,6 +188,11 @@ func (r *gcpHypervisorResourcePoolResource) Read(ctx context.Context, req resour
 func (r *gcpHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan GcpHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -201,7 +217,8 @@ func (r *gcpHypervisorResourcePoolResource) Update(ctx context.Context, req reso
 	}
 	editHypervisorResourcePool.SetNetworks(subnets)
 
-	updatedResourcePool, err := UpdateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, plan.Hypervisor.ValueString(), plan.Id.ValueString(), editHypervisorResourcePool)
+	timeouts := util.ObjectValueToTypedObject[HypervisorResourcePoolTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	updatedResourcePool, err := UpdateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, plan.Hypervisor.ValueString(), plan.Id.ValueString(), editHypervisorResourcePool, util.GetTimeoutValue(timeouts.Update, util.DefaultHypervisorResourcePoolUpdateTimeout))
 	if err != nil {
 		return
 	}
@@ -238,6 +255,11 @@ func (r *gcpHypervisorResourcePoolResource) ImportState(ctx context.Context, req
 func (r *gcpHypervisorResourcePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state GcpHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -276,6 +298,11 @@ func (r *gcpHypervisorResourcePoolResource) ValidateConfig(ctx context.Context,
 func (r *gcpHypervisorResourcePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
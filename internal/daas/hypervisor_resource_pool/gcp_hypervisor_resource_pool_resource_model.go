@@ -32,12 +32,15 @@ type GcpHypervisorResourcePoolResourceModel struct {
 	/** GCP Resource Pool **/
 	ProjectName types.String `tfsdk:"project_name"`
 	SharedVpc   types.Bool   `tfsdk:"shared_vpc"`
+	Timeouts    types.Object `tfsdk:"timeouts"` // HypervisorResourcePoolTimeouts
+	Site        types.String `tfsdk:"site"`
 }
 
 func (GcpHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages a GCP hypervisor resource pool.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the resource pool.",
 				Computed:    true,
@@ -102,6 +105,7 @@ func (GcpHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 					boolplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"timeouts": HypervisorResourcePoolTimeouts{}.GetSchema(),
 		},
 	}
 }
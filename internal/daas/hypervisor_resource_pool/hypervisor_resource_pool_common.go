@@ -55,8 +55,29 @@ func (HypervisorStorageModel) GetAttributes() map[string]schema.Attribute {
 	return HypervisorStorageModel{}.GetSchema().Attributes
 }
 
+type HypervisorResourcePoolTimeouts struct {
+	Create types.Int64 `tfsdk:"create"`
+	Update types.Int64 `tfsdk:"update"`
+}
+
+func (HypervisorResourcePoolTimeouts) GetSchema() schema.SingleNestedAttribute {
+	return util.GetTimeoutsSchema("hypervisor resource pool", util.TimeoutConfigs{
+		Create:        true,
+		CreateDefault: util.DefaultHypervisorResourcePoolCreateTimeout,
+		CreateMin:     1,
+
+		Update:        true,
+		UpdateDefault: util.DefaultHypervisorResourcePoolUpdateTimeout,
+		UpdateMin:     1,
+	})
+}
+
+func (HypervisorResourcePoolTimeouts) GetAttributes() map[string]schema.Attribute {
+	return HypervisorResourcePoolTimeouts{}.GetSchema().Attributes
+}
+
 // Create creates the resource and sets the initial Terraform state.
-func CreateHypervisorResourcePool(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, hypervisor citrixorchestration.HypervisorDetailResponseModel, resourcePoolDetails citrixorchestration.CreateHypervisorResourcePoolRequestModel) (*citrixorchestration.HypervisorResourcePoolDetailResponseModel, error) {
+func CreateHypervisorResourcePool(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, hypervisor citrixorchestration.HypervisorDetailResponseModel, resourcePoolDetails citrixorchestration.CreateHypervisorResourcePoolRequestModel, maxTimeout int) (*citrixorchestration.HypervisorResourcePoolDetailResponseModel, error) {
 	// Create new hypervisor resource pool
 	createResourcePoolRequest := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsCreateResourcePool(ctx, hypervisor.GetId())
 	createResourcePoolRequest = createResourcePoolRequest.CreateHypervisorResourcePoolRequestModel(resourcePoolDetails).Async(true)
@@ -70,7 +91,7 @@ func CreateHypervisorResourcePool(ctx context.Context, client *citrixdaasclient.
 		return nil, err
 	}
 
-	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error creating Resource Pool for Hypervisor "+hypervisor.GetName(), diagnostics, 10, true)
+	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error creating Resource Pool for Hypervisor "+hypervisor.GetName(), diagnostics, maxTimeout, true)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +106,7 @@ func CreateHypervisorResourcePool(ctx context.Context, client *citrixdaasclient.
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
-func UpdateHypervisorResourcePool(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, hypervisorId string, resourcePoolId string, editHypervisorResourcePool citrixorchestration.EditHypervisorResourcePoolRequestModel) (*citrixorchestration.HypervisorResourcePoolDetailResponseModel, error) {
+func UpdateHypervisorResourcePool(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, hypervisorId string, resourcePoolId string, editHypervisorResourcePool citrixorchestration.EditHypervisorResourcePoolRequestModel, maxTimeout int) (*citrixorchestration.HypervisorResourcePoolDetailResponseModel, error) {
 	// Patch hypervisor
 	patchResourcePoolRequest := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsPatchHypervisorResourcePool(ctx, hypervisorId, resourcePoolId)
 	patchResourcePoolRequest = patchResourcePoolRequest.EditHypervisorResourcePoolRequestModel(editHypervisorResourcePool).Async(true)
@@ -99,7 +120,7 @@ func UpdateHypervisorResourcePool(ctx context.Context, client *citrixdaasclient.
 		return nil, err
 	}
 
-	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating Resource Pool "+resourcePoolId, diagnostics, 5, true)
+	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating Resource Pool "+resourcePoolId, diagnostics, maxTimeout, true)
 	if err != nil {
 		return nil, err
 	}
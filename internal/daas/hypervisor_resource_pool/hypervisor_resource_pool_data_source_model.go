@@ -18,12 +18,14 @@ type HypervisorResourcePoolDataSourceModel struct {
 	Name           types.String `tfsdk:"name"`
 	HypervisorName types.String `tfsdk:"hypervisor_name"`
 	Networks       types.List   `tfsdk:"networks"` // List[string]
+	Site           types.String `tfsdk:"site"`
 }
 
 func (HypervisorResourcePoolDataSourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Read data of an existing hypervisor resource pool.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the hypervisor resource pool.",
 				Computed:    true,
@@ -0,0 +1,98 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package hypervisor_resource_pool
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &HypervisorResourcePoolsDataSource{}
+)
+
+func NewHypervisorResourcePoolsDataSource() datasource.DataSource {
+	return &HypervisorResourcePoolsDataSource{}
+}
+
+// HypervisorResourcePoolsDataSource defines the data source implementation.
+type HypervisorResourcePoolsDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *HypervisorResourcePoolsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hypervisor_resource_pools"
+}
+
+func (d *HypervisorResourcePoolsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = HypervisorResourcePoolsDataSourceModel{}.GetSchema()
+}
+
+func (d *HypervisorResourcePoolsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *HypervisorResourcePoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data HypervisorResourcePoolsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := util.NewListFilter(data.NameRegex.ValueString(), "", "")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		return
+	}
+
+	hypervisorName := data.HypervisorName.ValueString()
+	getResourcePoolsRequest := d.client.ApiClient.HypervisorsAPIsDAAS.HypervisorsGetHypervisorResourcePools(ctx, hypervisorName)
+	resourcePools, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.HypervisorResourcePoolResponseModelCollection](getResourcePoolsRequest, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Resource Pools of Hypervisor "+hypervisorName,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	result := []citrixorchestration.HypervisorResourcePoolDetailResponseModel{}
+	for _, resourcePool := range resourcePools.GetItems() {
+		if filter.MatchesName(resourcePool.GetName()) {
+			result = append(result, resourcePool)
+		}
+	}
+
+	data = data.RefreshPropertyValues(result)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,74 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package hypervisor_resource_pool
+
+import (
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HypervisorResourcePoolsDataSourceModel defines the Hypervisor Resource Pools data source implementation.
+type HypervisorResourcePoolsDataSourceModel struct {
+	HypervisorName types.String                         `tfsdk:"hypervisor_name"`
+	NameRegex      types.String                         `tfsdk:"name_regex"`
+	ResourcePools  []HypervisorResourcePoolSummaryModel `tfsdk:"resource_pools"`
+	Site           types.String                         `tfsdk:"site"`
+}
+
+func (HypervisorResourcePoolsDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source for the list of resource pools of a hypervisor, optionally filtered by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
+			"hypervisor_name": schema.StringAttribute{
+				Description: "Name or GUID identifier of the hypervisor to which the resource pools belong.",
+				Required:    true,
+			},
+			"name_regex": util.GetNameRegexFilterDataSourceSchema("resource pools"),
+			"resource_pools": schema.ListNestedAttribute{
+				Description:  "The resource pools matching the filters.",
+				Computed:     true,
+				NestedObject: HypervisorResourcePoolSummaryModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+// HypervisorResourcePoolSummaryModel defines the summary of a resource pool returned by the Hypervisor Resource Pools data source.
+type HypervisorResourcePoolSummaryModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (HypervisorResourcePoolSummaryModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the hypervisor resource pool.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the hypervisor resource pool.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r HypervisorResourcePoolsDataSourceModel) RefreshPropertyValues(resourcePools []citrixorchestration.HypervisorResourcePoolDetailResponseModel) HypervisorResourcePoolsDataSourceModel {
+	res := []HypervisorResourcePoolSummaryModel{}
+	for _, resourcePool := range resourcePools {
+		res = append(res, HypervisorResourcePoolSummaryModel{
+			Id:   types.StringValue(resourcePool.GetId()),
+			Name: types.StringValue(resourcePool.GetName()),
+		})
+	}
+
+	r.ResourcePools = res
+
+	return r
+}
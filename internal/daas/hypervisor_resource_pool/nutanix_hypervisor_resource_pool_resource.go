@@ -55,6 +55,11 @@ func (r *nutanixHypervisorResourcePoolResource) Configure(_ context.Context, req
 func (r *nutanixHypervisorResourcePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan NutanixHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -89,7 +94,8 @@ func (r *nutanixHypervisorResourcePoolResource) Create(ctx context.Context, req
 	}
 	resourcePoolDetails.SetNetworks(networks)
 
-	resourcePool, err := CreateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, *hypervisor, resourcePoolDetails)
+	timeouts := util.ObjectValueToTypedObject[HypervisorResourcePoolTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	resourcePool, err := CreateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, *hypervisor, resourcePoolDetails, util.GetTimeoutValue(timeouts.Create, util.DefaultHypervisorResourcePoolCreateTimeout))
 	if err != nil {
 		// Directly return. Error logs have been populated in common function
 		return
@@ -109,6 +115,11 @@ func (r *nutanixHypervisorResourcePoolResource) Create(ctx context.Context, req
 func (r *nutanixHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state NutanixHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -140,6 +151,11 @@ func (r *nutanixHypervisorResourcePoolResource) Read(ctx context.Context, req re
 func (r *nutanixHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan NutanixHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -172,7 +188,8 @@ func (r *nutanixHypervisorResourcePoolResource) Update(ctx context.Context, req
 	networks := plan.GetNetworksList(ctx, r.client, &resp.Diagnostics, hypervisor, false)
 	editHypervisorResourcePool.SetNetworks(networks)
 
-	_, err = UpdateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, plan.Hypervisor.ValueString(), plan.Id.ValueString(), editHypervisorResourcePool)
+	timeouts := util.ObjectValueToTypedObject[HypervisorResourcePoolTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	_, err = UpdateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, plan.Hypervisor.ValueString(), plan.Id.ValueString(), editHypervisorResourcePool, util.GetTimeoutValue(timeouts.Update, util.DefaultHypervisorResourcePoolUpdateTimeout))
 	if err != nil {
 		return
 	}
@@ -213,6 +230,11 @@ func (r *nutanixHypervisorResourcePoolResource) ImportState(ctx context.Context,
 func (r *nutanixHypervisorResourcePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state NutanixHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -277,6 +299,11 @@ func (r *nutanixHypervisorResourcePoolResource) ValidateConfig(ctx context.Conte
 func (r *nutanixHypervisorResourcePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -23,13 +23,16 @@ type NutanixHypervisorResourcePoolResourceModel struct {
 	Name       types.String `tfsdk:"name"`
 	Hypervisor types.String `tfsdk:"hypervisor"`
 	/**** Resource Pool Details ****/
-	Networks types.List `tfsdk:"networks"` // List[string]
+	Networks types.List   `tfsdk:"networks"` // List[string]
+	Timeouts types.Object `tfsdk:"timeouts"` // HypervisorResourcePoolTimeouts
+	Site     types.String `tfsdk:"site"`
 }
 
 func (NutanixHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages a Nutanix AHV hypervisor resource pool.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the resource pool.",
 				Computed:    true,
@@ -59,6 +62,7 @@ func (NutanixHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 					listvalidator.SizeAtLeast(1),
 				},
 			},
+			"timeouts": HypervisorResourcePoolTimeouts{}.GetSchema(),
 		},
 	}
 }
@@ -74,6 +74,11 @@ func (r *scvmmHypervisorResourcePoolResource) Configure(_ context.Context, req r
 func (r *scvmmHypervisorResourcePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -123,6 +128,11 @@ func (r *scvmmHypervisorResourcePoolResource) ModifyPlan(ctx context.Context, re
 func (r *scvmmHypervisorResourcePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan SCVMMHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -173,7 +183,8 @@ func (r *scvmmHypervisorResourcePoolResource) Create(ctx context.Context, req re
 
 	resourcePoolDetails.SetUseLocalStorageCaching(plan.UseLocalStorageCaching.ValueBool())
 
-	resourcePool, err := CreateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, *hypervisor, resourcePoolDetails)
+	timeouts := util.ObjectValueToTypedObject[HypervisorResourcePoolTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	resourcePool, err := CreateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, *hypervisor, resourcePoolDetails, util.GetTimeoutValue(timeouts.Create, util.DefaultHypervisorResourcePoolCreateTimeout))
 	if err != nil {
 		// Directly return. Error logs have been populated in common function
 		return
@@ -193,6 +204,11 @@ func (r *scvmmHypervisorResourcePoolResource) Create(ctx context.Context, req re
 func (r *scvmmHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state SCVMMHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -242,6 +258,11 @@ func (r *scvmmHypervisorResourcePoolResource) ImportState(ctx context.Context, r
 func (r *scvmmHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan SCVMMHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -318,7 +339,8 @@ func (r *scvmmHypervisorResourcePoolResource) Update(ctx context.Context, req re
 
 	editHypervisorResourcePool.SetUseLocalStorageCaching(plan.UseLocalStorageCaching.ValueBool())
 
-	updatedResourcePool, err := UpdateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, plan.Hypervisor.ValueString(), plan.Id.ValueString(), editHypervisorResourcePool)
+	timeouts := util.ObjectValueToTypedObject[HypervisorResourcePoolTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	updatedResourcePool, err := UpdateHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, plan.Hypervisor.ValueString(), plan.Id.ValueString(), editHypervisorResourcePool, util.GetTimeoutValue(timeouts.Update, util.DefaultHypervisorResourcePoolUpdateTimeout))
 	if err != nil {
 		return
 	}
@@ -337,6 +359,11 @@ func (r *scvmmHypervisorResourcePoolResource) Update(ctx context.Context, req re
 func (r *scvmmHypervisorResourcePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state SCVMMHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
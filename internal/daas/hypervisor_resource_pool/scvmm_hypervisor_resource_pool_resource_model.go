@@ -31,12 +31,15 @@ type SCVMMHypervisorResourcePoolResourceModel struct {
 	Storage                types.List   `tfsdk:"storage"`           // List[HypervisorStorageModel]
 	TemporaryStorage       types.List   `tfsdk:"temporary_storage"` // List[HypervisorStorageModel]
 	UseLocalStorageCaching types.Bool   `tfsdk:"use_local_storage_caching"`
+	Timeouts               types.Object `tfsdk:"timeouts"` // HypervisorResourcePoolTimeouts
+	Site                   types.String `tfsdk:"site"`
 }
 
 func (SCVMMHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages a Microsoft System Virtual Machines Manager hypervisor resource pool.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the resource pool.",
 				Computed:    true,
@@ -98,6 +101,7 @@ func (SCVMMHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
+			"timeouts": HypervisorResourcePoolTimeouts{}.GetSchema(),
 		},
 	}
 }
@@ -67,12 +67,15 @@ type VsphereHypervisorResourcePoolResourceModel struct {
 	Storage                types.List   `tfsdk:"storage"`           // List[HypervisorStorageModel]
 	TemporaryStorage       types.List   `tfsdk:"temporary_storage"` // List[HypervisorStorageModel]
 	UseLocalStorageCaching types.Bool   `tfsdk:"use_local_storage_caching"`
+	Timeouts               types.Object `tfsdk:"timeouts"` // HypervisorResourcePoolTimeouts
+	Site                   types.String `tfsdk:"site"`
 }
 
 func (VsphereHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages a VMware vSphere hypervisor resource pool.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the resource pool.",
 				Computed:    true,
@@ -128,6 +131,7 @@ func (VsphereHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
+			"timeouts": HypervisorResourcePoolTimeouts{}.GetSchema(),
 		},
 	}
 }
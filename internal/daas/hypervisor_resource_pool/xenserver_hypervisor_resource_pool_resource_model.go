@@ -25,16 +25,19 @@ type XenserverHypervisorResourcePoolResourceModel struct {
 	Name       types.String `tfsdk:"name"`
 	Hypervisor types.String `tfsdk:"hypervisor"`
 	/**** Resource Pool Details ****/
-	Networks               types.List `tfsdk:"networks"`          //List[string]
-	Storage                types.List `tfsdk:"storage"`           //List[HypervisorStorageModel]
-	TemporaryStorage       types.List `tfsdk:"temporary_storage"` //List[HypervisorStorageModel]
-	UseLocalStorageCaching types.Bool `tfsdk:"use_local_storage_caching"`
+	Networks               types.List   `tfsdk:"networks"`          //List[string]
+	Storage                types.List   `tfsdk:"storage"`           //List[HypervisorStorageModel]
+	TemporaryStorage       types.List   `tfsdk:"temporary_storage"` //List[HypervisorStorageModel]
+	UseLocalStorageCaching types.Bool   `tfsdk:"use_local_storage_caching"`
+	Timeouts               types.Object `tfsdk:"timeouts"` // HypervisorResourcePoolTimeouts
+	Site                   types.String `tfsdk:"site"`
 }
 
 func (XenserverHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages an XenServer hypervisor resource pool.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the resource pool.",
 				Computed:    true,
@@ -89,6 +92,7 @@ func (XenserverHypervisorResourcePoolResourceModel) GetSchema() schema.Schema {
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
+			"timeouts": HypervisorResourcePoolTimeouts{}.GetSchema(),
 		},
 	}
 }
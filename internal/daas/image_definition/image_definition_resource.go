@@ -0,0 +1,298 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package image_definition
+
+import (
+	"context"
+	"net/http"
+
+	citrixorchestration "github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &imageDefinitionResource{}
+	_ resource.ResourceWithConfigure      = &imageDefinitionResource{}
+	_ resource.ResourceWithImportState    = &imageDefinitionResource{}
+	_ resource.ResourceWithValidateConfig = &imageDefinitionResource{}
+	_ resource.ResourceWithModifyPlan     = &imageDefinitionResource{}
+)
+
+// NewImageDefinitionResource is a helper function to simplify the provider implementation.
+func NewImageDefinitionResource() resource.Resource {
+	return &imageDefinitionResource{}
+}
+
+// imageDefinitionResource is the resource implementation.
+type imageDefinitionResource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata returns the resource type name.
+func (r *imageDefinitionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_definition"
+}
+
+// Schema defines the schema for the resource.
+func (r *imageDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ImageDefinitionResourceModel{}.GetSchema()
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *imageDefinitionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *imageDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ImageDefinitionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	var hypervisorConnection citrixorchestration.AssignHypervisorConnectionToImageDefinitionRequestModel
+	hypervisorConnection.SetHypervisorConnection(plan.Hypervisor.ValueString())
+
+	var body citrixorchestration.CreateImageDefinitionRequestModel
+	body.SetName(plan.Name.ValueString())
+	body.SetDescription(plan.Description.ValueString())
+	body.SetOsType(citrixorchestration.OsType(plan.OsType.ValueString()))
+	body.SetVDASessionSupport(citrixorchestration.SessionSupport(plan.SessionSupport.ValueString()))
+	body.SetAssignedHypervisorConnection(hypervisorConnection)
+
+	createImageDefinitionRequest := r.client.ApiClient.ImageDefinitionsAPIsDAAS.ImageDefinitionsCreateImageDefinition(ctx)
+	createImageDefinitionRequest = createImageDefinitionRequest.CreateImageDefinitionRequestModel(body)
+
+	// Create new image definition
+	imageDefinition, httpResp, err := citrixdaasclient.AddRequestData(createImageDefinitionRequest, r.client).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Image Definition "+plan.Name.ValueString(),
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan = plan.RefreshPropertyValues(ctx, &resp.Diagnostics, imageDefinition)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *imageDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state ImageDefinitionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed image definition properties from Orchestration
+	imageDefinition, err := readImageDefinition(ctx, r.client, resp, state.Id.ValueString())
+	if err != nil {
+		return
+	}
+
+	state = state.RefreshPropertyValues(ctx, &resp.Diagnostics, imageDefinition)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *imageDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ImageDefinitionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from state
+	var state ImageDefinitionResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Construct the update model
+	var body citrixorchestration.UpdateImageDefinitionRequestModel
+	body.SetName(plan.Name.ValueString())
+	body.SetDescription(plan.Description.ValueString())
+
+	// Update image definition
+	updateImageDefinitionRequest := r.client.ApiClient.ImageDefinitionsAPIsDAAS.ImageDefinitionsUpdateImageDefinition(ctx, state.Id.ValueString())
+	updateImageDefinitionRequest = updateImageDefinitionRequest.UpdateImageDefinitionRequestModel(body)
+	_, httpResp, err := citrixdaasclient.AddRequestData(updateImageDefinitionRequest, r.client).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Image Definition "+state.Name.ValueString(),
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	// Fetch updated image definition
+	updatedImageDefinition, err := getImageDefinition(ctx, r.client, &resp.Diagnostics, state.Id.ValueString())
+	if err != nil {
+		return
+	}
+
+	// Update resource state with updated property values
+	plan = plan.RefreshPropertyValues(ctx, &resp.Diagnostics, updatedImageDefinition)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *imageDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from state
+	var state ImageDefinitionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing image definition
+	imageDefinitionId := state.Id.ValueString()
+	imageDefinitionName := state.Name.ValueString()
+	deleteImageDefinitionRequest := r.client.ApiClient.ImageDefinitionsAPIsDAAS.ImageDefinitionsDeleteImageDefinition(ctx, imageDefinitionId)
+	httpResp, err := citrixdaasclient.AddRequestData(deleteImageDefinitionRequest.Async(true), r.client).Execute()
+	if err != nil {
+		if httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting Image Definition "+imageDefinitionName,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	var timeouts ImageDefinitionTimeouts
+	if !state.Timeouts.IsNull() {
+		timeouts = util.ObjectValueToTypedObject[ImageDefinitionTimeouts](ctx, &resp.Diagnostics, state.Timeouts)
+	}
+	err = util.ProcessAsyncJobResponse(ctx, r.client, httpResp, "Error deleting Image Definition "+imageDefinitionName, &resp.Diagnostics, util.GetTimeoutValue(timeouts.Delete, util.DefaultImageDefinitionDeleteTimeout), true)
+	if err != nil {
+		return
+	}
+}
+
+func (r *imageDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *imageDefinitionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client != nil && r.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+}
+
+func (r *imageDefinitionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	var data ImageDefinitionResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaType, configValuesForSchema := util.GetConfigValuesForSchema(ctx, &resp.Diagnostics, &data)
+	tflog.Debug(ctx, "Validate Config - "+schemaType, configValuesForSchema)
+}
+
+// Gets the image definition and logs any errors
+func getImageDefinition(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, imageDefinitionId string) (*citrixorchestration.ImageDefinitionResponseModel, error) {
+	getImageDefinitionRequest := client.ApiClient.ImageDefinitionsAPIsDAAS.ImageDefinitionsGetImageDefinition(ctx, imageDefinitionId)
+	imageDefinition, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.ImageDefinitionResponseModel](getImageDefinitionRequest, client)
+	if err != nil {
+		diagnostics.AddError(
+			"Error reading Image Definition "+imageDefinitionId,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+	}
+
+	return imageDefinition, err
+}
+
+func readImageDefinition(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.ReadResponse, imageDefinitionId string) (*citrixorchestration.ImageDefinitionResponseModel, error) {
+	getImageDefinitionRequest := client.ApiClient.ImageDefinitionsAPIsDAAS.ImageDefinitionsGetImageDefinition(ctx, imageDefinitionId)
+	imageDefinition, _, err := util.ReadResource[*citrixorchestration.ImageDefinitionResponseModel](getImageDefinitionRequest, ctx, client, resp, "Image Definition", imageDefinitionId)
+	return imageDefinition, err
+}
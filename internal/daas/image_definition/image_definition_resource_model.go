@@ -0,0 +1,140 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package image_definition
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ImageDefinitionTimeouts struct {
+	Delete types.Int64 `tfsdk:"delete"`
+}
+
+func (ImageDefinitionTimeouts) GetSchema() schema.SingleNestedAttribute {
+	return util.GetTimeoutsSchema("image definition", util.TimeoutConfigs{
+		Delete:        true,
+		DeleteDefault: util.DefaultImageDefinitionDeleteTimeout,
+		DeleteMin:     5,
+	})
+}
+
+func (ImageDefinitionTimeouts) GetAttributes() map[string]schema.Attribute {
+	return ImageDefinitionTimeouts{}.GetSchema().Attributes
+}
+
+// ImageDefinitionResourceModel maps the resource schema data.
+type ImageDefinitionResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	OsType         types.String `tfsdk:"os_type"`
+	SessionSupport types.String `tfsdk:"session_support"`
+	Hypervisor     types.String `tfsdk:"hypervisor"`
+	LatestVersion  types.Int64  `tfsdk:"latest_version"`
+	Site           types.String `tfsdk:"site"`
+	Timeouts       types.Object `tfsdk:"timeouts"` // ImageDefinitionTimeouts
+}
+
+func (ImageDefinitionResourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		Description: "CVAD --- Manages an image definition. An image definition groups the prepared image versions of a master image, which can be shared by multiple machine catalogs.",
+		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the image definition.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the image definition.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the image definition.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"os_type": schema.StringAttribute{
+				Description: "The operating system type of the image definition. Choose between `Windows` and `Linux`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(citrixorchestration.OSTYPE_WINDOWS),
+						string(citrixorchestration.OSTYPE_LINUX),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"session_support": schema.StringAttribute{
+				Description: "Session support of the VDA installed on the image. Choose between `SingleSession` and `MultiSession`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(citrixorchestration.SESSIONSUPPORT_SINGLE_SESSION),
+						string(citrixorchestration.SESSIONSUPPORT_MULTI_SESSION),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hypervisor": schema.StringAttribute{
+				Description: "Id of the hypervisor on which the image versions of the image definition are prepared.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"latest_version": schema.Int64Attribute{
+				Description: "The latest version number of the image definition.",
+				Computed:    true,
+			},
+			"timeouts": ImageDefinitionTimeouts{}.GetSchema(),
+		},
+	}
+}
+
+func (ImageDefinitionResourceModel) GetAttributes() map[string]schema.Attribute {
+	return ImageDefinitionResourceModel{}.GetSchema().Attributes
+}
+
+func (r ImageDefinitionResourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, imageDefinition *citrixorchestration.ImageDefinitionResponseModel) ImageDefinitionResourceModel {
+	r.Id = types.StringValue(imageDefinition.GetId())
+	r.Name = types.StringValue(imageDefinition.GetName())
+	r.Description = types.StringValue(imageDefinition.GetDescription())
+	r.OsType = types.StringValue(string(imageDefinition.GetOsType()))
+	r.SessionSupport = types.StringValue(string(imageDefinition.GetVDASessionSupport()))
+	r.LatestVersion = types.Int64Value(int64(imageDefinition.GetLatestVersion()))
+
+	hypervisorConnections := imageDefinition.GetHypervisorConnections()
+	if len(hypervisorConnections) > 0 {
+		r.Hypervisor = types.StringValue(hypervisorConnections[0].GetId())
+	} else {
+		r.Hypervisor = types.StringNull()
+	}
+
+	return r
+}
@@ -0,0 +1,514 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package image_definition
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	citrixorchestration "github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &imageVersionResource{}
+	_ resource.ResourceWithConfigure      = &imageVersionResource{}
+	_ resource.ResourceWithValidateConfig = &imageVersionResource{}
+	_ resource.ResourceWithModifyPlan     = &imageVersionResource{}
+)
+
+// NewImageVersionResource is a helper function to simplify the provider implementation.
+func NewImageVersionResource() resource.Resource {
+	return &imageVersionResource{}
+}
+
+// imageVersionResource is the resource implementation.
+type imageVersionResource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata returns the resource type name.
+func (r *imageVersionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_version"
+}
+
+// Schema defines the schema for the resource.
+func (r *imageVersionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ImageVersionResourceModel{}.GetSchema()
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *imageVersionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *imageVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ImageVersionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hypervisor, err := util.GetHypervisor(ctx, r.client, &resp.Diagnostics, plan.Hypervisor.ValueString())
+	if err != nil {
+		return
+	}
+
+	hypervisorResourcePool, err := util.GetHypervisorResourcePool(ctx, r.client, &resp.Diagnostics, plan.Hypervisor.ValueString(), plan.HypervisorResourcePool.ValueString())
+	if err != nil {
+		return
+	}
+
+	// Generate API request body from plan
+	body, err := buildImageVersionRequestBody(ctx, r.client, &resp.Diagnostics, plan, hypervisor, hypervisorResourcePool)
+	if err != nil {
+		return
+	}
+
+	imageDefinitionId := plan.ImageDefinition.ValueString()
+
+	// Keep track of the existing image versions to identify the new image version when the job does not report it
+	existingImageVersions, err := getImageVersions(ctx, r.client, &resp.Diagnostics, imageDefinitionId)
+	if err != nil {
+		return
+	}
+
+	createImageVersionRequest := r.client.ApiClient.ImageDefinitionsAPIsDAAS.ImageDefinitionsCreateImageVersion(ctx, imageDefinitionId)
+	createImageVersionRequest = createImageVersionRequest.CreateImageVersionRequestModel(*body).Async(true)
+
+	// Create new image version
+	_, httpResp, err := citrixdaasclient.AddRequestData(createImageVersionRequest, r.client).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Image Version for Image Definition "+imageDefinitionId,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	var timeouts ImageVersionTimeouts
+	if !plan.Timeouts.IsNull() {
+		timeouts = util.ObjectValueToTypedObject[ImageVersionTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	}
+	resultLocation, err := util.ProcessAsyncJobResponseWithResultLocation(ctx, r.client, httpResp, "Error creating Image Version for Image Definition "+imageDefinitionId, &resp.Diagnostics, util.GetTimeoutValue(timeouts.Create, util.DefaultImageVersionCreateTimeout), true)
+	if err != nil {
+		return
+	}
+
+	imageVersionId := getImageVersionIdFromResultLocation(resultLocation)
+	if imageVersionId == "" {
+		imageVersionId, err = findCreatedImageVersionId(ctx, r.client, &resp.Diagnostics, imageDefinitionId, existingImageVersions)
+		if err != nil {
+			return
+		}
+	}
+
+	imageVersion, err := getImageVersion(ctx, r.client, &resp.Diagnostics, imageDefinitionId, imageVersionId)
+	if err != nil {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan = plan.RefreshPropertyValues(ctx, &resp.Diagnostics, imageVersion)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *imageVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state ImageVersionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed image version properties from Orchestration
+	imageVersion, err := readImageVersion(ctx, r.client, resp, state.ImageDefinition.ValueString(), state.Id.ValueString())
+	if err != nil {
+		return
+	}
+
+	state = state.RefreshPropertyValues(ctx, &resp.Diagnostics, imageVersion)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *imageVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ImageVersionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from state
+	var state ImageVersionResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the description of an image version can be updated in place
+	var body citrixorchestration.UpdateImageVersionRequestModel
+	body.SetDescription(plan.Description.ValueString())
+
+	imageDefinitionId := state.ImageDefinition.ValueString()
+	imageVersionId := state.Id.ValueString()
+	updateImageVersionRequest := r.client.ApiClient.ImageDefinitionsAPIsDAAS.ImageDefinitionsUpdateImageDefinitionImageVersion(ctx, imageDefinitionId, imageVersionId)
+	updateImageVersionRequest = updateImageVersionRequest.UpdateImageVersionRequestModel(body)
+	_, httpResp, err := citrixdaasclient.AddRequestData(updateImageVersionRequest, r.client).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Image Version "+imageVersionId,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	// Fetch updated image version
+	updatedImageVersion, err := getImageVersion(ctx, r.client, &resp.Diagnostics, imageDefinitionId, imageVersionId)
+	if err != nil {
+		return
+	}
+
+	// Update resource state with updated property values
+	plan = plan.RefreshPropertyValues(ctx, &resp.Diagnostics, updatedImageVersion)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *imageVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from state
+	var state ImageVersionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing image version
+	imageDefinitionId := state.ImageDefinition.ValueString()
+	imageVersionId := state.Id.ValueString()
+	deleteImageVersionRequest := r.client.ApiClient.ImageDefinitionsAPIsDAAS.ImageDefinitionsDeleteImageDefinitionImageVersion(ctx, imageDefinitionId, imageVersionId)
+	httpResp, err := citrixdaasclient.AddRequestData(deleteImageVersionRequest.Async(true), r.client).Execute()
+	if err != nil {
+		if httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting Image Version "+imageVersionId,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	var timeouts ImageVersionTimeouts
+	if !state.Timeouts.IsNull() {
+		timeouts = util.ObjectValueToTypedObject[ImageVersionTimeouts](ctx, &resp.Diagnostics, state.Timeouts)
+	}
+	err = util.ProcessAsyncJobResponse(ctx, r.client, httpResp, "Error deleting Image Version "+imageVersionId, &resp.Diagnostics, util.GetTimeoutValue(timeouts.Delete, util.DefaultImageVersionDeleteTimeout), true)
+	if err != nil {
+		return
+	}
+}
+
+func (r *imageVersionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client != nil && r.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+}
+
+func (r *imageVersionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	var data ImageVersionResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaType, configValuesForSchema := util.GetConfigValuesForSchema(ctx, &resp.Diagnostics, &data)
+	tflog.Debug(ctx, "Validate Config - "+schemaType, configValuesForSchema)
+}
+
+func buildImageVersionRequestBody(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, plan ImageVersionResourceModel, hypervisor *citrixorchestration.HypervisorDetailResponseModel, hypervisorResourcePool *citrixorchestration.HypervisorResourcePoolDetailResponseModel) (*citrixorchestration.CreateImageVersionRequestModel, error) {
+	var imageScheme citrixorchestration.CreateImageSchemeRequestModel
+	var body citrixorchestration.CreateImageVersionRequestModel
+	body.SetDescription(plan.Description.ValueString())
+	body.SetResourcePool(hypervisorResourcePool.GetId())
+
+	switch hypervisor.GetConnectionType() {
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_AZURE_RM:
+		if plan.AzureImageSpecs.IsNull() {
+			err := fmt.Errorf("azure_image_specs must be specified for image versions prepared on an Azure hypervisor")
+			diagnostics.AddError("Error creating Image Version", err.Error())
+			return nil, err
+		}
+		azureImageSpecs := util.ObjectValueToTypedObject[AzureImageSpecsModel](ctx, diagnostics, plan.AzureImageSpecs)
+
+		serviceOffering := azureImageSpecs.ServiceOffering.ValueString()
+		serviceOfferingPath, httpResp, err := util.GetSingleResourcePathFromHypervisor(ctx, client, hypervisor.GetName(), hypervisorResourcePool.GetName(), "serviceoffering.folder", serviceOffering, util.ServiceOfferingResourceType, "")
+		if err != nil {
+			diagnostics.AddError(
+				"Error creating Image Version",
+				"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+					fmt.Sprintf("\nFailed to resolve service offering %s on Azure, error: %s", serviceOffering, err.Error()),
+			)
+			return nil, err
+		}
+		imageScheme.SetServiceOfferingPath(serviceOfferingPath)
+
+		imagePath, err := getAzureImagePath(ctx, client, diagnostics, azureImageSpecs, hypervisor, hypervisorResourcePool)
+		if err != nil {
+			return nil, err
+		}
+		body.SetMasterImagePath(imagePath)
+
+		customProperties := []citrixorchestration.NameValueStringPairModel{}
+		util.AppendNameValueStringPair(&customProperties, "StorageType", azureImageSpecs.StorageType.ValueString())
+		if !azureImageSpecs.LicenseType.IsNull() {
+			util.AppendNameValueStringPair(&customProperties, "LicenseType", azureImageSpecs.LicenseType.ValueString())
+		}
+		imageScheme.SetCustomProperties(customProperties)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER:
+		if plan.VsphereImageSpecs.IsNull() {
+			err := fmt.Errorf("vsphere_image_specs must be specified for image versions prepared on a vSphere hypervisor")
+			diagnostics.AddError("Error creating Image Version", err.Error())
+			return nil, err
+		}
+		vsphereImageSpecs := util.ObjectValueToTypedObject[VsphereImageSpecsModel](ctx, diagnostics, plan.VsphereImageSpecs)
+
+		imagePath, err := getOnPremImagePath(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), vsphereImageSpecs.MasterImageVm.ValueString(), vsphereImageSpecs.ImageSnapshot.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		body.SetMasterImagePath(imagePath)
+
+		imageScheme.SetCpuCount(int32(vsphereImageSpecs.CpuCount.ValueInt64()))
+		imageScheme.SetMemoryMB(int32(vsphereImageSpecs.MemoryMB.ValueInt64()))
+	default:
+		err := fmt.Errorf("image versions are not supported for hypervisor connection type %s", hypervisor.GetConnectionType())
+		diagnostics.AddError("Error creating Image Version", err.Error())
+		return nil, err
+	}
+
+	body.SetImageScheme(imageScheme)
+
+	return &body, nil
+}
+
+func getAzureImagePath(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, azureImageSpecs AzureImageSpecsModel, hypervisor *citrixorchestration.HypervisorDetailResponseModel, hypervisorResourcePool *citrixorchestration.HypervisorResourcePoolDetailResponseModel) (string, error) {
+	imageBasePath := "image.folder"
+	if sharedSubscription := azureImageSpecs.SharedSubscription.ValueString(); sharedSubscription != "" {
+		imageBasePath = fmt.Sprintf("image.folder\\%s.sharedsubscription", sharedSubscription)
+	}
+	resourceGroup := azureImageSpecs.ResourceGroup.ValueString()
+
+	if masterImage := azureImageSpecs.MasterImage.ValueString(); masterImage != "" {
+		queryPath := fmt.Sprintf("%s\\%s.resourcegroup", imageBasePath, resourceGroup)
+		imagePath, httpResp, err := util.GetSingleResourcePathFromHypervisor(ctx, client, hypervisor.GetName(), hypervisorResourcePool.GetName(), queryPath, masterImage, "", "")
+		if err != nil {
+			diagnostics.AddError(
+				"Error creating Image Version",
+				"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+					fmt.Sprintf("\nFailed to resolve master image Managed Disk or Snapshot %s, error: %s", masterImage, err.Error()),
+			)
+			return "", err
+		}
+		return imagePath, nil
+	}
+
+	galleryImage := util.ObjectValueToTypedObject[AzureGalleryImageModel](ctx, diagnostics, azureImageSpecs.GalleryImage)
+	gallery := galleryImage.Gallery.ValueString()
+	definition := galleryImage.Definition.ValueString()
+	version := galleryImage.Version.ValueString()
+	queryPath := fmt.Sprintf("%s\\%s.resourcegroup\\%s.gallery\\%s.imagedefinition", imageBasePath, resourceGroup, gallery, definition)
+	imagePath, httpResp, err := util.GetSingleResourcePathFromHypervisor(ctx, client, hypervisor.GetName(), hypervisorResourcePool.GetName(), queryPath, version, "", "")
+	if err != nil {
+		diagnostics.AddError(
+			"Error creating Image Version",
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				fmt.Sprintf("\nFailed to locate Azure Image Gallery image %s of version %s in gallery %s, error: %s", definition, version, gallery, err.Error()),
+		)
+		return "", err
+	}
+
+	return imagePath, nil
+}
+
+func getOnPremImagePath(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, hypervisorName, resourcePoolName, image, snapshot string) (string, error) {
+	queryPath := ""
+	resourceType := util.VirtualMachineResourceType
+	resourceName := image
+	errTemplate := fmt.Sprintf("Failed to locate master image machine %s", image)
+	if snapshot != "" {
+		queryPath = fmt.Sprintf("%s.vm", image)
+		snapshotSegments := strings.Split(snapshot, "/")
+		snapshotName := snapshotSegments[len(snapshotSegments)-1]
+		for i := 0; i < len(snapshotSegments)-1; i++ {
+			queryPath = queryPath + "\\" + snapshotSegments[i] + ".snapshot"
+		}
+
+		resourceType = util.SnapshotResourceType
+		resourceName = snapshotName
+		errTemplate = fmt.Sprintf("Failed to locate snapshot %s of master image VM %s", snapshotName, image)
+	}
+
+	imagePath, httpResp, err := util.GetSingleResourcePathFromHypervisor(ctx, client, hypervisorName, resourcePoolName, queryPath, resourceName, resourceType, "")
+	if err != nil {
+		diagnostics.AddError(
+			"Error creating Image Version",
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				fmt.Sprintf("\n%s, error: %s", errTemplate, err.Error()),
+		)
+		return "", err
+	}
+
+	return imagePath, nil
+}
+
+// Gets the image version and logs any errors
+func getImageVersion(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, imageDefinitionId, imageVersionNumberOrId string) (*citrixorchestration.ImageVersionResponseModel, error) {
+	getImageVersionRequest := client.ApiClient.ImageDefinitionsAPIsDAAS.ImageDefinitionsGetImageDefinitionImageVersion(ctx, imageDefinitionId, imageVersionNumberOrId)
+	imageVersion, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.ImageVersionResponseModel](getImageVersionRequest, client)
+	if err != nil {
+		diagnostics.AddError(
+			"Error reading Image Version "+imageVersionNumberOrId+" of Image Definition "+imageDefinitionId,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+	}
+
+	return imageVersion, err
+}
+
+func getImageVersions(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, imageDefinitionId string) ([]citrixorchestration.ImageVersionResponseModel, error) {
+	request := client.ApiClient.ImageDefinitionsAPIsDAAS.ImageDefinitionsGetImageDefinitionImageVersions(ctx, imageDefinitionId).Limit(util.ListDataSourcePageSize)
+	return util.GetAllPages[citrixorchestration.ImageVersionResponseModel](diagnostics, "Error listing Image Versions of Image Definition "+imageDefinitionId, func(continuationToken string) (*citrixorchestration.ImageVersionResponseModelCollection, *http.Response, error) {
+		if continuationToken != "" {
+			request = request.ContinuationToken(continuationToken)
+		}
+		return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.ImageVersionResponseModelCollection](request, client)
+	})
+}
+
+// getImageVersionIdFromResultLocation returns the image version ID from the result location of an image version creation job, e.g. https://{host}/cvad/manage/ImageDefinitions/{definitionId}/ImageVersions/{versionId}
+func getImageVersionIdFromResultLocation(resultLocation string) string {
+	resultPath, _, _ := strings.Cut(resultLocation, "?")
+	segments := strings.Split(strings.TrimSuffix(resultPath, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[len(segments)-2], "ImageVersions") {
+		return ""
+	}
+	return segments[len(segments)-1]
+}
+
+// findCreatedImageVersionId returns the ID of the only image version of the image definition which is not in the list of image versions from before the creation.
+func findCreatedImageVersionId(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, imageDefinitionId string, existingImageVersions []citrixorchestration.ImageVersionResponseModel) (string, error) {
+	imageVersions, err := getImageVersions(ctx, client, diagnostics, imageDefinitionId)
+	if err != nil {
+		return "", err
+	}
+
+	createdImageVersionIds := []string{}
+	for _, imageVersion := range imageVersions {
+		isExisting := slices.ContainsFunc(existingImageVersions, func(existingImageVersion citrixorchestration.ImageVersionResponseModel) bool {
+			return existingImageVersion.GetId() == imageVersion.GetId()
+		})
+		if !isExisting {
+			createdImageVersionIds = append(createdImageVersionIds, imageVersion.GetId())
+		}
+	}
+
+	if len(createdImageVersionIds) != 1 {
+		err = fmt.Errorf("the job did not report the new image version, and %d image versions were added to image definition %s while the image version was created", len(createdImageVersionIds), imageDefinitionId)
+		diagnostics.AddError(
+			"Error creating Image Version for Image Definition "+imageDefinitionId,
+			"Error message: "+err.Error(),
+		)
+		return "", err
+	}
+
+	return createdImageVersionIds[0], nil
+}
+
+func readImageVersion(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.ReadResponse, imageDefinitionId, imageVersionId string) (*citrixorchestration.ImageVersionResponseModel, error) {
+	getImageVersionRequest := client.ApiClient.ImageDefinitionsAPIsDAAS.ImageDefinitionsGetImageDefinitionImageVersion(ctx, imageDefinitionId, imageVersionId)
+	imageVersion, _, err := util.ReadResource[*citrixorchestration.ImageVersionResponseModel](getImageVersionRequest, ctx, client, resp, "Image Version", imageVersionId)
+	return imageVersion, err
+}
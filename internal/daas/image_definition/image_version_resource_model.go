@@ -0,0 +1,281 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package image_definition
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ImageVersionTimeouts struct {
+	Create types.Int64 `tfsdk:"create"`
+	Delete types.Int64 `tfsdk:"delete"`
+}
+
+func (ImageVersionTimeouts) GetSchema() schema.SingleNestedAttribute {
+	return util.GetTimeoutsSchema("image version", util.TimeoutConfigs{
+		Create:        true,
+		CreateDefault: util.DefaultImageVersionCreateTimeout,
+		CreateMin:     5,
+
+		Delete:        true,
+		DeleteDefault: util.DefaultImageVersionDeleteTimeout,
+		DeleteMin:     5,
+	})
+}
+
+func (ImageVersionTimeouts) GetAttributes() map[string]schema.Attribute {
+	return ImageVersionTimeouts{}.GetSchema().Attributes
+}
+
+type AzureGalleryImageModel struct {
+	Gallery    types.String `tfsdk:"gallery"`
+	Definition types.String `tfsdk:"definition"`
+	Version    types.String `tfsdk:"version"`
+}
+
+func (AzureGalleryImageModel) GetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Details of the Azure Compute Gallery image used to prepare the image version.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"gallery": schema.StringAttribute{
+				Description: "The Azure Compute Gallery where the image is located.",
+				Required:    true,
+			},
+			"definition": schema.StringAttribute{
+				Description: "The image definition of the image in the Azure Compute Gallery.",
+				Required:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The image version of the image in the Azure Compute Gallery.",
+				Required:    true,
+			},
+		},
+		Validators: []validator.Object{
+			objectvalidator.ExactlyOneOf(path.Expressions{
+				path.MatchRelative().AtParent().AtName("master_image"),
+			}...),
+		},
+	}
+}
+
+func (AzureGalleryImageModel) GetAttributes() map[string]schema.Attribute {
+	return AzureGalleryImageModel{}.GetSchema().Attributes
+}
+
+type AzureImageSpecsModel struct {
+	ServiceOffering    types.String `tfsdk:"service_offering"`
+	StorageType        types.String `tfsdk:"storage_type"`
+	LicenseType        types.String `tfsdk:"license_type"`
+	ResourceGroup      types.String `tfsdk:"resource_group"`
+	SharedSubscription types.String `tfsdk:"shared_subscription"`
+	MasterImage        types.String `tfsdk:"master_image"`
+	GalleryImage       types.Object `tfsdk:"gallery_image"` // AzureGalleryImageModel
+}
+
+func (AzureImageSpecsModel) GetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Specifications of an image version prepared on an Azure hypervisor.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"service_offering": schema.StringAttribute{
+				Description: "The Azure VM Sku used to prepare the image version.",
+				Required:    true,
+			},
+			"storage_type": schema.StringAttribute{
+				Description: "Storage account type used for the prepared image disk on Azure. Storage types include: `Standard_LRS`, `StandardSSD_LRS` and `Premium_LRS`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						util.StandardLRS,
+						util.StandardSSDLRS,
+						util.Premium_LRS,
+					),
+				},
+			},
+			"license_type": schema.StringAttribute{
+				Description: "Windows license type used to prepare the image version in Azure. License types include: `Windows_Client` and `Windows_Server`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						util.WindowsClientLicenseType,
+						util.WindowsServerLicenseType,
+					),
+				},
+			},
+			"resource_group": schema.StringAttribute{
+				Description: "The Azure Resource Group where the managed disk / snapshot / gallery of the master image is located.",
+				Required:    true,
+			},
+			"shared_subscription": schema.StringAttribute{
+				Description: "The Azure Subscription ID where the master image is located. Only required if the image is not in the same subscription of the hypervisor.",
+				Optional:    true,
+			},
+			"master_image": schema.StringAttribute{
+				Description: "The name of the managed disk or snapshot used as master image. Omit this field if you want to use `gallery_image`.",
+				Optional:    true,
+			},
+			"gallery_image": AzureGalleryImageModel{}.GetSchema(),
+		},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.Object{
+			objectvalidator.ExactlyOneOf(path.Expressions{
+				path.MatchRelative().AtParent().AtName("vsphere_image_specs"),
+			}...),
+		},
+	}
+}
+
+func (AzureImageSpecsModel) GetAttributes() map[string]schema.Attribute {
+	return AzureImageSpecsModel{}.GetSchema().Attributes
+}
+
+type VsphereImageSpecsModel struct {
+	MasterImageVm types.String `tfsdk:"master_image_vm"`
+	ImageSnapshot types.String `tfsdk:"image_snapshot"`
+	CpuCount      types.Int64  `tfsdk:"cpu_count"`
+	MemoryMB      types.Int64  `tfsdk:"memory_mb"`
+}
+
+func (VsphereImageSpecsModel) GetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Specifications of an image version prepared on a vSphere hypervisor.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"master_image_vm": schema.StringAttribute{
+				Description: "The name of the virtual machine that will be used as master image.",
+				Required:    true,
+			},
+			"image_snapshot": schema.StringAttribute{
+				Description: "The Snapshot of the virtual machine specified in `master_image_vm`. Specify the relative path of the snapshot. Eg: snaphost-1/snapshot-2/snapshot-3. This property is case sensitive.",
+				Optional:    true,
+			},
+			"cpu_count": schema.Int64Attribute{
+				Description: "The number of processors of the machine used to prepare the image version.",
+				Required:    true,
+			},
+			"memory_mb": schema.Int64Attribute{
+				Description: "The maximum amount of memory of the machine used to prepare the image version.",
+				Required:    true,
+			},
+		},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func (VsphereImageSpecsModel) GetAttributes() map[string]schema.Attribute {
+	return VsphereImageSpecsModel{}.GetSchema().Attributes
+}
+
+// ImageVersionResourceModel maps the resource schema data.
+type ImageVersionResourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	ImageDefinition        types.String `tfsdk:"image_definition"`
+	VersionNumber          types.Int64  `tfsdk:"version_number"`
+	Description            types.String `tfsdk:"description"`
+	Hypervisor             types.String `tfsdk:"hypervisor"`
+	HypervisorResourcePool types.String `tfsdk:"hypervisor_resource_pool"`
+	Status                 types.String `tfsdk:"status"`
+	AzureImageSpecs        types.Object `tfsdk:"azure_image_specs"`   // AzureImageSpecsModel
+	VsphereImageSpecs      types.Object `tfsdk:"vsphere_image_specs"` // VsphereImageSpecsModel
+	Timeouts               types.Object `tfsdk:"timeouts"`            // ImageVersionTimeouts
+	Site                   types.String `tfsdk:"site"`
+}
+
+func (ImageVersionResourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		Description: "CVAD --- Manages an image version of an image definition. The image version is prepared once on the specified hypervisor resource pool and can be used by multiple machine catalogs through their `prepared_image` attribute. " +
+			"Changes to the image specifications replace the image version; use `create_before_destroy` so that the linked machine catalogs are updated to the new image version before the previous one is deleted.",
+		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the image version.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image_definition": schema.StringAttribute{
+				Description: "Id of the image definition the image version belongs to.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version_number": schema.Int64Attribute{
+				Description: "Version number of the image version within the image definition.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the image version.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"hypervisor": schema.StringAttribute{
+				Description: "Id of the hypervisor on which the image version is prepared.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hypervisor_resource_pool": schema.StringAttribute{
+				Description: "Id of the hypervisor resource pool on which the image version is prepared.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Preparation status of the image version.",
+				Computed:    true,
+			},
+			"azure_image_specs":   AzureImageSpecsModel{}.GetSchema(),
+			"vsphere_image_specs": VsphereImageSpecsModel{}.GetSchema(),
+			"timeouts":            ImageVersionTimeouts{}.GetSchema(),
+		},
+	}
+}
+
+func (ImageVersionResourceModel) GetAttributes() map[string]schema.Attribute {
+	return ImageVersionResourceModel{}.GetSchema().Attributes
+}
+
+func (r ImageVersionResourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, imageVersion *citrixorchestration.ImageVersionResponseModel) ImageVersionResourceModel {
+	r.Id = types.StringValue(imageVersion.GetId())
+	imageDefinition := imageVersion.GetImageDefinition()
+	r.ImageDefinition = types.StringValue(imageDefinition.GetId())
+	r.VersionNumber = types.Int64Value(int64(imageVersion.GetNumber()))
+	r.Description = types.StringValue(imageVersion.GetDescription())
+	r.Status = types.StringValue(string(imageVersion.GetImageVersionStatus()))
+
+	return r
+}
@@ -209,7 +209,7 @@ func checkIfProvSchemeIsCloudOnly(ctx context.Context, diagnostics *diag.Diagnos
 func generateBatchApiHeaders(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, provisioningSchemePlan ProvisioningSchemeModel, generateCredentialHeader bool) ([]citrixorchestration.NameValueStringPairModel, *http.Response, error) {
 	headers := []citrixorchestration.NameValueStringPairModel{}
 
-	cwsAuthToken, httpResp, err := client.SignIn()
+	cwsAuthToken, httpResp, err := util.SignIn(client)
 	var token string
 	if err != nil {
 		return headers, httpResp, err
@@ -234,14 +234,17 @@ func generateBatchApiHeaders(ctx context.Context, diagnostics *diag.Diagnostics,
 	return headers, httpResp, err
 }
 
+// Fields of the machine catalog read by the resource and the data source
+const machineCatalogFields = "Id,Name,Description,ProvisioningType,Zone,AllocationType,SessionSupport,TotalCount,HypervisorConnection,ProvisioningScheme,RemotePCEnrollmentScopes,IsPowerManaged,MinimumFunctionalLevel,IsRemotePC"
+
 func readMachineCatalog(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.ReadResponse, machineCatalogId string) (*citrixorchestration.MachineCatalogDetailResponseModel, *http.Response, error) {
-	getMachineCatalogRequest := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalog(ctx, machineCatalogId).Fields("Id,Name,Description,ProvisioningType,Zone,AllocationType,SessionSupport,TotalCount,HypervisorConnection,ProvisioningScheme,RemotePCEnrollmentScopes,IsPowerManaged,MinimumFunctionalLevel,IsRemotePC")
+	getMachineCatalogRequest := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalog(ctx, machineCatalogId).Fields(machineCatalogFields)
 	catalog, httpResp, err := util.ReadResource[*citrixorchestration.MachineCatalogDetailResponseModel](getMachineCatalogRequest, ctx, client, resp, "Machine Catalog", machineCatalogId)
 
 	return catalog, httpResp, err
 }
 
-func deleteMachinesFromCatalog(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.UpdateResponse, provisioningSchemePlan ProvisioningSchemeModel, machinesToDelete []citrixorchestration.MachineResponseModel, catalogNameOrId string, isMcsOrPvsCatalog bool) error {
+func deleteMachinesFromCatalog(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.UpdateResponse, provisioningSchemePlan ProvisioningSchemeModel, machinesToDelete []citrixorchestration.MachineResponseModel, catalogNameOrId string, deleteVm bool, deleteAccountOption citrixorchestration.MachineAccountDeleteOption) error {
 	batchApiHeaders, httpResp, err := generateBatchApiHeaders(ctx, &resp.Diagnostics, client, provisioningSchemePlan, false)
 	txId := citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)
 	if err != nil {
@@ -313,7 +316,8 @@ func deleteMachinesFromCatalog(ctx context.Context, client *citrixdaasclient.Cit
 		}
 	}
 
-	batchApiHeaders, httpResp, err = generateBatchApiHeaders(ctx, &resp.Diagnostics, client, provisioningSchemePlan, isMcsOrPvsCatalog)
+	// Admin credential is required to delete or disable the machine accounts
+	batchApiHeaders, httpResp, err = generateBatchApiHeaders(ctx, &resp.Diagnostics, client, provisioningSchemePlan, deleteAccountOption != citrixorchestration.MACHINEACCOUNTDELETEOPTION_LEAVE)
 	txId = citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -324,14 +328,10 @@ func deleteMachinesFromCatalog(ctx context.Context, client *citrixdaasclient.Cit
 		return err
 	}
 
-	deleteAccountOpion := "Leave"
-	if isMcsOrPvsCatalog {
-		deleteAccountOpion = "Delete"
-	}
 	batchRequestItems = []citrixorchestration.BatchRequestItemModel{}
 	for index, machineToDelete := range machinesToDelete {
 		var batchRequestItem citrixorchestration.BatchRequestItemModel
-		relativeUrl := fmt.Sprintf("/Machines/%s?deleteVm=%t&purgeDBOnly=false&deleteAccount=%s&async=true", machineToDelete.GetId(), isMcsOrPvsCatalog, deleteAccountOpion)
+		relativeUrl := fmt.Sprintf("/Machines/%s?deleteVm=%t&purgeDBOnly=false&deleteAccount=%s&async=true", machineToDelete.GetId(), deleteVm, deleteAccountOption)
 		batchRequestItem.SetReference(strconv.Itoa(index))
 		batchRequestItem.SetMethod(http.MethodDelete)
 		batchRequestItem.SetHeaders(batchApiHeaders)
@@ -448,3 +448,226 @@ func checkIfCatalogAttributeCanBeUpdated(ctx context.Context, state tfsdk.State)
 
 	return true
 }
+
+// checkPendingCatalogCreation checks on the job creating the catalog when it was still running at the time the state was saved.
+// It returns true and adds an error when the job is still running, so that the catalog is not updated or deleted before it is created.
+func checkPendingCatalogCreation(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, requestPrivate util.PrivateState, responsePrivate util.PrivateState, state MachineCatalogResourceModel) bool {
+	pendingJob := util.GetPendingAsyncJob(ctx, diagnostics, requestPrivate)
+	if pendingJob == nil || pendingJob.Operation != util.PendingAsyncJobOperationCreate {
+		return false
+	}
+
+	catalogName := state.Name.ValueString()
+	jobPending, _ := util.ResumePendingAsyncJob(ctx, client, *pendingJob, "Pending create job for Machine Catalog "+catalogName, diagnostics, responsePrivate)
+	if jobPending {
+		diagnostics.AddError(
+			"Machine Catalog "+catalogName+" creation is still in progress",
+			"TransactionId: "+pendingJob.TransactionId+
+				"\nJobId: "+pendingJob.JobId+
+				"\nError message: The machine catalog cannot be changed until the job creating it completes. Retry once the job has completed.",
+		)
+	}
+
+	return jobPending
+}
+
+// findMachineInCatalog finds a machine of the catalog by its name, with or without the domain.
+func findMachineInCatalog(machines []citrixorchestration.MachineResponseModel, machineName string) (citrixorchestration.MachineResponseModel, bool) {
+	for _, machine := range machines {
+		remoteMachineName := machine.GetName()
+		if strings.EqualFold(remoteMachineName, machineName) {
+			return machine, true
+		}
+
+		nameSegments := strings.Split(remoteMachineName, "\\")
+		if strings.EqualFold(nameSegments[len(nameSegments)-1], machineName) {
+			return machine, true
+		}
+	}
+
+	return citrixorchestration.MachineResponseModel{}, false
+}
+
+func tagsMatch(expectedTags []string, actualTags []string) bool {
+	if len(expectedTags) != len(actualTags) {
+		return false
+	}
+
+	for _, expectedTag := range expectedTags {
+		if !slices.ContainsFunc(actualTags, func(actualTag string) bool {
+			return strings.EqualFold(expectedTag, actualTag)
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r MachineCatalogResourceModel) updateCatalogWithMachineSettings(ctx context.Context, diagnostics *diag.Diagnostics, machines *citrixorchestration.MachineResponseModelCollection) MachineCatalogResourceModel {
+	if r.MachineSettings.IsNull() || r.MachineSettings.IsUnknown() {
+		return r
+	}
+
+	machineSettings := util.ObjectMapToTypedMap[MachineSettingsModel](ctx, diagnostics, r.MachineSettings)
+	for machineName, settings := range machineSettings {
+		machine, exists := findMachineInCatalog(machines.GetItems(), machineName)
+		if !exists {
+			// Keep the configured settings, the missing machine is reported when the settings are applied
+			continue
+		}
+
+		if !settings.InMaintenanceMode.IsNull() {
+			settings.InMaintenanceMode = types.BoolValue(machine.GetInMaintenanceMode())
+		}
+
+		if !settings.PowerState.IsNull() {
+			// Only refresh settled power states, so that machines that are still turning on or off are not reported as changed
+			powerState := machine.GetPowerState()
+			if powerState == citrixorchestration.POWERSTATE_ON || powerState == citrixorchestration.POWERSTATE_OFF {
+				settings.PowerState = types.StringValue(string(powerState))
+			}
+		}
+
+		if !settings.Tags.IsNull() {
+			configuredTags := util.StringSetToStringArray(ctx, diagnostics, settings.Tags)
+			if !tagsMatch(configuredTags, machine.GetTags()) {
+				settings.Tags = util.StringArrayToStringSet(ctx, diagnostics, machine.GetTags())
+			}
+		}
+
+		machineSettings[machineName] = settings
+	}
+
+	r.MachineSettings = util.TypedMapToObjectMap(ctx, diagnostics, machineSettings)
+	return r
+}
+
+// applyMachineSettings puts the machines of the catalog into the planned maintenance mode, power state and tags with a single batch request.
+// Returns true if any of the machines were updated.
+func applyMachineSettings(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, plan MachineCatalogResourceModel, machines *citrixorchestration.MachineResponseModelCollection) (bool, error) {
+	if plan.MachineSettings.IsNull() {
+		return false, nil
+	}
+
+	catalogName := plan.Name.ValueString()
+	machineSettings := util.ObjectMapToTypedMap[MachineSettingsModel](ctx, diagnostics, plan.MachineSettings)
+
+	missingMachines := []string{}
+	for machineName := range machineSettings {
+		if _, exists := findMachineInCatalog(machines.GetItems(), machineName); !exists {
+			missingMachines = append(missingMachines, machineName)
+		}
+	}
+
+	if len(missingMachines) > 0 {
+		slices.Sort(missingMachines)
+		err := fmt.Errorf("The following machines in machine_settings are not part of the machine catalog: " + strings.Join(missingMachines, ", "))
+		diagnostics.AddError(
+			"Error applying machine settings for Machine Catalog "+catalogName,
+			err.Error(),
+		)
+		return false, err
+	}
+
+	batchApiHeaders, httpResp, err := generateBatchApiHeaders(ctx, diagnostics, client, ProvisioningSchemeModel{}, false)
+	txId := citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)
+	if err != nil {
+		diagnostics.AddError(
+			"Error applying machine settings for Machine Catalog "+catalogName,
+			"TransactionId: "+txId+
+				"\nCould not update machine(s), unexpected error: "+util.ReadClientError(err),
+		)
+		return false, err
+	}
+
+	batchRequestItems := []citrixorchestration.BatchRequestItemModel{}
+	addBatchRequestItem := func(method string, relativeUrl string, body string) {
+		var batchRequestItem citrixorchestration.BatchRequestItemModel
+		batchRequestItem.SetReference(strconv.Itoa(len(batchRequestItems)))
+		batchRequestItem.SetMethod(method)
+		batchRequestItem.SetRelativeUrl(client.GetBatchRequestItemRelativeUrl(relativeUrl))
+		if body != "" {
+			batchRequestItem.SetBody(body)
+		}
+		batchRequestItem.SetHeaders(batchApiHeaders)
+		batchRequestItems = append(batchRequestItems, batchRequestItem)
+	}
+
+	for machineName, settings := range machineSettings {
+		machine, _ := findMachineInCatalog(machines.GetItems(), machineName)
+		machineId := machine.GetId()
+
+		if !settings.InMaintenanceMode.IsNull() && settings.InMaintenanceMode.ValueBool() != machine.GetInMaintenanceMode() {
+			var updateMachineModel citrixorchestration.UpdateMachineRequestModel
+			updateMachineModel.SetInMaintenanceMode(settings.InMaintenanceMode.ValueBool())
+			updateMachineStringBody, err := util.ConvertToString(updateMachineModel)
+			if err != nil {
+				diagnostics.AddError(
+					"Error applying machine settings for machine "+machineName,
+					"An unexpected error occurred: "+err.Error(),
+				)
+				return false, err
+			}
+			addBatchRequestItem(http.MethodPatch, fmt.Sprintf("/Machines/%s?async=true", machineId), updateMachineStringBody)
+		}
+
+		if !settings.Tags.IsNull() {
+			tags := util.StringSetToStringArray(ctx, diagnostics, settings.Tags)
+			if !tagsMatch(tags, machine.GetTags()) {
+				var tagsRequestModel citrixorchestration.TagsRequestModel
+				tagsRequestModel.SetItems(tags)
+				tagsStringBody, err := util.ConvertToString(tagsRequestModel)
+				if err != nil {
+					diagnostics.AddError(
+						"Error applying machine settings for machine "+machineName,
+						"An unexpected error occurred: "+err.Error(),
+					)
+					return false, err
+				}
+				addBatchRequestItem(http.MethodPut, fmt.Sprintf("/Machines/%s/Tags?async=true", machineId), tagsStringBody)
+			}
+		}
+
+		powerState := machine.GetPowerState()
+		switch settings.PowerState.ValueString() {
+		case string(citrixorchestration.POWERSTATE_ON):
+			if powerState != citrixorchestration.POWERSTATE_ON && powerState != citrixorchestration.POWERSTATE_TURNING_ON {
+				addBatchRequestItem(http.MethodPost, fmt.Sprintf("/Machines/%s/$start?async=true", machineId), "")
+			}
+		case string(citrixorchestration.POWERSTATE_OFF):
+			if powerState != citrixorchestration.POWERSTATE_OFF && powerState != citrixorchestration.POWERSTATE_TURNING_OFF {
+				addBatchRequestItem(http.MethodPost, fmt.Sprintf("/Machines/%s/$shutdown?async=true", machineId), "")
+			}
+		}
+	}
+
+	if len(batchRequestItems) == 0 {
+		return false, nil
+	}
+
+	var batchRequestModel citrixorchestration.BatchRequestModel
+	batchRequestModel.SetItems(batchRequestItems)
+	successfulJobs, txId, err := citrixdaasclient.PerformBatchOperation(ctx, client, batchRequestModel)
+	if err != nil {
+		diagnostics.AddError(
+			"Error applying machine settings for Machine Catalog "+catalogName,
+			"TransactionId: "+txId+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return true, err
+	}
+
+	if successfulJobs < len(batchRequestItems) {
+		errMsg := fmt.Sprintf("An error occurred while applying machine settings. %d of %d machine updates were successful.", successfulJobs, len(batchRequestItems))
+		err = fmt.Errorf(errMsg)
+		diagnostics.AddError(
+			"Error applying machine settings for Machine Catalog "+catalogName,
+			"TransactionId: "+txId+
+				"\n"+errMsg,
+		)
+		return true, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,83 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testPrivateState struct {
+	values map[string][]byte
+}
+
+func (p *testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p.values[key], nil
+}
+
+func (p *testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p.values, key)
+		return nil
+	}
+	p.values[key] = value
+	return nil
+}
+
+func newTestPendingJobPrivateState(t *testing.T, operation string) *testPrivateState {
+	value, err := json.Marshal(util.PendingAsyncJob{JobId: "job-id", TransactionId: "transaction-id", Operation: operation})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return &testPrivateState{values: map[string][]byte{util.PendingAsyncJobPrivateStateKey: value}}
+}
+
+func newTestJob(status citrixorchestration.JobStatus) citrixorchestration.JobResponseModel {
+	job := citrixorchestration.JobResponseModel{}
+	job.SetId("job-id")
+	job.SetType(citrixorchestration.JOBTYPE_CREATE_MACHINE_CATALOG)
+	job.SetStatus(status)
+	return job
+}
+
+func TestCheckPendingCatalogCreation(t *testing.T) {
+	state := MachineCatalogResourceModel{Id: types.StringNull(), Name: types.StringValue("catalog")}
+
+	tests := []struct {
+		name               string
+		operation          string
+		jobStatus          citrixorchestration.JobStatus
+		expectPending      bool
+		expectJobIsTracked bool
+	}{
+		{name: "CreateInProgress", operation: util.PendingAsyncJobOperationCreate, jobStatus: citrixorchestration.JOBSTATUS_IN_PROGRESS, expectPending: true, expectJobIsTracked: true},
+		{name: "CreateComplete", operation: util.PendingAsyncJobOperationCreate, jobStatus: citrixorchestration.JOBSTATUS_COMPLETE},
+		{name: "CreateFailed", operation: util.PendingAsyncJobOperationCreate, jobStatus: citrixorchestration.JOBSTATUS_FAILED},
+		{name: "UpdateInProgress", operation: util.PendingAsyncJobOperationUpdate, jobStatus: citrixorchestration.JOBSTATUS_IN_PROGRESS, expectJobIsTracked: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newTestClient(t, []citrixorchestration.JobResponseModel{newTestJob(test.jobStatus)})
+			privateState := newTestPendingJobPrivateState(t, test.operation)
+			diagnostics := diag.Diagnostics{}
+
+			jobPending := checkPendingCatalogCreation(context.Background(), client, &diagnostics, privateState, privateState, state)
+			if jobPending != test.expectPending {
+				t.Errorf("expected pending to be %t, got %t", test.expectPending, jobPending)
+			}
+			if diagnostics.HasError() != test.expectPending {
+				t.Errorf("expected an error only while the catalog creation is pending, got diagnostics %v", diagnostics)
+			}
+			if jobIsTracked := util.GetPendingAsyncJob(context.Background(), &diagnostics, privateState) != nil; jobIsTracked != test.expectJobIsTracked {
+				t.Errorf("expected job to be tracked to be %t, got %t", test.expectJobIsTracked, jobIsTracked)
+			}
+		})
+	}
+}
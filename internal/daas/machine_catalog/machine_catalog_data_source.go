@@ -5,6 +5,7 @@ package machine_catalog
 import (
 	"context"
 
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 
@@ -45,6 +46,11 @@ func (d *MachineCatalogDataSource) Configure(ctx context.Context, req datasource
 func (d *MachineCatalogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if d.client != nil && d.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -61,7 +67,7 @@ func (d *MachineCatalogDataSource) Read(ctx context.Context, req datasource.Read
 
 	// Get refreshed machine catalog state from Orchestration
 	machineCatalogName := data.Name.ValueString()
-	getMachineCatalogRequest := d.client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalog(ctx, machineCatalogName).Fields("Id,Name,Description,ProvisioningType,Zone,AllocationType,SessionSupport,TotalCount,HypervisorConnection,ProvisioningScheme,RemotePCEnrollmentScopes,IsPowerManaged,MinimumFunctionalLevel,IsRemotePC")
+	getMachineCatalogRequest := d.client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalog(ctx, machineCatalogName).Fields(machineCatalogFields)
 	machineCatalog, httpResp, err := citrixdaasclient.AddRequestData(getMachineCatalogRequest, d.client).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -69,21 +75,33 @@ func (d *MachineCatalogDataSource) Read(ctx context.Context, req datasource.Read
 			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 				"\nError message: "+util.ReadClientError(err),
 		)
+		return
 	}
 
 	// Get VDAs associated with the machine catalog
-	getMachineCatalogMachinesRequest := d.client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalogMachines(ctx, machineCatalogName)
-	machineCatalogVdas, httpResp, err := citrixdaasclient.AddRequestData(getMachineCatalogMachinesRequest, d.client).Execute()
-
+	machineCatalogVdas, err := util.GetMachineCatalogMachines(ctx, d.client, &resp.Diagnostics, machineCatalog.GetId())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing VDAs in Machine Catalog "+machineCatalogName,
-			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
-				"\nError message: "+util.ReadClientError(err),
-		)
+		return
+	}
+
+	// Resolve the hypervisor of the provisioning scheme in the same way as the machine catalog resource
+	provScheme := machineCatalog.GetProvisioningScheme()
+	resourcePool := provScheme.GetResourcePool()
+	hypervisor := resourcePool.GetHypervisor()
+	hypervisorName := hypervisor.GetName()
+
+	var connectionType *citrixorchestration.HypervisorConnectionType
+	var pluginId string
+	if hypervisorName != "" {
+		hypervisor, err := util.GetHypervisor(ctx, d.client, &resp.Diagnostics, hypervisorName)
+		if err != nil {
+			return
+		}
+		connectionType = hypervisor.GetConnectionType().Ptr()
+		pluginId = hypervisor.GetPluginId()
 	}
 
-	data = data.RefreshPropertyValues(machineCatalog, machineCatalogVdas)
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, d.client, machineCatalog, connectionType, machineCatalogVdas, pluginId)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
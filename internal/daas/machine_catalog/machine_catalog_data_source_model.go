@@ -3,60 +3,113 @@
 package machine_catalog
 
 import (
+	"context"
+
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixclient "github.com/citrix/citrix-daas-rest-go/client"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/vda"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // MachineCatalogDataSourceModel defines the Machine Catalog data source implementation.
 type MachineCatalogDataSourceModel struct {
-	Id   types.String   `tfsdk:"id"`
-	Name types.String   `tfsdk:"name"`
-	Vdas []vda.VdaModel `tfsdk:"vdas"` // List[VdaModel]
+	Id                     types.String   `tfsdk:"id"`
+	Name                   types.String   `tfsdk:"name"`
+	Description            types.String   `tfsdk:"description"`
+	IsPowerManaged         types.Bool     `tfsdk:"is_power_managed"`
+	IsRemotePc             types.Bool     `tfsdk:"is_remote_pc"`
+	AllocationType         types.String   `tfsdk:"allocation_type"`
+	SessionSupport         types.String   `tfsdk:"session_support"`
+	Zone                   types.String   `tfsdk:"zone"`
+	VdaUpgradeType         types.String   `tfsdk:"vda_upgrade_type"`
+	ProvisioningType       types.String   `tfsdk:"provisioning_type"`
+	ProvisioningScheme     types.Object   `tfsdk:"provisioning_scheme"` // ProvisioningSchemeModel without sensitive attributes
+	MachineAccounts        types.List     `tfsdk:"machine_accounts"`    // List[MachineAccountsModel]
+	RemotePcOus            types.List     `tfsdk:"remote_pc_ous"`       // List[RemotePcOuModel]
+	MinimumFunctionalLevel types.String   `tfsdk:"minimum_functional_level"`
+	Scopes                 types.Set      `tfsdk:"scopes"` // Set[String]
+	Vdas                   []vda.VdaModel `tfsdk:"vdas"`   // List[VdaModel]
+	Site                   types.String   `tfsdk:"site"`
 }
 
 func (MachineCatalogDataSourceModel) GetSchema() schema.Schema {
+	// Expose the same attributes as the machine catalog resource, except for the sensitive domain credentials
+	resourceAttributes := MachineCatalogResourceModel{}.GetSchema().Attributes
+	delete(resourceAttributes, "id")
+	delete(resourceAttributes, "name")
+	delete(resourceAttributes, "site")
+	delete(resourceAttributes, "timeouts")
+	// Machine settings are exposed through the VDAs of the machine catalog
+	delete(resourceAttributes, "machine_settings")
+
+	attributes := util.ResourceAttributesToDataSourceAttributes(resourceAttributes)
+	if provisioningScheme, ok := attributes["provisioning_scheme"].(schema.SingleNestedAttribute); ok {
+		// Image rollback, image rollout and scale-in only apply when updating the machine catalog resource
+		delete(provisioningScheme.Attributes, "rollback_to_previous_image")
+		delete(provisioningScheme.Attributes, "image_rollout_strategy")
+		delete(provisioningScheme.Attributes, "scale_in_policy")
+	}
+	attributes["site"] = util.GetSiteDataSourceSchema()
+	attributes["id"] = schema.StringAttribute{
+		Description: "GUID identifier of the machine catalog.",
+		Computed:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "Name of the machine catalog.",
+		Required:    true,
+	}
+	attributes["vdas"] = schema.ListNestedAttribute{
+		Description:  "The VDAs associated with the machine catalog.",
+		Computed:     true,
+		NestedObject: vda.VdaModel{}.GetSchema(),
+	}
+
 	return schema.Schema{
-		Description: "CVAD --- Read data of an existing machine catalog.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "GUID identifier of the machine catalog.",
-				Computed:    true,
-			},
-			"name": schema.StringAttribute{
-				Description: "Name of the machine catalog.",
-				Required:    true,
-			},
-			"vdas": schema.ListNestedAttribute{
-				Description:  "The VDAs associated with the machine catalog.",
-				Computed:     true,
-				NestedObject: vda.VdaModel{}.GetSchema(),
-			},
-		},
+		Description: "CVAD --- Read data of an existing machine catalog, including its provisioning scheme. Sensitive attributes of the machine catalog resource, such as the domain service account password, are not exposed.",
+		Attributes:  attributes,
 	}
 }
 
-func (r MachineCatalogDataSourceModel) RefreshPropertyValues(catalog *citrixorchestration.MachineCatalogDetailResponseModel, vdas *citrixorchestration.MachineResponseModelCollection) MachineCatalogDataSourceModel {
-	r.Id = types.StringValue(catalog.GetId())
-	r.Name = types.StringValue(catalog.GetName())
+func (r MachineCatalogDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixclient.CitrixDaasClient, catalog *citrixorchestration.MachineCatalogDetailResponseModel, connectionType *citrixorchestration.HypervisorConnectionType, vdas *citrixorchestration.MachineResponseModelCollection, pluginId string) MachineCatalogDataSourceModel {
+	// Refresh the machine catalog in the same way as an imported machine catalog resource, so that the data source has the same values as the resource
+	catalogModel := util.NewImportedResourceModel[MachineCatalogResourceModel](ctx, diagnostics, MachineCatalogResourceModel{}.GetSchema(), map[string]any{
+		"id": catalog.GetId(),
+	})
+	if diagnostics.HasError() {
+		return r
+	}
+	catalogModel = catalogModel.RefreshPropertyValues(ctx, diagnostics, client, catalog, connectionType, vdas, pluginId)
+
+	r.Id = catalogModel.Id
+	r.Name = catalogModel.Name
+	r.Description = catalogModel.Description
+	r.IsPowerManaged = catalogModel.IsPowerManaged
+	r.IsRemotePc = catalogModel.IsRemotePc
+	r.AllocationType = catalogModel.AllocationType
+	r.SessionSupport = catalogModel.SessionSupport
+	r.Zone = catalogModel.Zone
+	r.VdaUpgradeType = catalogModel.VdaUpgradeType
+	r.ProvisioningType = catalogModel.ProvisioningType
+	r.MinimumFunctionalLevel = catalogModel.MinimumFunctionalLevel
+	r.Scopes = catalogModel.Scopes
+
+	dataSourceAttributes := r.GetSchema().Attributes
+	if provisioningScheme, ok := util.ResourceValueToDataSourceValue(ctx, diagnostics, catalogModel.ProvisioningScheme, dataSourceAttributes["provisioning_scheme"].GetType()).(types.Object); ok {
+		r.ProvisioningScheme = provisioningScheme
+	}
+	if machineAccounts, ok := util.ResourceValueToDataSourceValue(ctx, diagnostics, catalogModel.MachineAccounts, dataSourceAttributes["machine_accounts"].GetType()).(types.List); ok {
+		r.MachineAccounts = machineAccounts
+	}
+	if remotePcOus, ok := util.ResourceValueToDataSourceValue(ctx, diagnostics, catalogModel.RemotePcOus, dataSourceAttributes["remote_pc_ous"].GetType()).(types.List); ok {
+		r.RemotePcOus = remotePcOus
+	}
 
 	res := []vda.VdaModel{}
 	for _, model := range vdas.GetItems() {
-		machineName := model.GetName()
-		hosting := model.GetHosting()
-		hostedMachineId := hosting.GetHostedMachineId()
-		machineCatalog := model.GetMachineCatalog()
-		machineCatalogId := machineCatalog.GetId()
-		deliveryGroup := model.GetDeliveryGroup()
-		deliveryGroupId := deliveryGroup.GetId()
-
-		res = append(res, vda.VdaModel{
-			MachineName:              types.StringValue(machineName),
-			HostedMachineId:          types.StringValue(hostedMachineId),
-			AssociatedMachineCatalog: types.StringValue(machineCatalogId),
-			AssociatedDeliveryGroup:  types.StringValue(deliveryGroupId),
-		})
+		res = append(res, vda.NewVdaModel(ctx, diagnostics, model))
 	}
 
 	r.Vdas = res
@@ -186,7 +186,7 @@ func deleteMachinesFromManualCatalog(ctx context.Context, client *citrixdaasclie
 		}
 	}
 
-	return deleteMachinesFromCatalog(ctx, client, resp, ProvisioningSchemeModel{}, machinesToDelete, catalogNameOrId, false)
+	return deleteMachinesFromCatalog(ctx, client, resp, ProvisioningSchemeModel{}, machinesToDelete, catalogNameOrId, false, citrixorchestration.MACHINEACCOUNTDELETEOPTION_LEAVE)
 }
 
 func addMachinesToManualCatalog(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, resp *resource.UpdateResponse, addMachinesList []MachineAccountsModel, catalogIdOrName string) error {
@@ -6,9 +6,13 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"math"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
@@ -282,13 +286,15 @@ func buildProvSchemeForCatalog(ctx context.Context, client *citrixdaasclient.Cit
 		provisioningScheme.SetMemoryMB(int32(vSphereMachineConfig.MemoryMB.ValueInt64()))
 		provisioningScheme.SetCpuCount(int32(vSphereMachineConfig.CpuCount.ValueInt64()))
 
-		image := vSphereMachineConfig.MasterImageVm.ValueString()
-		snapshot := vSphereMachineConfig.ImageSnapshot.ValueString()
-		imagePath, err := getOnPremImagePath(ctx, client, diag, hypervisor.GetName(), hypervisorResourcePool.GetName(), image, snapshot, "creating")
-		if err != nil {
-			return nil, err
+		if provisioningSchemePlan.PreparedImage.IsNull() {
+			image := vSphereMachineConfig.MasterImageVm.ValueString()
+			snapshot := vSphereMachineConfig.ImageSnapshot.ValueString()
+			imagePath, err := getOnPremImagePath(ctx, client, diag, hypervisor.GetName(), hypervisorResourcePool.GetName(), image, snapshot, "creating")
+			if err != nil {
+				return nil, err
+			}
+			provisioningScheme.SetMasterImagePath(imagePath)
 		}
-		provisioningScheme.SetMasterImagePath(imagePath)
 
 		masterImageNote := vSphereMachineConfig.MasterImageNote.ValueString()
 		provisioningScheme.SetMasterImageNote(masterImageNote)
@@ -431,6 +437,16 @@ func buildProvSchemeForCatalog(ctx context.Context, client *citrixdaasclient.Cit
 		provisioningScheme.SetNetworkMapping(networkMapping)
 	}
 
+	// A prepared image version replaces the master image of the catalog
+	if !provisioningSchemePlan.PreparedImage.IsNull() {
+		preparedImage := util.ObjectValueToTypedObject[PreparedImageModel](ctx, diag, provisioningSchemePlan.PreparedImage)
+		var assignImageVersion citrixorchestration.AssignImageVersionToProvisioningSchemeRequestModel
+		assignImageVersion.SetImageDefinition(preparedImage.ImageDefinition.ValueString())
+		assignImageVersion.SetImageVersion(preparedImage.ImageVersion.ValueString())
+		provisioningScheme.SetAssignImageVersionToProvisioningScheme(assignImageVersion)
+		provisioningScheme.UnsetMasterImagePath()
+	}
+
 	return &provisioningScheme, nil
 }
 
@@ -665,32 +681,127 @@ func deleteMachinesFromMcsPvsCatalog(ctx context.Context, client *citrixdaasclie
 	}
 
 	machineDeleteRequestCount := int(catalog.GetTotalCount()) - int(provisioningSchemePlan.NumTotalMachines.ValueInt64())
+	scaleInPolicy := util.ObjectValueToTypedObject[ScaleInPolicyModel](ctx, &resp.Diagnostics, provisioningSchemePlan.ScaleInPolicy)
+
+	machinesToDelete, err := getMachinesToDeleteFromMcsPvsCatalog(ctx, &resp.Diagnostics, getMachinesResponse.GetItems(), machineDeleteRequestCount, scaleInPolicy)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting machine(s) from Machine Catalog "+catalogName,
+			err.Error(),
+		)
+
+		return err
+	}
+
+	deleteVm := true
+	deleteAccountOption := citrixorchestration.MACHINEACCOUNTDELETEOPTION_DELETE
+	if !provisioningSchemePlan.ScaleInPolicy.IsNull() {
+		deleteVm = scaleInPolicy.DeleteVm.ValueBool()
+		deleteAccountOption = citrixorchestration.MachineAccountDeleteOption(scaleInPolicy.MachineAccountAction.ValueString())
+	}
+
+	return deleteMachinesFromCatalog(ctx, client, resp, provisioningSchemePlan, machinesToDelete, catalogName, deleteVm, deleteAccountOption)
+}
+
+// getMachinesToDeleteFromMcsPvsCatalog chooses the machines removed from the catalog with the scale-in policy.
+// The machines listed in machines_to_remove are chosen first, the remaining machines are chosen in the order of the scale-in preferences.
+func getMachinesToDeleteFromMcsPvsCatalog(ctx context.Context, diagnostics *diag.Diagnostics, machines []citrixorchestration.MachineResponseModel, machineDeleteRequestCount int, scaleInPolicy ScaleInPolicyModel) ([]citrixorchestration.MachineResponseModel, error) {
+	removeMachinesWithSessions := scaleInPolicy.RemoveMachinesWithSessions.ValueBool()
 	machinesToDelete := []citrixorchestration.MachineResponseModel{}
+	remainingMachines := slices.Clone(machines)
+
+	if !scaleInPolicy.MachinesToRemove.IsNull() {
+		missingMachines := []string{}
+		for _, machineName := range util.StringListToStringArray(ctx, diagnostics, scaleInPolicy.MachinesToRemove) {
+			machine, exists := findMachineInCatalog(remainingMachines, machineName)
+			if !exists {
+				missingMachines = append(missingMachines, machineName)
+				continue
+			}
+
+			if machine.GetSessionCount() > 0 && !removeMachinesWithSessions {
+				return nil, fmt.Errorf("machine %s in machines_to_remove has %d active session(s). Set remove_machines_with_sessions to true to remove machines with active sessions", machine.GetName(), machine.GetSessionCount())
+			}
 
-	for _, machine := range getMachinesResponse.GetItems() {
-		if !machine.GetDeliveryGroup().Id.IsSet() || machine.GetSessionCount() == 0 {
 			machinesToDelete = append(machinesToDelete, machine)
+			remainingMachines = slices.DeleteFunc(remainingMachines, func(remainingMachine citrixorchestration.MachineResponseModel) bool {
+				return remainingMachine.GetId() == machine.GetId()
+			})
+		}
+
+		if len(machinesToDelete) > machineDeleteRequestCount {
+			return nil, fmt.Errorf("%d machine(s) in machines_to_remove are in the machine catalog, but only %d machine(s) are removed by number_of_total_machines", len(machinesToDelete), machineDeleteRequestCount)
 		}
 
+		if len(missingMachines) > 0 {
+			// Do not replace machines that are not found with other machines chosen by the scale-in policy
+			if len(machinesToDelete) < machineDeleteRequestCount {
+				return nil, fmt.Errorf("machine(s) %s in machines_to_remove are not found in the machine catalog. Correct the machine names, or remove them from machines_to_remove to let the scale-in policy choose the machines to remove", strings.Join(missingMachines, ", "))
+			}
+			diagnostics.AddWarning(
+				"Machines in machines_to_remove not found",
+				fmt.Sprintf("Machine(s) %s in machines_to_remove are not found in the machine catalog and are ignored.", strings.Join(missingMachines, ", ")),
+			)
+		}
+	}
+
+	preferences := []string{}
+	if !scaleInPolicy.Prefer.IsNull() {
+		preferences = util.StringListToStringArray(ctx, diagnostics, scaleInPolicy.Prefer)
+	}
+	sort.SliceStable(remainingMachines, func(i, j int) bool {
+		return compareMachinesForScaleIn(remainingMachines[i], remainingMachines[j], preferences) < 0
+	})
+
+	for _, machine := range remainingMachines {
 		if len(machinesToDelete) == machineDeleteRequestCount {
 			break
 		}
+
+		if machine.GetSessionCount() == 0 || removeMachinesWithSessions {
+			machinesToDelete = append(machinesToDelete, machine)
+		}
 	}
 
-	machinesToDeleteCount := len(machinesToDelete)
+	if machineDeleteRequestCount > len(machinesToDelete) {
+		return nil, fmt.Errorf("%d machine(s) requested to be deleted. %d machine(s) qualify for deletion. Ensure machine that needs to be deleted has no active sessions.", machineDeleteRequestCount, len(machinesToDelete))
+	}
 
-	if machineDeleteRequestCount > machinesToDeleteCount {
-		errorString := fmt.Sprintf("%d machine(s) requested to be deleted. %d machine(s) qualify for deletion.", machineDeleteRequestCount, machinesToDeleteCount)
+	return machinesToDelete, nil
+}
 
-		resp.Diagnostics.AddError(
-			"Error deleting machine(s) from Machine Catalog "+catalogName,
-			errorString+" Ensure machine that needs to be deleted has no active sessions.",
-		)
+// compareMachinesForScaleIn returns a negative number if machine a is preferred over machine b for removal, a positive number if machine b is preferred, and 0 otherwise.
+func compareMachinesForScaleIn(a citrixorchestration.MachineResponseModel, b citrixorchestration.MachineResponseModel, preferences []string) int {
+	compareBool := func(preferA bool, preferB bool) int {
+		if preferA == preferB {
+			return 0
+		}
+		if preferA {
+			return -1
+		}
+		return 1
+	}
 
-		return err
+	for _, preference := range preferences {
+		result := 0
+		switch preference {
+		case util.ScaleInPreferUnassigned:
+			result = compareBool(a.DeliveryGroup == nil && len(a.GetAssignedUsers()) == 0, b.DeliveryGroup == nil && len(b.GetAssignedUsers()) == 0)
+		case util.ScaleInPreferUnregistered:
+			result = compareBool(a.GetRegistrationState() != citrixorchestration.REGISTRATIONSTATE_REGISTERED, b.GetRegistrationState() != citrixorchestration.REGISTRATIONSTATE_REGISTERED)
+		case util.ScaleInPreferOldest:
+			// Machine Uids are assigned in ascending order when machines are added to the site
+			result = int(a.GetUid()) - int(b.GetUid())
+		case util.ScaleInPreferInMaintenanceMode:
+			result = compareBool(a.GetInMaintenanceMode(), b.GetInMaintenanceMode())
+		}
+
+		if result != 0 {
+			return result
+		}
 	}
 
-	return deleteMachinesFromCatalog(ctx, client, resp, provisioningSchemePlan, machinesToDelete, catalogName, true)
+	return 0
 }
 
 func addMachinesToMcsPvsCatalog(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.UpdateResponse, catalog *citrixorchestration.MachineCatalogDetailResponseModel, provisioningSchemePlan ProvisioningSchemeModel) error {
@@ -779,7 +890,7 @@ func addMachinesToMcsPvsCatalog(ctx context.Context, client *citrixdaasclient.Ci
 	return nil
 }
 
-func updateCatalogMachineProfile(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.UpdateResponse, catalog *citrixorchestration.MachineCatalogDetailResponseModel, machineProfilePath string) error {
+func updateCatalogMachineProfile(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.UpdateResponse, catalog *citrixorchestration.MachineCatalogDetailResponseModel, machineProfilePath string, maxTimeout int) error {
 	var body citrixorchestration.UpdateMachineCatalogRequestModel
 	body.SetMachineProfilePath(machineProfilePath)
 
@@ -795,7 +906,7 @@ func updateCatalogMachineProfile(ctx context.Context, client *citrixdaasclient.C
 		return err
 	}
 
-	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating machine profile for Machine Catalog "+catalog.GetName(), &resp.Diagnostics, 15, false)
+	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating machine profile for Machine Catalog "+catalog.GetName(), &resp.Diagnostics, maxTimeout, false)
 	if err != nil {
 		return err
 	}
@@ -815,6 +926,8 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 	machineProfile := provScheme.GetMachineProfile()
 
 	provisioningSchemePlan := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, &resp.Diagnostics, plan.ProvisioningScheme)
+	timeouts := util.ObjectValueToTypedObject[MachineCatalogTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	updateTimeout := util.GetTimeoutValue(timeouts.Update, util.DefaultMachineCatalogUpdateTimeout)
 
 	hypervisor, errResp := util.GetHypervisor(ctx, client, &resp.Diagnostics, provisioningSchemePlan.Hypervisor.ValueString())
 	if errResp != nil {
@@ -844,6 +957,7 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 		azureMachineConfigModel := util.ObjectValueToTypedObject[AzureMachineConfigModel](ctx, &resp.Diagnostics, provisioningSchemePlan.AzureMachineConfig)
 		azureMachineProfile := azureMachineConfigModel.MachineProfile
 		if !(*provisioningType == citrixorchestration.PROVISIONINGTYPE_PVS_STREAMING) {
+			// Master image is null when a prepared image is used, which leaves the image path empty
 			azureMasterImageModel := util.ObjectValueToTypedObject[AzureMasterImageModel](ctx, &resp.Diagnostics, azureMachineConfigModel.AzureMasterImage)
 			newImage := azureMasterImageModel.MasterImage.ValueString()
 			resourceGroup := azureMasterImageModel.ResourceGroup.ValueString()
@@ -1027,11 +1141,13 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 		}
 	case citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER:
 		vSphereMachineConfig := util.ObjectValueToTypedObject[VsphereMachineConfigModel](ctx, &resp.Diagnostics, provisioningSchemePlan.VsphereMachineConfig)
-		newImage := vSphereMachineConfig.MasterImageVm.ValueString()
-		snapshot := vSphereMachineConfig.ImageSnapshot.ValueString()
-		imagePath, err = getOnPremImagePath(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), newImage, snapshot, "updating")
-		if err != nil {
-			return err
+		if provisioningSchemePlan.PreparedImage.IsNull() {
+			newImage := vSphereMachineConfig.MasterImageVm.ValueString()
+			snapshot := vSphereMachineConfig.ImageSnapshot.ValueString()
+			imagePath, err = getOnPremImagePath(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), newImage, snapshot, "updating")
+			if err != nil {
+				return err
+			}
 		}
 
 		masterImageNote = vSphereMachineConfig.MasterImageNote.ValueString()
@@ -1134,7 +1250,7 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 	}
 
 	if machineProfile.GetXDPath() != machineProfilePath {
-		err = updateCatalogMachineProfile(ctx, client, resp, catalog, machineProfilePath)
+		err = updateCatalogMachineProfile(ctx, client, resp, catalog, machineProfilePath, updateTimeout)
 		if err != nil {
 			return err
 		}
@@ -1142,10 +1258,33 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 
 	// Updating image is not supported for PVSStreaming catalog
 	if !(*provisioningType == citrixorchestration.PROVISIONINGTYPE_PVS_STREAMING) {
-		if masterImage.GetXDPath() == imagePath && currentDiskImage.GetMasterImageNote() == masterImageNote {
+		var preparedImage *PreparedImageModel
+		if !provisioningSchemePlan.PreparedImage.IsNull() {
+			preparedImageModel := util.ObjectValueToTypedObject[PreparedImageModel](ctx, &resp.Diagnostics, provisioningSchemePlan.PreparedImage)
+			preparedImage = &preparedImageModel
+
+			currentImageVersion := provScheme.GetCurrentImageVersion()
+			imageVersion := currentImageVersion.GetImageVersion()
+			if strings.EqualFold(imageVersion.GetId(), preparedImage.ImageVersion.ValueString()) && currentDiskImage.GetMasterImageNote() == masterImageNote {
+				return nil
+			}
+		} else if masterImage.GetXDPath() == imagePath && currentDiskImage.GetMasterImageNote() == masterImageNote {
 			return nil
 		}
 
+		if preparedImage == nil && provisioningSchemePlan.RollbackToPreviousImage.ValueBool() && masterImage.GetXDPath() != imagePath {
+			imageHistory, err := getMachineCatalogImageHistory(ctx, client, &resp.Diagnostics, catalog)
+			if err != nil {
+				return err
+			}
+
+			// Roll back instead of pushing the previous image forward as a new image update
+			previousImage := getPreviousMasterImage(imageHistory)
+			if previousImage != nil && strings.EqualFold(previousImage.Image.GetXDPath(), imagePath) {
+				return rollbackCatalogImage(ctx, client, resp, catalog, rebootOption, updateTimeout)
+			}
+		}
+
 		// Update Master Image for Machine Catalog
 		var updateProvisioningSchemeModel citrixorchestration.UpdateMachineCatalogProvisioningSchemeRequestModel
 
@@ -1159,11 +1298,27 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 		}
 
 		updateProvisioningSchemeModel.SetMinimumFunctionalLevel(*functionalLevel)
-		updateProvisioningSchemeModel.SetMasterImagePath(imagePath)
+		if preparedImage != nil {
+			var assignImageVersion citrixorchestration.AssignImageVersionToProvisioningSchemeRequestModel
+			assignImageVersion.SetImageDefinition(preparedImage.ImageDefinition.ValueString())
+			assignImageVersion.SetImageVersion(preparedImage.ImageVersion.ValueString())
+			updateProvisioningSchemeModel.SetAssignImageVersionToProvisioningScheme(assignImageVersion)
+		} else {
+			updateProvisioningSchemeModel.SetMasterImagePath(imagePath)
+		}
 
 		updateProvisioningSchemeModel.SetStoreOldImage(true)
 
 		updateProvisioningSchemeModel.SetMasterImageNote(masterImageNote)
+
+		// For a staged rollout, the new image is applied on next shutdown and the machines are rebooted by the rollout instead
+		rolloutRebootOption := rebootOption
+		if !provisioningSchemePlan.ImageRolloutStrategy.IsNull() {
+			var noRebootOption citrixorchestration.RebootMachinesRequestModel
+			noRebootOption.SetRebootDuration(-1)
+			noRebootOption.SetWarningDuration(-1)
+			rebootOption = noRebootOption
+		}
 		updateProvisioningSchemeModel.SetRebootOptions(rebootOption)
 
 		if len(updateCustomProperties) > 0 {
@@ -1181,10 +1336,25 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 			)
 		}
 
-		err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating Image for Machine Catalog "+catalogName, &resp.Diagnostics, 60, false)
+		jobPending, err := util.ProcessAsyncJobResponseWithResume(ctx, client, httpResp, "Error updating Image for Machine Catalog "+catalogName, &resp.Diagnostics, updateTimeout, false, resp.Private, util.PendingAsyncJobOperationUpdate)
 		if err != nil {
 			return err
 		}
+
+		if jobPending {
+			// Keep the previous state until the image update job completes
+			errorMessage := "The image update job has not completed yet. The provider will check the status of the job on the next plan or apply."
+			resp.Diagnostics.AddError(
+				"Error updating Image for Machine Catalog "+catalogName,
+				errorMessage,
+			)
+			return fmt.Errorf(errorMessage)
+		}
+
+		if !provisioningSchemePlan.ImageRolloutStrategy.IsNull() {
+			rolloutStrategy := util.ObjectValueToTypedObject[ImageRolloutStrategyModel](ctx, &resp.Diagnostics, provisioningSchemePlan.ImageRolloutStrategy)
+			return rolloutCatalogImage(ctx, client, resp, catalog, rolloutStrategy, rolloutRebootOption, updateTimeout)
+		}
 	}
 
 	return nil
@@ -1287,6 +1457,9 @@ func (r MachineCatalogResourceModel) updateCatalogWithProvScheme(ctx context.Con
 		}
 	}
 
+	// Refresh Prepared Image
+	provSchemeModel = provSchemeModel.refreshPreparedImage(ctx, diagnostics, provScheme)
+
 	remoteCustomProperties := map[string]string{}
 	for _, customProperty := range customProperties {
 		remoteCustomProperties[customProperty.GetName()] = customProperty.GetValue()
@@ -1308,6 +1481,29 @@ func (r MachineCatalogResourceModel) updateCatalogWithProvScheme(ctx context.Con
 		provSchemeModel.CustomProperties = util.TypedArrayToObjectList[CustomPropertyModel](ctx, diagnostics, refreshedCustomProperties)
 	}
 
+	// Refresh Image History
+	if r.ProvisioningType.ValueString() == string(citrixorchestration.PROVISIONINGTYPE_PVS_STREAMING) {
+		provSchemeModel.ImageHistory = getImageHistoryList(ctx, diagnostics, nil)
+	} else {
+		// The image history is informational, so failing to read it must not fail the refresh of the catalog
+		var imageHistoryDiagnostics diag.Diagnostics
+		imageHistory, err := getMachineCatalogImageHistory(ctx, client, &imageHistoryDiagnostics, catalog)
+		if err == nil {
+			provSchemeModel.ImageHistory = getImageHistoryList(ctx, diagnostics, imageHistory)
+		} else {
+			for _, imageHistoryDiagnostic := range imageHistoryDiagnostics.Errors() {
+				diagnostics.AddWarning(imageHistoryDiagnostic.Summary(), imageHistoryDiagnostic.Detail())
+			}
+			// Keep the last known image history
+			if provSchemeModel.ImageHistory.IsNull() || provSchemeModel.ImageHistory.IsUnknown() {
+				provSchemeModel.ImageHistory = getImageHistoryList(ctx, diagnostics, nil)
+			}
+		}
+	}
+	if provSchemeModel.RollbackToPreviousImage.IsNull() {
+		provSchemeModel.RollbackToPreviousImage = types.BoolValue(false)
+	}
+
 	// Refresh Total Machine Count
 	provSchemeModel.NumTotalMachines = types.Int64Value(int64(provScheme.GetMachineCount()))
 
@@ -1626,3 +1822,303 @@ func getNetworkMappingForSCVMMCatalog(ctx context.Context, client *citrixdaascli
 
 	return networkMappingsRequest, nil
 }
+
+func getMachineCatalogImageHistory(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, catalog *citrixorchestration.MachineCatalogDetailResponseModel) ([]citrixorchestration.VMImageResponseModel, error) {
+	getImageHistoryRequest := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalogMasterImageHistory(ctx, catalog.GetId())
+	imageHistory, httpResp, err := citrixdaasclient.AddRequestData(getImageHistoryRequest, client).Execute()
+	if err != nil {
+		diagnostics.AddError(
+			"Error reading image history of Machine Catalog "+catalog.GetName(),
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return nil, err
+	}
+
+	images := imageHistory.GetItems()
+	sort.SliceStable(images, func(i, j int) bool {
+		return isImageHistoryDateAfter(images[i].GetDate(), images[j].GetDate())
+	})
+
+	return images, nil
+}
+
+// isImageHistoryDateAfter reports whether an image history date is more recent than another one.
+// Dates that cannot be parsed are ordered after all valid dates.
+func isImageHistoryDateAfter(date string, otherDate string) bool {
+	dateTime, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return false
+	}
+	otherDateTime, err := time.Parse(time.RFC3339, otherDate)
+	if err != nil {
+		return true
+	}
+	return dateTime.After(otherDateTime)
+}
+
+// getPreviousMasterImage returns the most recent image that was replaced by the current image and is still available for rollback.
+func getPreviousMasterImage(imageHistory []citrixorchestration.VMImageResponseModel) *citrixorchestration.VMImageResponseModel {
+	currentImageFound := false
+	for index, image := range imageHistory {
+		if image.GetImageStatus() == citrixorchestration.VMIMAGESTATUS_CURRENT {
+			currentImageFound = true
+			continue
+		}
+		if currentImageFound && image.GetImageStatus() != citrixorchestration.VMIMAGESTATUS_DELETED && image.Image != nil {
+			return &imageHistory[index]
+		}
+	}
+	return nil
+}
+
+func rollbackCatalogImage(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.UpdateResponse, catalog *citrixorchestration.MachineCatalogDetailResponseModel, rebootOption citrixorchestration.RebootMachinesRequestModel, updateTimeout int) error {
+	catalogName := catalog.GetName()
+
+	rollbackRequest := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsRollbackMachineCatalogProvisioningScheme(ctx, catalog.GetId())
+	rollbackRequest = rollbackRequest.RebootMachinesRequestModel(rebootOption)
+	_, httpResp, err := citrixdaasclient.AddRequestData(rollbackRequest, client).Async(true).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rolling back Image for Machine Catalog "+catalogName,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return err
+	}
+
+	jobPending, err := util.ProcessAsyncJobResponseWithResume(ctx, client, httpResp, "Error rolling back Image for Machine Catalog "+catalogName, &resp.Diagnostics, updateTimeout, false, resp.Private, util.PendingAsyncJobOperationUpdate)
+	if err != nil {
+		return err
+	}
+
+	if jobPending {
+		// Keep the previous state until the image rollback job completes
+		errorMessage := "The image rollback job has not completed yet. The provider will check the status of the job on the next plan or apply."
+		resp.Diagnostics.AddError(
+			"Error rolling back Image for Machine Catalog "+catalogName,
+			errorMessage,
+		)
+		return fmt.Errorf(errorMessage)
+	}
+
+	return nil
+}
+
+func getImageHistoryList(ctx context.Context, diagnostics *diag.Diagnostics, imageHistory []citrixorchestration.VMImageResponseModel) types.List {
+	images := []ImageHistoryModel{}
+	for _, image := range imageHistory {
+		imageRef := image.GetImage()
+		imageModel := ImageHistoryModel{
+			ImagePath:       types.StringValue(imageRef.GetXDPath()),
+			MasterImageNote: types.StringValue(image.GetMasterImageNote()),
+			Status:          types.StringValue(string(image.GetImageStatus())),
+			Date:            types.StringValue(image.GetDate()),
+		}
+		images = append(images, imageModel)
+	}
+	return util.TypedArrayToObjectList[ImageHistoryModel](ctx, diagnostics, images)
+}
+
+// rolloutCatalogImage applies the new image of the catalog to the canary machines first, and completes the image update on the rest of the catalog once the canary machines have re-registered.
+// When too many canary machines fail, the catalog is rolled back to the previous image so that the remaining machines do not pick up the new image.
+func rolloutCatalogImage(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.UpdateResponse, catalog *citrixorchestration.MachineCatalogDetailResponseModel, rolloutStrategy ImageRolloutStrategyModel, rebootOption citrixorchestration.RebootMachinesRequestModel, updateTimeout int) error {
+	catalogName := catalog.GetName()
+	errContext := "Error rolling out Image for Machine Catalog " + catalogName
+
+	machines, err := util.GetMachineCatalogMachines(ctx, client, &resp.Diagnostics, catalog.GetId())
+	if err != nil {
+		return err
+	}
+
+	canaryMachines, err := getCanaryMachines(ctx, &resp.Diagnostics, machines.GetItems(), rolloutStrategy)
+	if err != nil {
+		resp.Diagnostics.AddError(errContext, err.Error())
+		return err
+	}
+
+	// Reboot the canary machines together to apply the new image, and wait for all of them to re-register
+	canaryErrors := rebootCanaryMachines(ctx, client, canaryMachines, updateTimeout, int(rolloutStrategy.RegistrationTimeout.ValueInt64()))
+	failedMachines := []string{}
+	for index, err := range canaryErrors {
+		if err == nil {
+			continue
+		}
+		machineName := canaryMachines[index].GetName()
+		failedMachines = append(failedMachines, machineName)
+		resp.Diagnostics.AddWarning(
+			"Canary machine "+machineName+" failed the image rollout for Machine Catalog "+catalogName,
+			err.Error(),
+		)
+	}
+
+	if int64(len(failedMachines)) > rolloutStrategy.FailureThreshold.ValueInt64() {
+		errorMessage := fmt.Sprintf("The image rollout was stopped because %d canary machine(s) failed to reboot or re-register, which exceeds the failure threshold of %d: %s. "+
+			"The machine catalog is rolled back to the previous image with `image_update_reboot_options`, the rollout is attempted again on the next apply.",
+			len(failedMachines), rolloutStrategy.FailureThreshold.ValueInt64(), strings.Join(failedMachines, ", "))
+		resp.Diagnostics.AddError(errContext, errorMessage)
+
+		if err := rollbackCatalogImage(ctx, client, resp, catalog, rebootOption, updateTimeout); err != nil {
+			return err
+		}
+		return fmt.Errorf(errorMessage)
+	}
+
+	if rebootOption.GetRebootDuration() < 0 {
+		// The remaining machines are updated with the new image on their next shutdown
+		return nil
+	}
+
+	// Complete the image update on the rest of the catalog with the configured reboot duration and warnings.
+	// The reboot cycle covers the whole catalog, so the canary machines which already run the new image are restarted again.
+	rebootCatalogMachinesRequest := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsRebootMachineCatalogMachines(ctx, catalog.GetId())
+	rebootCatalogMachinesRequest = rebootCatalogMachinesRequest.RebootMachinesRequestModel(rebootOption)
+	httpResp, err := citrixdaasclient.AddRequestData(rebootCatalogMachinesRequest, client).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errContext,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func getCanaryMachines(ctx context.Context, diagnostics *diag.Diagnostics, machines []citrixorchestration.MachineResponseModel, rolloutStrategy ImageRolloutStrategyModel) ([]citrixorchestration.MachineResponseModel, error) {
+	if !rolloutStrategy.CanaryMachines.IsNull() {
+		canaryMachines := []citrixorchestration.MachineResponseModel{}
+		for _, canaryMachineName := range util.StringListToStringArray(ctx, diagnostics, rolloutStrategy.CanaryMachines) {
+			machine, found := findMachineInCatalog(machines, canaryMachineName)
+			if !found {
+				return nil, fmt.Errorf("canary machine %s is not found in the machine catalog", canaryMachineName)
+			}
+			canaryMachines = append(canaryMachines, machine)
+		}
+		return canaryMachines, nil
+	}
+
+	canaryCount := int(math.Ceil(float64(len(machines)) * float64(rolloutStrategy.CanaryPercentage.ValueInt64()) / 100))
+	sortedMachines := slices.Clone(machines)
+	sort.SliceStable(sortedMachines, func(i, j int) bool {
+		return strings.ToLower(sortedMachines[i].GetName()) < strings.ToLower(sortedMachines[j].GetName())
+	})
+	return sortedMachines[:canaryCount], nil
+}
+
+// rebootCanaryMachines reboots all canary machines at once and waits for each of them to re-register.
+// The returned errors are in the same order as the canary machines, with a nil error for each machine that re-registered.
+func rebootCanaryMachines(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, canaryMachines []citrixorchestration.MachineResponseModel, updateTimeout int, registrationTimeout int) []error {
+	canaryErrors := make([]error, len(canaryMachines))
+	var waitGroup sync.WaitGroup
+	for index, machine := range canaryMachines {
+		waitGroup.Add(1)
+		go func(index int, machine citrixorchestration.MachineResponseModel) {
+			defer waitGroup.Done()
+			canaryErrors[index] = rebootCanaryMachine(ctx, client, machine, updateTimeout, registrationTimeout)
+		}(index, machine)
+	}
+	waitGroup.Wait()
+
+	return canaryErrors
+}
+
+func rebootCanaryMachine(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, machine citrixorchestration.MachineResponseModel, updateTimeout int, registrationTimeout int) error {
+	// Diagnostics are not safe for concurrent use, the job errors are returned to the caller instead
+	var machineDiagnostics diag.Diagnostics
+	rebootMachineRequest := client.ApiClient.MachinesAPIsDAAS.MachinesRebootMachine(ctx, machine.GetId())
+	_, httpResp, err := citrixdaasclient.AddRequestData(rebootMachineRequest, client).Async(true).Execute()
+	if err != nil {
+		return fmt.Errorf("TransactionId: %s\nError message: %s", citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp), util.ReadClientError(err))
+	}
+
+	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error rebooting machine "+machine.GetName(), &machineDiagnostics, updateTimeout, true)
+	if err != nil {
+		return err
+	}
+
+	return waitForMachineRegistration(ctx, client, machine.GetId(), machine.GetLastDeregistrationTime(), registrationTimeout)
+}
+
+// machineRegistrationPollInterval is the time between two checks of the registration state of a rebooted machine
+var machineRegistrationPollInterval = 30 * time.Second
+
+// waitForMachineRegistration waits for a rebooted machine to deregister and register again.
+// A machine that still reports the deregistration time from before the reboot while it is registered has not restarted yet.
+func waitForMachineRegistration(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, machineId string, lastDeregistrationTime string, registrationTimeout int) error {
+	deadline := time.Now().Add(time.Minute * time.Duration(registrationTimeout))
+	deregistered := false
+	getMachineRequest := client.ApiClient.MachinesAPIsDAAS.MachinesGetMachine(ctx, machineId)
+
+	for {
+		timer := time.NewTimer(machineRegistrationPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		machine, httpResp, err := citrixdaasclient.AddRequestData(getMachineRequest, client).Execute()
+		if err != nil {
+			return fmt.Errorf("TransactionId: %s\nError message: %s", citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp), util.ReadClientError(err))
+		}
+
+		deregistered = deregistered || hasMachineDeregistered(machine, lastDeregistrationTime)
+		if deregistered && machine.GetRegistrationState() == citrixorchestration.REGISTRATIONSTATE_REGISTERED {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			if !deregistered {
+				return fmt.Errorf("machine did not deregister to restart with the new image within %d minutes", registrationTimeout)
+			}
+			return fmt.Errorf("machine did not re-register within %d minutes, current registration state is %s", registrationTimeout, machine.GetRegistrationState())
+		}
+	}
+}
+
+// hasMachineDeregistered reports whether the machine deregistered since the given deregistration time, either because it is currently not registered or because it deregistered again in between two checks.
+func hasMachineDeregistered(machine *citrixorchestration.MachineDetailResponseModel, lastDeregistrationTime string) bool {
+	return machine.GetRegistrationState() != citrixorchestration.REGISTRATIONSTATE_REGISTERED || machine.GetLastDeregistrationTime() != lastDeregistrationTime
+}
+
+func (provSchemeModel ProvisioningSchemeModel) refreshPreparedImage(ctx context.Context, diagnostics *diag.Diagnostics, provScheme citrixorchestration.ProvisioningSchemeResponseModel) ProvisioningSchemeModel {
+	if !provScheme.HasCurrentImageVersion() {
+		if attributesMap, err := util.AttributeMapFromObject(PreparedImageModel{}); err == nil {
+			provSchemeModel.PreparedImage = types.ObjectNull(attributesMap)
+		} else {
+			diagnostics.AddWarning("Error when creating null PreparedImageModel", err.Error())
+		}
+		return provSchemeModel
+	}
+
+	currentImageVersion := provScheme.GetCurrentImageVersion()
+	imageVersion := currentImageVersion.GetImageVersion()
+	imageDefinition := imageVersion.GetImageDefinition()
+	preparedImage := PreparedImageModel{
+		ImageDefinition: types.StringValue(imageDefinition.GetId()),
+		ImageVersion:    types.StringValue(imageVersion.GetId()),
+	}
+	provSchemeModel.PreparedImage = util.TypedObjectToObjectValue(ctx, diagnostics, preparedImage)
+
+	// The master image of a catalog using a prepared image is managed by the image version
+	if !provSchemeModel.AzureMachineConfig.IsNull() {
+		azureMachineConfigModel := util.ObjectValueToTypedObject[AzureMachineConfigModel](ctx, diagnostics, provSchemeModel.AzureMachineConfig)
+		if attributesMap, err := util.AttributeMapFromObject(AzureMasterImageModel{}); err == nil {
+			azureMachineConfigModel.AzureMasterImage = types.ObjectNull(attributesMap)
+		} else {
+			diagnostics.AddWarning("Error when creating null AzureMasterImageModel", err.Error())
+		}
+		provSchemeModel.AzureMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, azureMachineConfigModel)
+	}
+	if !provSchemeModel.VsphereMachineConfig.IsNull() {
+		vSphereMachineConfig := util.ObjectValueToTypedObject[VsphereMachineConfigModel](ctx, diagnostics, provSchemeModel.VsphereMachineConfig)
+		vSphereMachineConfig.MasterImageVm = types.StringNull()
+		vSphereMachineConfig.ImageSnapshot = types.StringNull()
+		provSchemeModel.VsphereMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, vSphereMachineConfig)
+	}
+
+	return provSchemeModel
+}
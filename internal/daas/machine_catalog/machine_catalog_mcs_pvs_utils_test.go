@@ -0,0 +1,387 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func newTestMachine(id string, name string) citrixorchestration.MachineResponseModel {
+	machine := citrixorchestration.MachineResponseModel{}
+	machine.SetId(id)
+	machine.SetName(name)
+	return machine
+}
+
+func getTestMachineIds(machines []citrixorchestration.MachineResponseModel) []string {
+	machineIds := []string{}
+	for _, machine := range machines {
+		machineIds = append(machineIds, machine.GetId())
+	}
+	return machineIds
+}
+
+func TestGetCanaryMachines(t *testing.T) {
+	machines := []citrixorchestration.MachineResponseModel{
+		newTestMachine("3", "DOMAIN\\machine-c"),
+		newTestMachine("1", "DOMAIN\\machine-a"),
+		newTestMachine("4", "DOMAIN\\machine-d"),
+		newTestMachine("2", "DOMAIN\\machine-b"),
+	}
+
+	tests := []struct {
+		name             string
+		canaryMachines   []string
+		canaryPercentage int64
+		expected         []string
+		expectError      bool
+	}{
+		{name: "PercentageIsRoundedUp", canaryPercentage: 30, expected: []string{"1", "2"}},
+		{name: "FullPercentage", canaryPercentage: 100, expected: []string{"1", "2", "3", "4"}},
+		{name: "MachineNames", canaryMachines: []string{"machine-d", "DOMAIN\\MACHINE-B"}, expected: []string{"4", "2"}},
+		{name: "UnknownMachineName", canaryMachines: []string{"machine-e"}, expectError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rolloutStrategy := ImageRolloutStrategyModel{
+				CanaryPercentage: types.Int64Null(),
+				CanaryMachines:   types.ListNull(types.StringType),
+			}
+			if test.canaryMachines != nil {
+				canaryMachineValues := []attr.Value{}
+				for _, canaryMachine := range test.canaryMachines {
+					canaryMachineValues = append(canaryMachineValues, types.StringValue(canaryMachine))
+				}
+				rolloutStrategy.CanaryMachines = types.ListValueMust(types.StringType, canaryMachineValues)
+			} else {
+				rolloutStrategy.CanaryPercentage = types.Int64Value(test.canaryPercentage)
+			}
+			diagnostics := diag.Diagnostics{}
+
+			canaryMachines, err := getCanaryMachines(context.Background(), &diagnostics, machines, rolloutStrategy)
+			if test.expectError {
+				if err == nil {
+					t.Errorf("expected an error, got canary machines %v", getTestMachineIds(canaryMachines))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			canaryMachineIds := getTestMachineIds(canaryMachines)
+			if strings.Join(canaryMachineIds, ",") != strings.Join(test.expected, ",") {
+				t.Errorf("expected canary machines %v, got %v", test.expected, canaryMachineIds)
+			}
+		})
+	}
+}
+
+func newTestMachineDetail(registrationState citrixorchestration.RegistrationState, lastDeregistrationTime string) citrixorchestration.MachineDetailResponseModel {
+	machine := citrixorchestration.MachineDetailResponseModel{}
+	machine.SetId("machine-id")
+	machine.SetRegistrationState(registrationState)
+	machine.SetLastDeregistrationTime(lastDeregistrationTime)
+	return machine
+}
+
+func TestHasMachineDeregistered(t *testing.T) {
+	lastDeregistrationTime := "2024-01-01T00:00:00Z"
+
+	tests := []struct {
+		name     string
+		machine  citrixorchestration.MachineDetailResponseModel
+		expected bool
+	}{
+		{name: "NotRestarted", machine: newTestMachineDetail(citrixorchestration.REGISTRATIONSTATE_REGISTERED, lastDeregistrationTime), expected: false},
+		{name: "Unregistered", machine: newTestMachineDetail(citrixorchestration.REGISTRATIONSTATE_UNREGISTERED, lastDeregistrationTime), expected: true},
+		{name: "ReregisteredBetweenChecks", machine: newTestMachineDetail(citrixorchestration.REGISTRATIONSTATE_REGISTERED, "2024-01-02T00:00:00Z"), expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if deregistered := hasMachineDeregistered(&test.machine, lastDeregistrationTime); deregistered != test.expected {
+				t.Errorf("expected machine deregistered to be %t, got %t", test.expected, deregistered)
+			}
+		})
+	}
+}
+
+// newTestClient returns a client whose Orchestration requests are answered with the JSON encoded responses in order, repeating the last response.
+func newTestClient[T any](t *testing.T, responses []T) *citrixdaasclient.CitrixDaasClient {
+	var lock sync.Mutex
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		response := responses[min(requestCount, len(responses)-1)]
+		requestCount++
+		lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Errorf("unexpected error writing response: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	apiConfig := citrixorchestration.NewConfiguration()
+	apiConfig.Servers = citrixorchestration.ServerConfigurations{{URL: server.URL}}
+	return &citrixdaasclient.CitrixDaasClient{
+		ApiClient:    citrixorchestration.NewAPIClient(apiConfig),
+		ClientConfig: &citrixdaasclient.ClientConfiguration{},
+	}
+}
+
+// newTestMachineClient returns a client whose machine requests are answered with the given machine states in order, repeating the last state.
+func newTestMachineClient(t *testing.T, machineStates []citrixorchestration.MachineDetailResponseModel) *citrixdaasclient.CitrixDaasClient {
+	return newTestClient(t, machineStates)
+}
+
+func TestWaitForMachineRegistration(t *testing.T) {
+	pollInterval := machineRegistrationPollInterval
+	machineRegistrationPollInterval = time.Millisecond
+	t.Cleanup(func() { machineRegistrationPollInterval = pollInterval })
+
+	lastDeregistrationTime := "2024-01-01T00:00:00Z"
+	registered := newTestMachineDetail(citrixorchestration.REGISTRATIONSTATE_REGISTERED, lastDeregistrationTime)
+	unregistered := newTestMachineDetail(citrixorchestration.REGISTRATIONSTATE_UNREGISTERED, lastDeregistrationTime)
+	reregistered := newTestMachineDetail(citrixorchestration.REGISTRATIONSTATE_REGISTERED, "2024-01-02T00:00:00Z")
+
+	tests := []struct {
+		name          string
+		machineStates []citrixorchestration.MachineDetailResponseModel
+		expectError   bool
+	}{
+		{name: "DeregisteredAndRegistered", machineStates: []citrixorchestration.MachineDetailResponseModel{registered, unregistered, unregistered, reregistered}},
+		{name: "ReregisteredBetweenChecks", machineStates: []citrixorchestration.MachineDetailResponseModel{registered, reregistered}},
+		{name: "NeverRestarted", machineStates: []citrixorchestration.MachineDetailResponseModel{registered}, expectError: true},
+		{name: "NeverReregistered", machineStates: []citrixorchestration.MachineDetailResponseModel{unregistered}, expectError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newTestMachineClient(t, test.machineStates)
+
+			// A registration timeout of 0 minutes stops waiting after the first check that does not complete the wait
+			registrationTimeout := 1
+			if test.expectError {
+				registrationTimeout = 0
+			}
+			err := waitForMachineRegistration(context.Background(), client, "machine-id", lastDeregistrationTime, registrationTimeout)
+			if test.expectError && err == nil {
+				t.Error("expected an error for a machine that did not restart and re-register")
+			}
+			if !test.expectError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestWaitForMachineRegistrationIsCancelled(t *testing.T) {
+	client := newTestMachineClient(t, []citrixorchestration.MachineDetailResponseModel{
+		newTestMachineDetail(citrixorchestration.REGISTRATIONSTATE_REGISTERED, "2024-01-01T00:00:00Z"),
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := waitForMachineRegistration(ctx, client, "machine-id", "2024-01-01T00:00:00Z", 30); err != context.Canceled {
+		t.Errorf("expected the wait to be cancelled, got %v", err)
+	}
+}
+
+// newTestRolloutClient returns a client for an image rollout on a catalog with the given machines, whose reboot jobs end with the given status.
+// The paths of the requests which change the catalog or its machines are recorded in the returned slice.
+func newTestRolloutClient(t *testing.T, machines []citrixorchestration.MachineResponseModel, rebootJobStatus citrixorchestration.JobStatus) (*citrixdaasclient.CitrixDaasClient, *[]string) {
+	var lock sync.Mutex
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response any
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/MachineCatalogs/catalog-id/Machines"):
+			machineCollection := citrixorchestration.MachineResponseModelCollection{}
+			machineCollection.SetItems(machines)
+			response = machineCollection
+		case r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/Jobs/"):
+			jobStatus := citrixorchestration.JOBSTATUS_COMPLETE
+			if strings.HasSuffix(r.URL.Path, "/reboot-job") {
+				jobStatus = rebootJobStatus
+			}
+			job := newTestJob(jobStatus)
+			job.SetErrorString("job failed")
+			response = job
+		case r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/Machines/"):
+			response = newTestMachineDetail(citrixorchestration.REGISTRATIONSTATE_REGISTERED, "2024-01-02T00:00:00Z")
+		default:
+			lock.Lock()
+			requests = append(requests, r.URL.Path)
+			lock.Unlock()
+
+			jobId := "catalog-job"
+			if strings.HasSuffix(r.URL.Path, "/$reboot") {
+				jobId = "reboot-job"
+			}
+			w.Header().Set("Location", "/Jobs/"+jobId)
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Errorf("unexpected error writing response: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	apiConfig := citrixorchestration.NewConfiguration()
+	apiConfig.Servers = citrixorchestration.ServerConfigurations{{URL: server.URL}}
+	client := &citrixdaasclient.CitrixDaasClient{
+		ApiClient:    citrixorchestration.NewAPIClient(apiConfig),
+		ClientConfig: &citrixdaasclient.ClientConfiguration{},
+	}
+	return client, &requests
+}
+
+func TestRolloutCatalogImage(t *testing.T) {
+	pollInterval := machineRegistrationPollInterval
+	machineRegistrationPollInterval = time.Millisecond
+	t.Cleanup(func() { machineRegistrationPollInterval = pollInterval })
+
+	machines := []citrixorchestration.MachineResponseModel{
+		newTestMachine("1", "DOMAIN\\machine-a"),
+		newTestMachine("2", "DOMAIN\\machine-b"),
+	}
+	for index := range machines {
+		machines[index].SetRegistrationState(citrixorchestration.REGISTRATIONSTATE_REGISTERED)
+		machines[index].SetLastDeregistrationTime("2024-01-01T00:00:00Z")
+	}
+
+	tests := []struct {
+		name             string
+		rebootJobStatus  citrixorchestration.JobStatus
+		rebootDuration   int32
+		expectError      bool
+		expectedRequests []string
+	}{
+		{
+			name:             "CanaryPassed",
+			rebootJobStatus:  citrixorchestration.JOBSTATUS_COMPLETE,
+			rebootDuration:   30,
+			expectedRequests: []string{"/Machines/1/$reboot", "/MachineCatalogs/catalog-id/$RebootMachines"},
+		},
+		{
+			name:             "CanaryPassedWithoutReboot",
+			rebootJobStatus:  citrixorchestration.JOBSTATUS_COMPLETE,
+			rebootDuration:   -1,
+			expectedRequests: []string{"/Machines/1/$reboot"},
+		},
+		{
+			name:             "FailureThresholdExceeded",
+			rebootJobStatus:  citrixorchestration.JOBSTATUS_FAILED,
+			rebootDuration:   30,
+			expectError:      true,
+			expectedRequests: []string{"/Machines/1/$reboot", "/MachineCatalogs/catalog-id/$RollbackProvisioningScheme"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, requests := newTestRolloutClient(t, machines, test.rebootJobStatus)
+			catalog := citrixorchestration.MachineCatalogDetailResponseModel{}
+			catalog.SetId("catalog-id")
+			catalog.SetName("catalog")
+			rolloutStrategy := ImageRolloutStrategyModel{
+				CanaryPercentage:    types.Int64Value(50),
+				CanaryMachines:      types.ListNull(types.StringType),
+				RegistrationTimeout: types.Int64Value(1),
+				FailureThreshold:    types.Int64Value(0),
+			}
+			var rebootOption citrixorchestration.RebootMachinesRequestModel
+			rebootOption.SetRebootDuration(test.rebootDuration)
+			resp := resource.UpdateResponse{}
+
+			err := rolloutCatalogImage(context.Background(), client, &resp, &catalog, rolloutStrategy, rebootOption, 1)
+			if test.expectError != (err != nil) {
+				t.Errorf("expected error to be %t, got %v", test.expectError, err)
+			}
+			if test.expectError != resp.Diagnostics.HasError() {
+				t.Errorf("expected error diagnostics to be %t, got %v", test.expectError, resp.Diagnostics)
+			}
+			if strings.Join(*requests, ",") != strings.Join(test.expectedRequests, ",") {
+				t.Errorf("expected requests %v, got %v", test.expectedRequests, *requests)
+			}
+		})
+	}
+}
+
+func TestGetMachinesToDeleteFromMcsPvsCatalogWithMachinesToRemove(t *testing.T) {
+	machines := []citrixorchestration.MachineResponseModel{
+		newTestMachine("1", "DOMAIN\\machine-a"),
+		newTestMachine("2", "DOMAIN\\machine-b"),
+		newTestMachine("3", "DOMAIN\\machine-c"),
+	}
+
+	tests := []struct {
+		name             string
+		machinesToRemove []string
+		deleteCount      int
+		expected         []string
+		expectError      bool
+		expectWarning    bool
+	}{
+		{name: "ListedMachines", machinesToRemove: []string{"machine-c", "machine-b"}, deleteCount: 2, expected: []string{"3", "2"}},
+		{name: "ListedMachineAndPolicy", machinesToRemove: []string{"machine-c"}, deleteCount: 2, expected: []string{"3", "1"}},
+		{name: "MissingMachineIsNeeded", machinesToRemove: []string{"machine-c", "machine-x"}, deleteCount: 2, expectError: true},
+		{name: "MissingMachineIsNotNeeded", machinesToRemove: []string{"machine-c", "machine-x"}, deleteCount: 1, expected: []string{"3"}, expectWarning: true},
+		{name: "TooManyListedMachines", machinesToRemove: []string{"machine-c", "machine-b"}, deleteCount: 1, expectError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			machinesToRemoveValues := []attr.Value{}
+			for _, machineName := range test.machinesToRemove {
+				machinesToRemoveValues = append(machinesToRemoveValues, types.StringValue(machineName))
+			}
+			scaleInPolicy := ScaleInPolicyModel{
+				Prefer:                     types.ListNull(types.StringType),
+				MachinesToRemove:           types.ListValueMust(types.StringType, machinesToRemoveValues),
+				RemoveMachinesWithSessions: types.BoolValue(false),
+			}
+			diagnostics := diag.Diagnostics{}
+
+			machinesToDelete, err := getMachinesToDeleteFromMcsPvsCatalog(context.Background(), &diagnostics, machines, test.deleteCount, scaleInPolicy)
+			if test.expectError {
+				if err == nil {
+					t.Errorf("expected an error, got machines to delete %v", getTestMachineIds(machinesToDelete))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			machineIds := getTestMachineIds(machinesToDelete)
+			if strings.Join(machineIds, ",") != strings.Join(test.expected, ",") {
+				t.Errorf("expected machines to delete %v, got %v", test.expected, machineIds)
+			}
+			if hasWarning := diagnostics.WarningsCount() > 0; hasWarning != test.expectWarning {
+				t.Errorf("expected warning to be %t, got diagnostics %v", test.expectWarning, diagnostics)
+			}
+		})
+	}
+}
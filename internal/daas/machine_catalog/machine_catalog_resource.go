@@ -11,6 +11,7 @@ import (
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -59,6 +60,11 @@ func (r *machineCatalogResource) Configure(_ context.Context, req resource.Confi
 func (r *machineCatalogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan MachineCatalogResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -99,11 +105,26 @@ func (r *machineCatalogResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	err = util.ProcessAsyncJobResponse(ctx, r.client, httpResp, "Error creating Machine Catalog", &resp.Diagnostics, 120, false)
+	timeouts := util.ObjectValueToTypedObject[MachineCatalogTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	jobPending, err := util.ProcessAsyncJobResponseWithResume(ctx, r.client, httpResp, "Error creating Machine Catalog", &resp.Diagnostics, util.GetTimeoutValue(timeouts.Create, util.DefaultMachineCatalogCreateTimeout), false, resp.Private, util.PendingAsyncJobOperationCreate)
 	if err != nil {
 		return
 	}
 
+	if jobPending {
+		// Save the planned state so that the catalog is reconciled once the create job completes instead of being created again
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		util.NullifyUnknownStateValues(&resp.Diagnostics, &resp.State)
+		resp.Diagnostics.AddWarning(
+			"Machine Catalog "+plan.Name.ValueString()+" creation is still in progress",
+			"The job creating the machine catalog did not complete before the provider stopped waiting for it. "+
+				"The partial state of the machine catalog was saved, the provider checks the status of the job on the next plan or apply. "+
+				"The machine catalog cannot be updated or deleted until the job completes.",
+		)
+		return
+	}
+
 	// Get the new catalog
 	catalog, err := util.GetMachineCatalog(ctx, r.client, &resp.Diagnostics, plan.Name.ValueString(), true)
 
@@ -117,6 +138,20 @@ func (r *machineCatalogResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	// Apply the machine settings. Failures are reported as warnings so that the created catalog is not tainted,
+	// the machine settings which could not be applied show up as changes on the next plan.
+	var machineSettingsDiagnostics diag.Diagnostics
+	machinesUpdated, _ := applyMachineSettings(ctx, r.client, &machineSettingsDiagnostics, plan, machines)
+	for _, machineSettingsDiagnostic := range machineSettingsDiagnostics {
+		resp.Diagnostics.AddWarning(machineSettingsDiagnostic.Summary(), machineSettingsDiagnostic.Detail())
+	}
+	if machinesUpdated {
+		machines, err = util.GetMachineCatalogMachines(ctx, r.client, &resp.Diagnostics, catalog.GetId())
+		if err != nil {
+			return
+		}
+	}
+
 	hypervisorConnection := catalog.GetHypervisorConnection()
 	hypervisorId := hypervisorConnection.GetId()
 	var connectionType citrixorchestration.HypervisorConnectionType
@@ -146,6 +181,11 @@ func (r *machineCatalogResource) Create(ctx context.Context, req resource.Create
 func (r *machineCatalogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state MachineCatalogResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -154,15 +194,36 @@ func (r *machineCatalogResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	// Check on a job that was still running when the last operation stopped waiting for it
+	pendingJob := util.GetPendingAsyncJob(ctx, &resp.Diagnostics, req.Private)
+	if pendingJob != nil {
+		jobPending, err := util.ResumePendingAsyncJob(ctx, r.client, *pendingJob, "Pending "+pendingJob.Operation+" job for Machine Catalog "+state.Name.ValueString(), &resp.Diagnostics, resp.Private)
+		if jobPending {
+			// Keep the current state until the job completes
+			return
+		}
+
+		if err != nil && pendingJob.Operation == util.PendingAsyncJobOperationCreate {
+			resp.Diagnostics.AddWarning(
+				"Machine Catalog "+state.Name.ValueString()+" creation failed",
+				"The job creating the machine catalog failed after the provider stopped waiting for it. Use `terraform apply -replace` to recreate the machine catalog.",
+			)
+		}
+	}
+
 	// Get refreshed machine catalog state from Orchestration
 	catalogId := state.Id.ValueString()
+	if catalogId == "" {
+		// The create job was still running when the state was saved, look up the catalog by name
+		catalogId = state.Name.ValueString()
+	}
 
 	catalog, _, err := readMachineCatalog(ctx, r.client, resp, catalogId)
 	if err != nil {
 		return
 	}
 
-	machineCatalogMachines, err := util.GetMachineCatalogMachines(ctx, r.client, &resp.Diagnostics, catalogId)
+	machineCatalogMachines, err := util.GetMachineCatalogMachines(ctx, r.client, &resp.Diagnostics, catalog.GetId())
 	if err != nil {
 		return
 	}
@@ -198,6 +259,11 @@ func (r *machineCatalogResource) Read(ctx context.Context, req resource.ReadRequ
 func (r *machineCatalogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan MachineCatalogResourceModel
 	var state MachineCatalogResourceModel
@@ -213,14 +279,23 @@ func (r *machineCatalogResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	if jobPending := checkPendingCatalogCreation(ctx, r.client, &resp.Diagnostics, req.Private, resp.Private, state); jobPending {
+		return
+	}
+
 	// Get refreshed machine catalogs from Orchestration
-	catalogId := plan.Id.ValueString()
+	catalogId := state.Id.ValueString()
+	if catalogId == "" {
+		// The create job was still running when the state was saved, look up the catalog by name
+		catalogId = state.Name.ValueString()
+	}
 	catalogName := plan.Name.ValueString()
 	catalog, err := util.GetMachineCatalog(ctx, r.client, &resp.Diagnostics, catalogId, true)
 
 	if err != nil {
 		return
 	}
+	catalogId = catalog.GetId()
 
 	body, err := getRequestModelForUpdateMachineCatalog(plan, ctx, r.client, resp, r.client.AuthConfig.OnPremises)
 	if err != nil {
@@ -291,6 +366,15 @@ func (r *machineCatalogResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	// The other changes are already applied, so the state is refreshed even when the machine settings fail
+	machinesUpdated, _ := applyMachineSettings(ctx, r.client, &resp.Diagnostics, plan, machines)
+	if machinesUpdated {
+		machines, err = util.GetMachineCatalogMachines(ctx, r.client, &resp.Diagnostics, catalog.GetId())
+		if err != nil {
+			return
+		}
+	}
+
 	hypervisorConnection := catalog.GetHypervisorConnection()
 	hypervisorId := hypervisorConnection.GetId()
 	var connectionType citrixorchestration.HypervisorConnectionType
@@ -319,6 +403,11 @@ func (r *machineCatalogResource) Update(ctx context.Context, req resource.Update
 func (r *machineCatalogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state MachineCatalogResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -327,7 +416,15 @@ func (r *machineCatalogResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	if jobPending := checkPendingCatalogCreation(ctx, r.client, &resp.Diagnostics, req.Private, resp.Private, state); jobPending {
+		return
+	}
+
 	catalogId := state.Id.ValueString()
+	if catalogId == "" {
+		// The create job was still running when the state was saved, look up the catalog by name
+		catalogId = state.Name.ValueString()
+	}
 
 	catalog, httpResp, err := readMachineCatalog(ctx, r.client, nil, catalogId)
 
@@ -347,7 +444,7 @@ func (r *machineCatalogResource) Delete(ctx context.Context, req resource.Delete
 
 	// Delete existing order
 	catalogName := state.Name.ValueString()
-	deleteMachineCatalogRequest := r.client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsDeleteMachineCatalog(ctx, catalogId)
+	deleteMachineCatalogRequest := r.client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsDeleteMachineCatalog(ctx, catalog.GetId())
 	deleteAccountOption := citrixorchestration.MACHINEACCOUNTDELETEOPTION_NONE
 	deleteVmOption := false
 	if catalog.GetProvisioningType() == citrixorchestration.PROVISIONINGTYPE_MCS || catalog.GetProvisioningType() == citrixorchestration.PROVISIONINGTYPE_PVS_STREAMING {
@@ -381,10 +478,20 @@ func (r *machineCatalogResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	err = util.ProcessAsyncJobResponse(ctx, r.client, httpResp, "Error deleting Machine Catalog "+catalogName, &resp.Diagnostics, 60, false)
+	timeouts := util.ObjectValueToTypedObject[MachineCatalogTimeouts](ctx, &resp.Diagnostics, state.Timeouts)
+	jobPending, err := util.ProcessAsyncJobResponseWithResume(ctx, r.client, httpResp, "Error deleting Machine Catalog "+catalogName, &resp.Diagnostics, util.GetTimeoutValue(timeouts.Delete, util.DefaultMachineCatalogDeleteTimeout), false, resp.Private, util.PendingAsyncJobOperationDelete)
 	if err != nil {
 		return
 	}
+
+	if jobPending {
+		// Keep the catalog in the state until the delete job completes
+		resp.Diagnostics.AddError(
+			"Error deleting Machine Catalog "+catalogName,
+			"The delete job has not completed yet. The provider will check the status of the job on the next plan or apply.",
+		)
+		return
+	}
 }
 
 func (r *machineCatalogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -556,6 +663,41 @@ func (r *machineCatalogResource) ValidateConfig(ctx context.Context, req resourc
 					rebootOptions := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, &resp.Diagnostics, vSphereMachineConfigModel.ImageUpdateRebootOptions)
 					rebootOptions.ValidateConfig(&resp.Diagnostics)
 				}
+
+				if provSchemeModel.PreparedImage.IsNull() && vSphereMachineConfigModel.MasterImageVm.IsNull() {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("master_image_vm"),
+						"Missing Attribute Configuration",
+						"Expected master_image_vm to be configured when prepared_image is not configured.",
+					)
+				}
+
+				if !provSchemeModel.PreparedImage.IsNull() && !vSphereMachineConfigModel.MasterImageVm.IsNull() {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("master_image_vm"),
+						"Incorrect Attribute Configuration",
+						"master_image_vm cannot be configured when prepared_image is configured.",
+					)
+				}
+			}
+
+			if !provSchemeModel.PreparedImage.IsNull() {
+				// Validate Prepared Image
+				if provSchemeModel.AzureMachineConfig.IsNull() && provSchemeModel.VsphereMachineConfig.IsNull() {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("prepared_image"),
+						"Incorrect Attribute Configuration",
+						"prepared_image is only supported for Azure and vSphere catalogs.",
+					)
+				}
+
+				if provSchemeModel.RollbackToPreviousImage.ValueBool() {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("rollback_to_previous_image"),
+						"Incorrect Attribute Configuration",
+						"rollback_to_previous_image cannot be enabled when prepared_image is configured. Reference the previous image version in prepared_image instead.",
+					)
+				}
 			}
 
 			if !provSchemeModel.XenserverMachineConfig.IsNull() {
@@ -656,6 +798,30 @@ func (r *machineCatalogResource) ValidateConfig(ctx context.Context, req resourc
 				)
 			}
 
+			if provSchemeModel.RollbackToPreviousImage.ValueBool() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("rollback_to_previous_image"),
+					"Incorrect Attribute Configuration",
+					fmt.Sprintf("rollback_to_previous_image cannot be enabled when value of provisioning_type is %s.", provisioningTypePvsStreaming),
+				)
+			}
+
+			if !provSchemeModel.ImageRolloutStrategy.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("image_rollout_strategy"),
+					"Incorrect Attribute Configuration",
+					fmt.Sprintf("image_rollout_strategy cannot be configured when value of provisioning_type is %s.", provisioningTypePvsStreaming),
+				)
+			}
+
+			if !provSchemeModel.PreparedImage.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("prepared_image"),
+					"Incorrect Attribute Configuration",
+					fmt.Sprintf("prepared_image cannot be configured when value of provisioning_type is %s.", provisioningTypePvsStreaming),
+				)
+			}
+
 			if azureMachineConfigModel.StorageType.ValueString() == util.AzureEphemeralOSDisk {
 				resp.Diagnostics.AddAttributeError(
 					path.Root("storage_type"),
@@ -820,6 +986,19 @@ func (r *machineCatalogResource) ValidateConfig(ctx context.Context, req resourc
 		}
 	}
 
+	if data.ProvisioningType.ValueString() == provisioningTypeManual && !data.IsPowerManaged.IsUnknown() && !data.IsPowerManaged.ValueBool() {
+		machineSettings := util.ObjectMapToTypedMap[MachineSettingsModel](ctx, &resp.Diagnostics, data.MachineSettings)
+		for machineName, settings := range machineSettings {
+			if !settings.PowerState.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("machine_settings").AtMapKey(machineName).AtName("power_state"),
+					"Incorrect Attribute Configuration",
+					"power_state cannot be configured when machines in the machine catalog are not power managed.",
+				)
+			}
+		}
+	}
+
 	provSchemeModel := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, &resp.Diagnostics, data.ProvisioningScheme)
 	if !data.ProvisioningScheme.IsNull() && !provSchemeModel.CustomProperties.IsNull() {
 		customProperties := util.ObjectListToTypedArray[CustomPropertyModel](ctx, &resp.Diagnostics, provSchemeModel.CustomProperties)
@@ -839,6 +1018,11 @@ func (r *machineCatalogResource) ValidateConfig(ctx context.Context, req resourc
 func (r *machineCatalogResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
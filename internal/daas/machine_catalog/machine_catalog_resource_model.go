@@ -14,13 +14,16 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -45,7 +48,36 @@ type MachineCatalogResourceModel struct {
 	MachineAccounts        types.List   `tfsdk:"machine_accounts"`    // List[MachineAccountsModel]
 	RemotePcOus            types.List   `tfsdk:"remote_pc_ous"`       // List[RemotePcOuModel]
 	MinimumFunctionalLevel types.String `tfsdk:"minimum_functional_level"`
-	Scopes                 types.Set    `tfsdk:"scopes"` //Set[String]
+	Scopes                 types.Set    `tfsdk:"scopes"`           //Set[String]
+	MachineSettings        types.Map    `tfsdk:"machine_settings"` // Map[MachineSettingsModel]
+	Timeouts               types.Object `tfsdk:"timeouts"`         // MachineCatalogTimeouts
+	Site                   types.String `tfsdk:"site"`
+}
+
+type MachineCatalogTimeouts struct {
+	Create types.Int64 `tfsdk:"create"`
+	Update types.Int64 `tfsdk:"update"`
+	Delete types.Int64 `tfsdk:"delete"`
+}
+
+func (MachineCatalogTimeouts) GetSchema() schema.SingleNestedAttribute {
+	return util.GetTimeoutsSchema("machine catalog", util.TimeoutConfigs{
+		Create:        true,
+		CreateDefault: util.DefaultMachineCatalogCreateTimeout,
+		CreateMin:     5,
+
+		Update:        true,
+		UpdateDefault: util.DefaultMachineCatalogUpdateTimeout,
+		UpdateMin:     5,
+
+		Delete:        true,
+		DeleteDefault: util.DefaultMachineCatalogDeleteTimeout,
+		DeleteMin:     5,
+	})
+}
+
+func (MachineCatalogTimeouts) GetAttributes() map[string]schema.Attribute {
+	return MachineCatalogTimeouts{}.GetSchema().Attributes
 }
 
 type MachineAccountsModel struct {
@@ -156,6 +188,11 @@ type ProvisioningSchemeModel struct {
 	MachineDomainIdentity       types.Object `tfsdk:"machine_domain_identity"`        // MachineDomainIdentityModel
 	MachineAccountCreationRules types.Object `tfsdk:"machine_account_creation_rules"` // MachineAccountCreationRulesModel
 	CustomProperties            types.List   `tfsdk:"custom_properties"`              // List[CustomPropertyModel]
+	RollbackToPreviousImage     types.Bool   `tfsdk:"rollback_to_previous_image"`
+	ImageRolloutStrategy        types.Object `tfsdk:"image_rollout_strategy"` // ImageRolloutStrategyModel
+	ImageHistory                types.List   `tfsdk:"image_history"`          // List[ImageHistoryModel]
+	PreparedImage               types.Object `tfsdk:"prepared_image"`         // PreparedImageModel
+	ScaleInPolicy               types.Object `tfsdk:"scale_in_policy"`        // ScaleInPolicyModel
 }
 
 func (ProvisioningSchemeModel) GetSchema() schema.SingleNestedAttribute {
@@ -253,6 +290,22 @@ func (ProvisioningSchemeModel) GetSchema() schema.SingleNestedAttribute {
 					listvalidator.SizeAtLeast(1),
 				},
 			},
+			"rollback_to_previous_image": schema.BoolAttribute{
+				Description: "Roll back the machine catalog to its previous master image instead of updating to a new one. " +
+					"When set to `true` and the configured master image matches the previous image in `image_history`, the catalog is rolled back with the `image_update_reboot_options` of the machine config. " +
+					"Otherwise the configured master image is applied as a regular image update. Only supported for MCS catalogs. Default is `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"image_rollout_strategy": ImageRolloutStrategyModel{}.GetSchema(),
+			"image_history": schema.ListNestedAttribute{
+				Description:  "The history of master images used by the machine catalog, ordered from the most recent.",
+				Computed:     true,
+				NestedObject: ImageHistoryModel{}.GetSchema(),
+			},
+			"prepared_image":  PreparedImageModel{}.GetSchema(),
+			"scale_in_policy": ScaleInPolicyModel{}.GetSchema(),
 		},
 	}
 }
@@ -261,6 +314,206 @@ func (ProvisioningSchemeModel) GetAttributes() map[string]schema.Attribute {
 	return ProvisioningSchemeModel{}.GetSchema().Attributes
 }
 
+type ImageRolloutStrategyModel struct {
+	CanaryPercentage    types.Int64 `tfsdk:"canary_percentage"`
+	CanaryMachines      types.List  `tfsdk:"canary_machines"` // List[string]
+	RegistrationTimeout types.Int64 `tfsdk:"registration_timeout"`
+	FailureThreshold    types.Int64 `tfsdk:"failure_threshold"`
+}
+
+func (ImageRolloutStrategyModel) GetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Staged rollout of master image updates. The new image is first applied to a set of canary machines, which are rebooted together and must re-register before the rest of the catalog is updated. " +
+			"The whole catalog is then rebooted with `image_update_reboot_options`, which also restarts the canary machines again. When `reboot_duration` is `-1`, the remaining machines are updated on their next shutdown instead. " +
+			"When omitted, the image update is applied to all machines of the catalog at once. Only supported for MCS catalogs.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"canary_percentage": schema.Int64Attribute{
+				Description: "Percentage of the machines in the catalog that the new image is first applied to. The number of canary machines is rounded up.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+					int64validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("canary_machines")),
+				},
+			},
+			"canary_machines": schema.ListAttribute{
+				Description: "Names of the machines in the catalog that the new image is first applied to.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"registration_timeout": schema.Int64Attribute{
+				Description: "Time in minutes to wait for each canary machine to re-register after it is rebooted with the new image. Default is `30`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(30),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"failure_threshold": schema.Int64Attribute{
+				Description: "Maximum number of canary machines that can fail to reboot or re-register before the rollout is stopped. When the rollout is stopped, the catalog is rolled back to the previous image with `image_update_reboot_options` and the rollout is attempted again on the next apply. Default is `0`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (ImageRolloutStrategyModel) GetAttributes() map[string]schema.Attribute {
+	return ImageRolloutStrategyModel{}.GetSchema().Attributes
+}
+
+type ScaleInPolicyModel struct {
+	Prefer                     types.List   `tfsdk:"prefer"`             // List[string]
+	MachinesToRemove           types.List   `tfsdk:"machines_to_remove"` // List[string]
+	RemoveMachinesWithSessions types.Bool   `tfsdk:"remove_machines_with_sessions"`
+	MachineAccountAction       types.String `tfsdk:"machine_account_action"`
+	DeleteVm                   types.Bool   `tfsdk:"delete_vm"`
+}
+
+func (ScaleInPolicyModel) GetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Policy used to choose the machines removed from the catalog when `number_of_total_machines` is decreased. " +
+			"When omitted, machines without active sessions are removed in the order returned by the machine catalog, and their machine accounts and virtual machines are deleted.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"prefer": schema.ListAttribute{
+				Description: "Ordered preferences used to choose the machines to remove. Each preference is used to break ties of the previous ones. " +
+					"Choose from `Unassigned` for machines without a delivery group or assigned users, `Unregistered` for machines that are not registered, `Oldest` for the machines added to the catalog first, and `InMaintenanceMode` for machines in maintenance mode.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf(
+							util.ScaleInPreferUnassigned,
+							util.ScaleInPreferUnregistered,
+							util.ScaleInPreferOldest,
+							util.ScaleInPreferInMaintenanceMode,
+						),
+					),
+				},
+			},
+			"machines_to_remove": schema.ListAttribute{
+				Description: "Names of the machines to remove first when the number of machines is decreased. The machine name can be specified with or without the domain. " +
+					"Machines that are no longer in the catalog are ignored with a warning, unless fewer listed machines than the number of machines being removed are found. The number of listed machines in the catalog cannot exceed the number of machines being removed.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"remove_machines_with_sessions": schema.BoolAttribute{
+				Description: "Allow removing machines with active sessions. Default is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"machine_account_action": schema.StringAttribute{
+				Description: "Action performed on the Active Directory machine accounts of the removed machines. Choose between `Delete`, `Disable` and `Leave`. Default is `Delete`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(citrixorchestration.MACHINEACCOUNTDELETEOPTION_DELETE)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(citrixorchestration.MACHINEACCOUNTDELETEOPTION_DELETE),
+						string(citrixorchestration.MACHINEACCOUNTDELETEOPTION_DISABLE),
+						string(citrixorchestration.MACHINEACCOUNTDELETEOPTION_LEAVE),
+					),
+				},
+			},
+			"delete_vm": schema.BoolAttribute{
+				Description: "Delete the virtual machines of the removed machines from the hypervisor. When set to `false`, the virtual machines are only removed from the catalog and left on the hypervisor. Default is `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (ScaleInPolicyModel) GetAttributes() map[string]schema.Attribute {
+	return ScaleInPolicyModel{}.GetSchema().Attributes
+}
+
+type PreparedImageModel struct {
+	ImageDefinition types.String `tfsdk:"image_definition"`
+	ImageVersion    types.String `tfsdk:"image_version"`
+}
+
+func (PreparedImageModel) GetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Prepared image version used by the machine catalog instead of a master image. " +
+			"When the referenced image version changes, the new image version is applied to the catalog with the `image_update_reboot_options` of the machine config. " +
+			"Only supported for Azure and vSphere MCS catalogs.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"image_definition": schema.StringAttribute{
+				Description: "Id of the image definition.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+			},
+			"image_version": schema.StringAttribute{
+				Description: "Id of the image version of the image definition.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+			},
+		},
+	}
+}
+
+func (PreparedImageModel) GetAttributes() map[string]schema.Attribute {
+	return PreparedImageModel{}.GetSchema().Attributes
+}
+
+type ImageHistoryModel struct {
+	ImagePath       types.String `tfsdk:"image_path"`
+	MasterImageNote types.String `tfsdk:"master_image_note"`
+	Status          types.String `tfsdk:"status"`
+	Date            types.String `tfsdk:"date"`
+}
+
+func (ImageHistoryModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"image_path": schema.StringAttribute{
+				Description: "Path of the master image on the hypervisor.",
+				Computed:    true,
+			},
+			"master_image_note": schema.StringAttribute{
+				Description: "The note of the master image.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the master image. Possible values are `Current`, `Prepared`, `Deleted` and `Unknown`.",
+				Computed:    true,
+			},
+			"date": schema.StringAttribute{
+				Description: "The date and time when the master image was used by the machine catalog.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (ImageHistoryModel) GetAttributes() map[string]schema.Attribute {
+	return ImageHistoryModel{}.GetSchema().Attributes
+}
+
 type CustomPropertyModel struct {
 	Name  types.String `tfsdk:"name"`
 	Value types.String `tfsdk:"value"`
@@ -402,6 +655,47 @@ func (NetworkMappingModel) GetAttributes() map[string]schema.Attribute {
 	return NetworkMappingModel{}.GetSchema().Attributes
 }
 
+type MachineSettingsModel struct {
+	InMaintenanceMode types.Bool   `tfsdk:"in_maintenance_mode"`
+	PowerState        types.String `tfsdk:"power_state"`
+	Tags              types.Set    `tfsdk:"tags"` // Set[string]
+}
+
+func (MachineSettingsModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"in_maintenance_mode": schema.BoolAttribute{
+				Description: "Specify if the machine should be in maintenance mode. When omitted, the maintenance mode of the machine is not managed.",
+				Optional:    true,
+			},
+			"power_state": schema.StringAttribute{
+				Description: "Desired power state of the machine. Choose between `On` and `Off`. When omitted, the power state of the machine is not managed. Only supported for power managed machines.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(citrixorchestration.POWERSTATE_ON),
+						string(citrixorchestration.POWERSTATE_OFF),
+					),
+				},
+			},
+			"tags": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "Names of the tags to be associated with the machine. The tags must already exist. Tags of the machine that are not in this set are removed. When omitted, the tags of the machine are not managed.",
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (MachineSettingsModel) GetAttributes() map[string]schema.Attribute {
+	return MachineSettingsModel{}.GetSchema().Attributes
+}
+
 // ensure RemotePcOuModel implements RefreshableListItemWithAttributes
 var _ util.RefreshableListItemWithAttributes[citrixorchestration.RemotePCEnrollmentScopeResponseModel] = RemotePcOuModel{}
 
@@ -437,6 +731,7 @@ func (MachineCatalogResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages a machine catalog.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the machine catalog.",
 				Computed:    true,
@@ -559,7 +854,16 @@ func (MachineCatalogResourceModel) GetSchema() schema.Schema {
 					),
 				},
 			},
+			"machine_settings": schema.MapNestedAttribute{
+				Description:  "Settings of individual machines in the machine catalog, keyed by machine name. The machine name can be specified with or without the domain, e.g. `DOMAIN\\\\machine-01` or `machine-01`. Changes are applied to the machines in a single batch after the machines are added to the machine catalog. Removing a machine from this map leaves its settings unchanged.",
+				Optional:     true,
+				NestedObject: MachineSettingsModel{}.GetSchema(),
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
 			"provisioning_scheme": ProvisioningSchemeModel{}.GetSchema(),
+			"timeouts":            MachineCatalogTimeouts{}.GetSchema(),
 		},
 	}
 }
@@ -615,6 +919,8 @@ func (r MachineCatalogResourceModel) RefreshPropertyValues(ctx context.Context,
 
 	r = r.updateCatalogWithRemotePcConfig(ctx, diagnostics, catalog)
 
+	r = r.updateCatalogWithMachineSettings(ctx, diagnostics, machines)
+
 	if catalog.ProvisioningScheme == nil {
 		if attributesMap, err := util.AttributeMapFromObject(ProvisioningSchemeModel{}); err == nil {
 			r.ProvisioningScheme = types.ObjectNull(attributesMap)
@@ -0,0 +1,117 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &MachineCatalogsDataSource{}
+)
+
+func NewMachineCatalogsDataSource() datasource.DataSource {
+	return &MachineCatalogsDataSource{}
+}
+
+// MachineCatalogsDataSource defines the data source implementation.
+type MachineCatalogsDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *MachineCatalogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_catalogs"
+}
+
+func (d *MachineCatalogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = MachineCatalogsDataSourceModel{}.GetSchema()
+}
+
+func (d *MachineCatalogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *MachineCatalogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data MachineCatalogsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := util.NewListFilter(data.NameRegex.ValueString(), data.AdminFolderPath.ValueString(), data.Scope.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		return
+	}
+
+	catalogs, err := getMachineCatalogs(ctx, d.client, &resp.Diagnostics, data)
+	if err != nil {
+		return
+	}
+
+	result := []citrixorchestration.MachineCatalogResponseModel{}
+	for _, catalog := range catalogs {
+		if filter.MatchesName(catalog.GetName()) && filter.MatchesFolder(catalog.GetAdminFolder()) && filter.MatchesScopes(catalog.GetScopes()) {
+			result = append(result, catalog)
+		}
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, result)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getMachineCatalogs retrieves all pages of machine catalogs with the tag of the data source, or in the admin folder of the data source when no tag is specified
+func getMachineCatalogs(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, data MachineCatalogsDataSourceModel) ([]citrixorchestration.MachineCatalogResponseModel, error) {
+	if tag := data.Tag.ValueString(); tag != "" {
+		request := client.ApiClient.TagsAPIsDAAS.TagsGetTagMachineCatalogs(ctx, tag).Limit(util.ListDataSourcePageSize)
+		return util.GetAllPages[citrixorchestration.MachineCatalogResponseModel](diagnostics, "Error listing Machine Catalogs with tag "+tag, func(continuationToken string) (*citrixorchestration.MachineCatalogResponseModelCollection, *http.Response, error) {
+			if continuationToken != "" {
+				request = request.ContinuationToken(continuationToken)
+			}
+			return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.MachineCatalogResponseModelCollection](request, client)
+		})
+	}
+
+	request := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalogs(ctx).Limit(util.ListDataSourcePageSize)
+	if adminFolderPath := util.NormalizeFolderPath(data.AdminFolderPath.ValueString()); adminFolderPath != "" {
+		request = request.AdminFolder(adminFolderPath)
+	}
+	return util.GetAllPages[citrixorchestration.MachineCatalogResponseModel](diagnostics, "Error listing Machine Catalogs", func(continuationToken string) (*citrixorchestration.MachineCatalogResponseModelCollection, *http.Response, error) {
+		if continuationToken != "" {
+			request = request.ContinuationToken(continuationToken)
+		}
+		return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.MachineCatalogResponseModelCollection](request, client)
+	})
+}
@@ -0,0 +1,135 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MachineCatalogsDataSourceModel defines the Machine Catalogs data source implementation.
+type MachineCatalogsDataSourceModel struct {
+	NameRegex       types.String                 `tfsdk:"name_regex"`
+	AdminFolderPath types.String                 `tfsdk:"admin_folder_path"`
+	Scope           types.String                 `tfsdk:"scope"`
+	Tag             types.String                 `tfsdk:"tag"`
+	MachineCatalogs []MachineCatalogSummaryModel `tfsdk:"machine_catalogs"`
+	Site            types.String                 `tfsdk:"site"`
+}
+
+func (MachineCatalogsDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source for the list of machine catalogs, optionally filtered by name, admin folder, delegated admin scope and tag.",
+
+		Attributes: map[string]schema.Attribute{
+			"site":              util.GetSiteDataSourceSchema(),
+			"name_regex":        util.GetNameRegexFilterDataSourceSchema("machine catalogs"),
+			"admin_folder_path": util.GetFolderPathFilterDataSourceSchema("machine catalogs", "admin folder"),
+			"scope":             util.GetScopeFilterDataSourceSchema("machine catalogs"),
+			"tag":               util.GetTagFilterDataSourceSchema("machine catalogs"),
+			"machine_catalogs": schema.ListNestedAttribute{
+				Description:  "The machine catalogs matching the filters.",
+				Computed:     true,
+				NestedObject: MachineCatalogSummaryModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+// MachineCatalogSummaryModel defines the summary of a machine catalog returned by the Machine Catalogs data source.
+type MachineCatalogSummaryModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	AdminFolderPath  types.String `tfsdk:"admin_folder_path"`
+	Zone             types.String `tfsdk:"zone"`
+	SessionSupport   types.String `tfsdk:"session_support"`
+	ProvisioningType types.String `tfsdk:"provisioning_type"`
+	AllocationType   types.String `tfsdk:"allocation_type"`
+	IsPowerManaged   types.Bool   `tfsdk:"is_power_managed"`
+	TotalMachines    types.Int64  `tfsdk:"total_machines"`
+	Scopes           types.List   `tfsdk:"scopes"` // List[string]
+}
+
+func (MachineCatalogSummaryModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the machine catalog.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the machine catalog.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the machine catalog.",
+				Computed:    true,
+			},
+			"admin_folder_path": schema.StringAttribute{
+				Description: "Path of the admin folder of the machine catalog. Empty when the machine catalog is in the root folder.",
+				Computed:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "GUID identifier of the zone of the machine catalog.",
+				Computed:    true,
+			},
+			"session_support": schema.StringAttribute{
+				Description: "Session support of the machine catalog.",
+				Computed:    true,
+			},
+			"provisioning_type": schema.StringAttribute{
+				Description: "Provisioning type of the machine catalog.",
+				Computed:    true,
+			},
+			"allocation_type": schema.StringAttribute{
+				Description: "Allocation type of the machine catalog.",
+				Computed:    true,
+			},
+			"is_power_managed": schema.BoolAttribute{
+				Description: "Indicates whether the machines of the machine catalog are power managed.",
+				Computed:    true,
+			},
+			"total_machines": schema.Int64Attribute{
+				Description: "Number of machines in the machine catalog.",
+				Computed:    true,
+			},
+			"scopes": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "GUID identifiers of the delegated admin scopes of the machine catalog.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r MachineCatalogsDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, catalogs []citrixorchestration.MachineCatalogResponseModel) MachineCatalogsDataSourceModel {
+	res := []MachineCatalogSummaryModel{}
+	for _, catalog := range catalogs {
+		adminFolder := catalog.GetAdminFolder()
+		zone := catalog.GetZone()
+		res = append(res, MachineCatalogSummaryModel{
+			Id:               types.StringValue(catalog.GetId()),
+			Name:             types.StringValue(catalog.GetName()),
+			Description:      types.StringValue(catalog.GetDescription()),
+			AdminFolderPath:  types.StringValue(util.NormalizeFolderPath(adminFolder.GetName())),
+			Zone:             types.StringValue(zone.GetId()),
+			SessionSupport:   types.StringValue(string(catalog.GetSessionSupport())),
+			ProvisioningType: types.StringValue(string(catalog.GetProvisioningType())),
+			AllocationType:   types.StringValue(string(catalog.GetAllocationType())),
+			IsPowerManaged:   types.BoolValue(catalog.GetIsPowerManaged()),
+			TotalMachines:    types.Int64Value(int64(catalog.GetTotalCount())),
+			Scopes:           util.StringArrayToStringList(ctx, diagnostics, util.GetIdsForScopeObjects(catalog.GetScopes())),
+		})
+	}
+
+	r.MachineCatalogs = res
+
+	return r
+}
@@ -293,8 +293,8 @@ func (VsphereMachineConfigModel) GetSchema() schema.SingleNestedAttribute {
 		Optional:    true,
 		Attributes: map[string]schema.Attribute{
 			"master_image_vm": schema.StringAttribute{
-				Description: "The name of the virtual machine that will be used as master image. This property is case sensitive.",
-				Required:    true,
+				Description: "The name of the virtual machine that will be used as master image. This property is case sensitive. Required unless `provisioning_scheme.prepared_image` is specified.",
+				Optional:    true,
 			},
 			"image_snapshot": schema.StringAttribute{
 				Description: "The Snapshot of the virtual machine specified in `master_image_vm`. Specify the relative path of the snapshot. Eg: snaphost-1/snapshot-2/snapshot-3. This property is case sensitive.",
@@ -640,7 +640,7 @@ func (AzurePvsConfigurationModel) GetSchema() schema.SingleNestedAttribute {
 			},
 		},
 		Validators: []validator.Object{
-			objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("azure_pvs_config"), path.MatchRelative().AtParent().AtName("azure_master_image")),
+			objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("azure_pvs_config"), path.MatchRelative().AtParent().AtName("azure_master_image"), path.MatchRelative().AtParent().AtParent().AtName("prepared_image")),
 		},
 	}
 }
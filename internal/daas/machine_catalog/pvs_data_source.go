@@ -45,6 +45,11 @@ func (d *PvsDataSource) Configure(ctx context.Context, req datasource.ConfigureR
 func (d *PvsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data PvsDataSourceModel
 
 	// Read Terraform configuration data into the model
@@ -4,6 +4,7 @@ package machine_catalog
 
 import (
 	"context"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -17,6 +18,7 @@ type PvsDataSourceModel struct {
 	StoreName types.String `tfsdk:"pvs_store_name"`
 	VdiskId   types.String `tfsdk:"pvs_vdisk_id"`
 	VdiskName types.String `tfsdk:"pvs_vdisk_name"`
+	Site      types.String `tfsdk:"site"`
 }
 
 func (r PvsDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, pvsSiteId string, pvsVdiskId string) PvsDataSourceModel {
@@ -31,6 +33,7 @@ func (PvsDataSourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- PVS Configuration to create machine catalog using PVSStreaming.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
 			"pvs_farm_name": schema.StringAttribute{
 				Description: "Name of the PVS farm.",
 				Required:    true,
@@ -46,6 +46,11 @@ type policySetResource struct {
 func (r *policySetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -138,6 +143,11 @@ func (*policySetResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 func (r *policySetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan PolicySetResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -264,6 +274,11 @@ func (r *policySetResource) Create(ctx context.Context, req resource.CreateReque
 func (r *policySetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state PolicySetResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -301,6 +316,11 @@ func (r *policySetResource) Read(ctx context.Context, req resource.ReadRequest,
 func (r *policySetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan PolicySetResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -499,6 +519,11 @@ func (r *policySetResource) Update(ctx context.Context, req resource.UpdateReque
 func (r *policySetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state PolicySetResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -675,7 +700,7 @@ func readPolicies(ctx context.Context, client *citrixdaasclient.CitrixDaasClient
 func generateBatchApiHeaders(client *citrixdaasclient.CitrixDaasClient) ([]citrixorchestration.NameValueStringPairModel, *http.Response, error) {
 	headers := []citrixorchestration.NameValueStringPairModel{}
 
-	cwsAuthToken, httpResp, err := client.SignIn()
+	cwsAuthToken, httpResp, err := util.SignIn(client)
 	var token string
 	if err != nil {
 		return headers, httpResp, err
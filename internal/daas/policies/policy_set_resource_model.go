@@ -497,12 +497,14 @@ type PolicySetResourceModel struct {
 	Scopes      types.Set    `tfsdk:"scopes"` // []types.Set
 	IsAssigned  types.Bool   `tfsdk:"assigned"`
 	Policies    types.List   `tfsdk:"policies"` // []PolicyModel
+	Site        types.String `tfsdk:"site"`
 }
 
 func (PolicySetResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages a policy set and the policies within it. The order of the policies specified in this resource reflect the policy priority.", // TODO: Update this comment when policy set is available for cloud
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the policy set.",
 				Computed:    true,
@@ -0,0 +1,107 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package policies
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &PolicySetsDataSource{}
+)
+
+func NewPolicySetsDataSource() datasource.DataSource {
+	return &PolicySetsDataSource{}
+}
+
+// PolicySetsDataSource defines the data source implementation.
+type PolicySetsDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *PolicySetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_sets"
+}
+
+func (d *PolicySetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = PolicySetsDataSourceModel{}.GetSchema()
+}
+
+func (d *PolicySetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *PolicySetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data PolicySetsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := util.NewListFilter(data.NameRegex.ValueString(), "", data.Scope.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		return
+	}
+
+	policySets, err := getPolicySets(ctx, d.client, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	// Orchestration returns the scopes of the policy sets by name
+	getAdminScopesRequest := d.client.ApiClient.AdminAPIsDAAS.AdminGetAdminScopes(ctx)
+	scopes, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.ScopeResponseModelCollection](getAdminScopesRequest, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Admin Scopes",
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+	scopeIdsByName := map[string]string{}
+	for _, scope := range scopes.GetItems() {
+		scopeIdsByName[scope.GetName()] = scope.GetId()
+	}
+
+	result := []citrixorchestration.PolicySetResponse{}
+	for _, policySet := range policySets {
+		if filter.MatchesName(policySet.GetName()) && filter.MatchesScopeNamesOrIds(policySet.GetScopes(), getPolicySetScopeIds(policySet, scopeIdsByName)) {
+			result = append(result, policySet)
+		}
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, result, scopeIdsByName)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,104 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package policies
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PolicySetsDataSourceModel defines the Policy Sets data source implementation.
+type PolicySetsDataSourceModel struct {
+	NameRegex  types.String            `tfsdk:"name_regex"`
+	Scope      types.String            `tfsdk:"scope"`
+	PolicySets []PolicySetSummaryModel `tfsdk:"policy_sets"`
+	Site       types.String            `tfsdk:"site"`
+}
+
+func (PolicySetsDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source for the list of policy sets, optionally filtered by name and delegated admin scope.",
+
+		Attributes: map[string]schema.Attribute{
+			"site":       util.GetSiteDataSourceSchema(),
+			"name_regex": util.GetNameRegexFilterDataSourceSchema("policy sets"),
+			"scope":      util.GetScopeFilterDataSourceSchema("policy sets"),
+			"policy_sets": schema.ListNestedAttribute{
+				Description:  "The policy sets matching the filters.",
+				Computed:     true,
+				NestedObject: PolicySetSummaryModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+// PolicySetSummaryModel defines the summary of a policy set returned by the Policy Sets data source.
+type PolicySetSummaryModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	Scopes      types.List   `tfsdk:"scopes"` // List[string]
+}
+
+func (PolicySetSummaryModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the policy set.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the policy set.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the policy set.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the policy set.",
+				Computed:    true,
+			},
+			"scopes": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "GUID identifiers of the delegated admin scopes of the policy set.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r PolicySetsDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, policySets []citrixorchestration.PolicySetResponse, scopeIdsByName map[string]string) PolicySetsDataSourceModel {
+	res := []PolicySetSummaryModel{}
+	for _, policySet := range policySets {
+		res = append(res, PolicySetSummaryModel{
+			Id:          types.StringValue(policySet.GetPolicySetGuid()),
+			Name:        types.StringValue(policySet.GetName()),
+			Description: types.StringValue(policySet.GetDescription()),
+			Type:        types.StringValue(string(policySet.GetPolicySetType())),
+			Scopes:      util.StringArrayToStringList(ctx, diagnostics, getPolicySetScopeIds(policySet, scopeIdsByName)),
+		})
+	}
+
+	r.PolicySets = res
+
+	return r
+}
+
+// getPolicySetScopeIds returns the IDs of the scopes of the policy set, which Orchestration returns by name, excluding the All scope
+func getPolicySetScopeIds(policySet citrixorchestration.PolicySetResponse, scopeIdsByName map[string]string) []string {
+	scopeIds := []string{}
+	for _, scopeName := range policySet.GetScopes() {
+		if scopeId := scopeIdsByName[scopeName]; scopeId != "" && scopeId != util.AllScopeId {
+			scopeIds = append(scopeIds, scopeId)
+		}
+	}
+	return scopeIds
+}
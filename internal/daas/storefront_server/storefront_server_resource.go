@@ -44,6 +44,7 @@ func (r *storeFrontServerResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		Description: "CVAD --- Manages a StoreFront server.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the StoreFront server.",
 				Computed:    true,
@@ -67,6 +68,7 @@ func (r *storeFrontServerResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "Indicates if the StoreFront server is enabled.",
 				Required:    true,
 			},
+			"timeouts": StoreFrontServerTimeouts{}.GetSchema(),
 		},
 	}
 }
@@ -84,6 +86,11 @@ func (r *storeFrontServerResource) Configure(_ context.Context, req resource.Con
 func (r *storeFrontServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan StoreFrontServerResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -113,7 +120,8 @@ func (r *storeFrontServerResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	err = util.ProcessAsyncJobResponse(ctx, r.client, httpResp, "Error creating StoreFront Server "+plan.Name.ValueString(), &resp.Diagnostics, 10, true)
+	timeouts := util.ObjectValueToTypedObject[StoreFrontServerTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	err = util.ProcessAsyncJobResponse(ctx, r.client, httpResp, "Error creating StoreFront Server "+plan.Name.ValueString(), &resp.Diagnostics, util.GetTimeoutValue(timeouts.Create, util.DefaultStoreFrontServerCreateTimeout), true)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating StoreFront Server "+plan.Name.ValueString(),
@@ -144,6 +152,11 @@ func (r *storeFrontServerResource) Create(ctx context.Context, req resource.Crea
 func (r *storeFrontServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state StoreFrontServerResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -180,6 +193,11 @@ func (r *storeFrontServerResource) Read(ctx context.Context, req resource.ReadRe
 func (r *storeFrontServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan StoreFrontServerResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -212,7 +230,8 @@ func (r *storeFrontServerResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	err = util.ProcessAsyncJobResponse(ctx, r.client, httpResp, "Error updating StoreFront Server "+sfServerName, &resp.Diagnostics, 10, true)
+	timeouts := util.ObjectValueToTypedObject[StoreFrontServerTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	err = util.ProcessAsyncJobResponse(ctx, r.client, httpResp, "Error updating StoreFront Server "+sfServerName, &resp.Diagnostics, util.GetTimeoutValue(timeouts.Update, util.DefaultStoreFrontServerUpdateTimeout), true)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating StoreFront Server "+sfServerName,
@@ -247,6 +266,11 @@ func (r *storeFrontServerResource) Update(ctx context.Context, req resource.Upda
 func (r *storeFrontServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state StoreFrontServerResourceModel
 	diags := req.State.Get(ctx, &state)
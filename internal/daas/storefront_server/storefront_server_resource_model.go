@@ -4,7 +4,9 @@ package storefront_server
 
 import (
 	citrixorchestration "github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -15,6 +17,29 @@ type StoreFrontServerResourceModel struct {
 	Description types.String `tfsdk:"description"`
 	Url         types.String `tfsdk:"url"`
 	Enabled     types.Bool   `tfsdk:"enabled"`
+	Timeouts    types.Object `tfsdk:"timeouts"` // StoreFrontServerTimeouts
+	Site        types.String `tfsdk:"site"`
+}
+
+type StoreFrontServerTimeouts struct {
+	Create types.Int64 `tfsdk:"create"`
+	Update types.Int64 `tfsdk:"update"`
+}
+
+func (StoreFrontServerTimeouts) GetSchema() schema.SingleNestedAttribute {
+	return util.GetTimeoutsSchema("StoreFront server", util.TimeoutConfigs{
+		Create:        true,
+		CreateDefault: util.DefaultStoreFrontServerCreateTimeout,
+		CreateMin:     1,
+
+		Update:        true,
+		UpdateDefault: util.DefaultStoreFrontServerUpdateTimeout,
+		UpdateMin:     1,
+	})
+}
+
+func (StoreFrontServerTimeouts) GetAttributes() map[string]schema.Attribute {
+	return StoreFrontServerTimeouts{}.GetSchema().Attributes
 }
 
 func (r StoreFrontServerResourceModel) RefreshPropertyValues(sfServer *citrixorchestration.StoreFrontServerResponseModel) StoreFrontServerResourceModel {
@@ -0,0 +1,127 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package vda
+
+import (
+	"context"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &MachineDataSource{}
+)
+
+func NewMachineDataSource() datasource.DataSource {
+	return &MachineDataSource{}
+}
+
+// MachineDataSource defines the data source implementation.
+type MachineDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *MachineDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine"
+}
+
+func (d *MachineDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = MachineDataSourceModel{}.GetSchema()
+}
+
+func (d *MachineDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *MachineDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data MachineDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	machineNameOrSid := data.Sid.ValueString()
+	if !data.Name.IsNull() {
+		// Orchestration expects the domain separator of machine names to be replaced with '|'
+		machineNameOrSid = strings.ReplaceAll(data.Name.ValueString(), "\\", "|")
+	}
+
+	getMachineRequest := d.client.ApiClient.MachinesAPIsDAAS.MachinesGetMachine(ctx, machineNameOrSid)
+	machine, httpResp, err := citrixdaasclient.AddRequestData(getMachineRequest, d.client).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Machine "+machineNameOrSid,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	resourcePool, err := getMachineResourcePool(ctx, d.client, &resp.Diagnostics, machine)
+	if err != nil {
+		return
+	}
+
+	getMachineSessionsRequest := d.client.ApiClient.MachinesAPIsDAAS.MachinesGetMachineSessions(ctx, machine.GetId())
+	sessions, httpResp, err := citrixdaasclient.AddRequestData(getMachineSessionsRequest, d.client).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Sessions of Machine "+machine.GetName(),
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, machine, resourcePool, sessions.GetItems())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getMachineResourcePool returns the resource pool of the provisioning scheme of the machine catalog of an MCS provisioned machine, or nil for other machines
+func getMachineResourcePool(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, machine *citrixorchestration.MachineDetailResponseModel) (*citrixorchestration.HypervisorResourcePoolRefResponseModel, error) {
+	machineCatalog := machine.GetMachineCatalog()
+	if machine.GetProvisioningType() != citrixorchestration.PROVISIONINGTYPE_MCS || machineCatalog.GetId() == "" {
+		return nil, nil
+	}
+
+	catalog, err := util.GetMachineCatalog(ctx, client, diagnostics, machineCatalog.GetId(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	provScheme, ok := catalog.GetProvisioningSchemeOk()
+	if !ok {
+		return nil, nil
+	}
+	resourcePool := provScheme.GetResourcePool()
+	return &resourcePool, nil
+}
@@ -0,0 +1,199 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package vda
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MachineDataSourceModel defines the Machine data source implementation.
+type MachineDataSourceModel struct {
+	Id                     types.String          `tfsdk:"id"`
+	Name                   types.String          `tfsdk:"name"`
+	Sid                    types.String          `tfsdk:"sid"`
+	MachineCatalog         types.String          `tfsdk:"machine_catalog"`
+	DeliveryGroup          types.String          `tfsdk:"delivery_group"`
+	HostedMachineId        types.String          `tfsdk:"hosted_machine_id"`
+	Hypervisor             types.String          `tfsdk:"hypervisor"`
+	HypervisorResourcePool types.String          `tfsdk:"hypervisor_resource_pool"`
+	AssignedUsers          types.List            `tfsdk:"assigned_users"` // List[string]
+	Tags                   types.List            `tfsdk:"tags"`           // List[string]
+	RegistrationState      types.String          `tfsdk:"registration_state"`
+	PowerState             types.String          `tfsdk:"power_state"`
+	InMaintenanceMode      types.Bool            `tfsdk:"in_maintenance_mode"`
+	Sessions               []MachineSessionModel `tfsdk:"sessions"`
+	Site                   types.String          `tfsdk:"site"`
+}
+
+func (MachineDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source to get details regarding a specific machine, including its hosting details, assigned users and active sessions.",
+
+		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the machine.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the machine, in the format `{domain}\\{machine name}`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name"), path.MatchRoot("sid")), // Ensures that only one of either name or sid is provided. It will also cause a validation error if none are specified.
+				},
+			},
+			"sid": schema.StringAttribute{
+				Description: "Security identifier of the machine.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"machine_catalog": schema.StringAttribute{
+				Description: "GUID identifier of the machine catalog which the machine belongs to.",
+				Computed:    true,
+			},
+			"delivery_group": schema.StringAttribute{
+				Description: "GUID identifier of the delivery group which the machine belongs to. Empty when the machine is not in a delivery group.",
+				Computed:    true,
+			},
+			"hosted_machine_id": schema.StringAttribute{
+				Description: "Machine ID within the hypervisor hosting unit.",
+				Computed:    true,
+			},
+			"hypervisor": schema.StringAttribute{
+				Description: "GUID identifier of the hypervisor hosting the machine. Empty when the machine is not power managed.",
+				Computed:    true,
+			},
+			"hypervisor_resource_pool": schema.StringAttribute{
+				Description: "GUID identifier of the hypervisor resource pool used to provision the machine. Empty when the machine is not provisioned by MCS.",
+				Computed:    true,
+			},
+			"assigned_users": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Users assigned to the machine, in SamAccountName or UserPrincipalName format.",
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Tags associated with the machine.",
+				Computed:    true,
+			},
+			"registration_state": schema.StringAttribute{
+				Description: "Registration state of the machine.",
+				Computed:    true,
+			},
+			"power_state": schema.StringAttribute{
+				Description: "Power state of the machine.",
+				Computed:    true,
+			},
+			"in_maintenance_mode": schema.BoolAttribute{
+				Description: "Indicates whether the machine is in maintenance mode.",
+				Computed:    true,
+			},
+			"sessions": schema.ListNestedAttribute{
+				Description:  "The active sessions hosted by the machine.",
+				Computed:     true,
+				NestedObject: MachineSessionModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+// MachineSessionModel defines the session data model of the Machine data source.
+type MachineSessionModel struct {
+	Id         types.String `tfsdk:"id"`
+	User       types.String `tfsdk:"user"`
+	ClientName types.String `tfsdk:"client_name"`
+	State      types.String `tfsdk:"state"`
+	StartTime  types.String `tfsdk:"start_time"`
+}
+
+func (MachineSessionModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the session.",
+				Computed:    true,
+			},
+			"user": schema.StringAttribute{
+				Description: "User of the session, in SamAccountName or UserPrincipalName format.",
+				Computed:    true,
+			},
+			"client_name": schema.StringAttribute{
+				Description: "Name of the client device the session is connected from.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the session.",
+				Computed:    true,
+			},
+			"start_time": schema.StringAttribute{
+				Description: "Time the session was started.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r MachineDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, machine *citrixorchestration.MachineDetailResponseModel, resourcePool *citrixorchestration.HypervisorResourcePoolRefResponseModel, sessions []citrixorchestration.SessionResponseModel) MachineDataSourceModel {
+	machineCatalog := machine.GetMachineCatalog()
+	deliveryGroup := machine.GetDeliveryGroup()
+	hosting := machine.GetHosting()
+	hypervisor := hosting.GetHypervisorConnection()
+
+	r.Id = types.StringValue(machine.GetId())
+	r.Name = types.StringValue(machine.GetName())
+	r.Sid = types.StringValue(machine.GetSid())
+	r.MachineCatalog = types.StringValue(machineCatalog.GetId())
+	r.DeliveryGroup = types.StringValue(deliveryGroup.GetId())
+	r.HostedMachineId = types.StringValue(hosting.GetHostedMachineId())
+	r.Hypervisor = types.StringValue(hypervisor.GetId())
+	r.HypervisorResourcePool = types.StringValue("")
+	if resourcePool != nil {
+		r.HypervisorResourcePool = types.StringValue(resourcePool.GetId())
+	}
+	r.RegistrationState = types.StringValue(string(machine.GetRegistrationState()))
+	r.PowerState = types.StringValue(string(machine.GetPowerState()))
+	r.InMaintenanceMode = types.BoolValue(machine.GetInMaintenanceMode())
+
+	assignedUsers := []string{}
+	for _, user := range machine.GetAssignedUsers() {
+		assignedUsers = append(assignedUsers, getUserName(user))
+	}
+	r.AssignedUsers = util.StringArrayToStringList(ctx, diagnostics, assignedUsers)
+	r.Tags = util.StringArrayToStringList(ctx, diagnostics, machine.GetTags())
+
+	res := []MachineSessionModel{}
+	for _, session := range sessions {
+		user := session.GetUser()
+		client := session.GetClient()
+		res = append(res, MachineSessionModel{
+			Id:         types.StringValue(session.GetId()),
+			User:       types.StringValue(getUserName(user)),
+			ClientName: types.StringValue(client.GetName()),
+			State:      types.StringValue(string(session.GetState())),
+			StartTime:  types.StringValue(session.GetStartTime()),
+		})
+	}
+	r.Sessions = res
+
+	return r
+}
+
+// getUserName returns the SamAccountName of a user, or the UserPrincipalName for users without one
+func getUserName(user citrixorchestration.IdentityUserResponseModel) string {
+	if samName := user.GetSamName(); samName != "" {
+		return samName
+	}
+	return user.GetPrincipalName()
+}
@@ -4,13 +4,24 @@ package vda
 
 import (
 	"context"
+	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// Number of VDAs requested per page, so that machine catalogs and delivery groups with thousands of machines are retrieved in several requests
+const vdaPageSize int32 = 1000
+
+const vdaFields = "Id,Name,Hosting,MachineCatalog,DeliveryGroup,RegistrationState,PowerState,InMaintenanceMode,AgentVersion,OSType,SessionCount,LastDeregistrationReason,IPAddress,Tags"
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ datasource.DataSource = &VdaDataSource{}
@@ -45,6 +56,11 @@ func (d *VdaDataSource) Configure(ctx context.Context, req datasource.ConfigureR
 func (d *VdaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if d.client != nil && d.client.ApiClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -59,39 +75,142 @@ func (d *VdaDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	// Get refreshed machine catalog state from Orchestration
-	machineCatalogId := data.MachineCatalog.ValueString()
-	if machineCatalogId != "" {
-		getMachineCatalogMachinesRequest := d.client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalogMachines(ctx, machineCatalogId)
-		machineCatalogVdas, httpResp, err := citrixdaasclient.AddRequestData(getMachineCatalogMachinesRequest, d.client).Execute()
+	vdas, err := getVdas(ctx, d.client, &resp.Diagnostics, data)
+	if err != nil {
+		return
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, vdas)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getVdas retrieves all pages of VDAs of the machine catalog or delivery group of the data source that match its filters
+func getVdas(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, data VdaDataSourceModel) ([]citrixorchestration.MachineResponseModel, error) {
+	var vdas []citrixorchestration.MachineResponseModel
+	var err error
+	if data.hasFilters() {
+		vdas, err = searchVdas(ctx, client, diagnostics, data)
+	} else if machineCatalogId := data.MachineCatalog.ValueString(); machineCatalogId != "" {
+		vdas, err = getMachineCatalogVdas(ctx, client, diagnostics, machineCatalogId)
+	} else {
+		vdas, err = getDeliveryGroupVdas(ctx, client, diagnostics, data.DeliveryGroup.ValueString())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := []citrixorchestration.MachineResponseModel{}
+	for _, vda := range vdas {
+		if data.matchesFilters(vda) {
+			result = append(result, vda)
+		}
+	}
+	return result, nil
+}
+
+func getMachineCatalogVdas(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, machineCatalogId string) ([]citrixorchestration.MachineResponseModel, error) {
+	request := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalogMachines(ctx, machineCatalogId).Fields(vdaFields).Limit(vdaPageSize)
+	return util.GetAllPages[citrixorchestration.MachineResponseModel](diagnostics, "Error listing Machine Catalog VDAs "+machineCatalogId, func(continuationToken string) (*citrixorchestration.MachineResponseModelCollection, *http.Response, error) {
+		if continuationToken != "" {
+			request = request.ContinuationToken(continuationToken)
+		}
+		return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.MachineResponseModelCollection](request, client)
+	})
+}
+
+func getDeliveryGroupVdas(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, deliveryGroupId string) ([]citrixorchestration.MachineResponseModel, error) {
+	request := client.ApiClient.DeliveryGroupsAPIsDAAS.DeliveryGroupsGetDeliveryGroupMachines(ctx, deliveryGroupId).Limit(vdaPageSize)
+	return util.GetAllPages[citrixorchestration.MachineResponseModel](diagnostics, "Error listing Delivery Group VDAs "+deliveryGroupId, func(continuationToken string) (*citrixorchestration.MachineResponseModelCollection, *http.Response, error) {
+		if continuationToken != "" {
+			request = request.ContinuationToken(continuationToken)
+		}
+		return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.MachineResponseModelCollection](request, client)
+	})
+}
+
+// searchVdas searches the VDAs of the machine catalog or delivery group with the filters of the data source applied by the Orchestration service
+func searchVdas(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, data VdaDataSourceModel) ([]citrixorchestration.MachineResponseModel, error) {
+	filters := []citrixorchestration.MachineAndSessionSearchFilterRequestModel{}
+	addFilter := func(property citrixorchestration.MachineAndSessionSearchProperty, operator citrixorchestration.SearchOperator, value string) {
+		filter := citrixorchestration.NewMachineAndSessionSearchFilterRequestModel(property, operator)
+		filter.SetValue(value)
+		filters = append(filters, *filter)
+	}
 
+	// Machine searches identify the machine catalog and delivery group by name
+	if machineCatalogId := data.MachineCatalog.ValueString(); machineCatalogId != "" {
+		getMachineCatalogRequest := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalog(ctx, machineCatalogId).Fields("Id,Name,FullName")
+		machineCatalog, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.MachineCatalogDetailResponseModel](getMachineCatalogRequest, client)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error listing Machine Catalog VDAs"+machineCatalogId,
+			diagnostics.AddError(
+				"Error reading Machine Catalog "+machineCatalogId,
 				"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 					"\nError message: "+util.ReadClientError(err),
 			)
+			return nil, err
 		}
-
-		data = data.RefreshPropertyValues(machineCatalogVdas)
-	}
-
-	deliveryGroupId := data.DeliveryGroup.ValueString()
-	if deliveryGroupId != "" {
-		getDeliveryGroupMachinesRequest := d.client.ApiClient.DeliveryGroupsAPIsDAAS.DeliveryGroupsGetDeliveryGroupMachines(ctx, deliveryGroupId)
-		deliveryGroupVdas, httpResp, err := citrixdaasclient.AddRequestData(getDeliveryGroupMachinesRequest, d.client).Execute()
-
+		addFilter(citrixorchestration.MACHINEANDSESSIONSEARCHPROPERTY_MACHINE_CATALOG, citrixorchestration.SEARCHOPERATOR_EQUALS, getFullName(machineCatalog.GetFullName(), machineCatalog.GetName()))
+		data.MachineCatalog = types.StringValue(machineCatalog.GetId())
+	} else {
+		deliveryGroupId := data.DeliveryGroup.ValueString()
+		getDeliveryGroupRequest := client.ApiClient.DeliveryGroupsAPIsDAAS.DeliveryGroupsGetDeliveryGroup(ctx, deliveryGroupId).Fields("Id,Name,FullName")
+		deliveryGroup, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.DeliveryGroupDetailResponseModel](getDeliveryGroupRequest, client)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error listing Delivery Group VDAs"+deliveryGroupId,
+			diagnostics.AddError(
+				"Error reading Delivery Group "+deliveryGroupId,
 				"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 					"\nError message: "+util.ReadClientError(err),
 			)
+			return nil, err
 		}
+		addFilter(citrixorchestration.MACHINEANDSESSIONSEARCHPROPERTY_DELIVERY_GROUP, citrixorchestration.SEARCHOPERATOR_EQUALS, getFullName(deliveryGroup.GetFullName(), deliveryGroup.GetName()))
+		data.DeliveryGroup = types.StringValue(deliveryGroup.GetId())
+	}
 
-		data = data.RefreshPropertyValues(deliveryGroupVdas)
+	if !data.RegistrationState.IsNull() {
+		addFilter(citrixorchestration.MACHINEANDSESSIONSEARCHPROPERTY_REGISTRATION_STATE, citrixorchestration.SEARCHOPERATOR_EQUALS, data.RegistrationState.ValueString())
+	}
+	if !data.InMaintenanceMode.IsNull() {
+		addFilter(citrixorchestration.MACHINEANDSESSIONSEARCHPROPERTY_IN_MAINTENANCE_MODE, citrixorchestration.SEARCHOPERATOR_EQUALS, strconv.FormatBool(data.InMaintenanceMode.ValueBool()))
+	}
+	if !data.Tag.IsNull() {
+		addFilter(citrixorchestration.MACHINEANDSESSIONSEARCHPROPERTY_TAGS, citrixorchestration.SEARCHOPERATOR_EQUALS, data.Tag.ValueString())
+	}
+	if !data.NamePattern.IsNull() {
+		addFilter(citrixorchestration.MACHINEANDSESSIONSEARCHPROPERTY_MACHINE_NAME, citrixorchestration.SEARCHOPERATOR_LIKE, data.NamePattern.ValueString())
 	}
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	searchRequestBody := citrixorchestration.NewMachineAndSessionSearchRequestModel()
+	searchRequestBody.SetSearchFilters(filters)
+	request := client.ApiClient.MachinesAPIsDAAS.MachinesDoMachineSearch(ctx).MachineAndSessionSearchRequestModel(*searchRequestBody).Fields(vdaFields).Limit(vdaPageSize)
+	vdas, err := util.GetAllPages[citrixorchestration.MachineResponseModel](diagnostics, "Error searching VDAs", func(continuationToken string) (*citrixorchestration.MachineResponseModelCollection, *http.Response, error) {
+		if continuationToken != "" {
+			request = request.ContinuationToken(continuationToken)
+		}
+		return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.MachineResponseModelCollection](request, client)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Only keep the VDAs of the machine catalog or delivery group, in case names are matched partially
+	result := []citrixorchestration.MachineResponseModel{}
+	for _, vda := range vdas {
+		machineCatalog := vda.GetMachineCatalog()
+		deliveryGroup := vda.GetDeliveryGroup()
+		if (!data.MachineCatalog.IsNull() && strings.EqualFold(machineCatalog.GetId(), data.MachineCatalog.ValueString())) ||
+			(!data.DeliveryGroup.IsNull() && strings.EqualFold(deliveryGroup.GetId(), data.DeliveryGroup.ValueString())) {
+			result = append(result, vda)
+		}
+	}
+	return result, nil
+}
+
+func getFullName(fullName, name string) string {
+	if fullName != "" {
+		return fullName
+	}
+	return name
 }
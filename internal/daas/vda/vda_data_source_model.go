@@ -3,9 +3,15 @@
 package vda
 
 import (
+	"context"
+	"slices"
+	"strings"
+
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,17 +19,24 @@ import (
 
 // VdaDataSourceModel defines the VDA data source implementation.
 type VdaDataSourceModel struct {
-	MachineCatalog types.String `tfsdk:"machine_catalog"`
-	DeliveryGroup  types.String `tfsdk:"delivery_group"`
-	Vdas           []VdaModel   `tfsdk:"vdas"`
+	MachineCatalog    types.String `tfsdk:"machine_catalog"`
+	DeliveryGroup     types.String `tfsdk:"delivery_group"`
+	RegistrationState types.String `tfsdk:"registration_state"`
+	InMaintenanceMode types.Bool   `tfsdk:"in_maintenance_mode"`
+	Tag               types.String `tfsdk:"tag"`
+	NamePattern       types.String `tfsdk:"name_pattern"`
+	Vdas              []VdaModel   `tfsdk:"vdas"`
+	Site              types.String `tfsdk:"site"`
 }
 
 func (VdaDataSourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "CVAD --- Data source for the list of VDAs that belong to either a machine catalog or a delivery group. Machine catalog and delivery group cannot be specified at the same time.",
+		MarkdownDescription: "CVAD --- Data source for the list of VDAs that belong to either a machine catalog or a delivery group. Machine catalog and delivery group cannot be specified at the same time. " +
+			"The VDAs can be further filtered by registration state, maintenance mode, tag and name. Filters are applied by the Orchestration service, so that only matching VDAs are retrieved from large machine catalogs and delivery groups.",
 
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
 			"machine_catalog": schema.StringAttribute{
 				MarkdownDescription: "The machine catalog which the VDAs are associated with.",
 				Optional:            true,
@@ -35,6 +48,31 @@ func (VdaDataSourceModel) GetSchema() schema.Schema {
 				MarkdownDescription: "The delivery group which the VDAs are associated with.",
 				Optional:            true,
 			},
+			"registration_state": schema.StringAttribute{
+				MarkdownDescription: "Only return the VDAs with this registration state. Possible values are `Registered`, `Unregistered`, `Initializing`, `AgentError` and `Unknown`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(util.GetAllowedRegistrationStateValues()...),
+				},
+			},
+			"in_maintenance_mode": schema.BoolAttribute{
+				MarkdownDescription: "Only return the VDAs that are in maintenance mode when set to `true`, or that are not in maintenance mode when set to `false`.",
+				Optional:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Only return the VDAs with this tag.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name_pattern": schema.StringAttribute{
+				MarkdownDescription: "Only return the VDAs whose machine name matches this pattern. The pattern is case-insensitive and `*` matches any sequence of characters, e.g. `*web-*`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"vdas": schema.ListNestedAttribute{
 				Description:  "The VDAs associated with the specified machine catalog or delivery group.",
 				Computed:     true,
@@ -50,6 +88,15 @@ type VdaModel struct {
 	HostedMachineId          types.String `tfsdk:"hosted_machine_id"`
 	AssociatedMachineCatalog types.String `tfsdk:"associated_machine_catalog"`
 	AssociatedDeliveryGroup  types.String `tfsdk:"associated_delivery_group"`
+	RegistrationState        types.String `tfsdk:"registration_state"`
+	PowerState               types.String `tfsdk:"power_state"`
+	InMaintenanceMode        types.Bool   `tfsdk:"in_maintenance_mode"`
+	AgentVersion             types.String `tfsdk:"agent_version"`
+	OsType                   types.String `tfsdk:"os_type"`
+	SessionCount             types.Int64  `tfsdk:"session_count"`
+	LastDeregistrationReason types.String `tfsdk:"last_deregistration_reason"`
+	IpAddress                types.String `tfsdk:"ip_address"`
+	Tags                     types.List   `tfsdk:"tags"` // List[string]
 }
 
 func (VdaModel) GetSchema() schema.NestedAttributeObject {
@@ -71,31 +118,141 @@ func (VdaModel) GetSchema() schema.NestedAttributeObject {
 				Description: "Delivery group which the VDA is associated with.",
 				Computed:    true,
 			},
+			"registration_state": schema.StringAttribute{
+				Description: "Registration state of the VDA.",
+				Computed:    true,
+			},
+			"power_state": schema.StringAttribute{
+				Description: "Power state of the VDA.",
+				Computed:    true,
+			},
+			"in_maintenance_mode": schema.BoolAttribute{
+				Description: "Indicates whether the VDA is in maintenance mode.",
+				Computed:    true,
+			},
+			"agent_version": schema.StringAttribute{
+				Description: "Version of the VDA agent installed on the machine.",
+				Computed:    true,
+			},
+			"os_type": schema.StringAttribute{
+				Description: "Operating system of the VDA.",
+				Computed:    true,
+			},
+			"session_count": schema.Int64Attribute{
+				Description: "Number of sessions hosted by the VDA.",
+				Computed:    true,
+			},
+			"last_deregistration_reason": schema.StringAttribute{
+				Description: "Reason of the last deregistration of the VDA.",
+				Computed:    true,
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "IP address of the VDA.",
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Tags associated with the VDA.",
+				Computed:    true,
+			},
 		},
 	}
 }
 
-func (r VdaDataSourceModel) RefreshPropertyValues(vdas *citrixorchestration.MachineResponseModelCollection) VdaDataSourceModel {
+func (r VdaDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, vdas []citrixorchestration.MachineResponseModel) VdaDataSourceModel {
 
 	res := []VdaModel{}
-	for _, model := range vdas.GetItems() {
-		machineName := model.GetName()
-		hosting := model.GetHosting()
-		hostedMachineId := hosting.GetHostedMachineId()
-		machineCatalog := model.GetMachineCatalog()
-		machineCatalogId := machineCatalog.GetId()
-		deliveryGroup := model.GetDeliveryGroup()
-		deliveryGroupId := deliveryGroup.GetId()
-
-		res = append(res, VdaModel{
-			MachineName:              types.StringValue(machineName),
-			HostedMachineId:          types.StringValue(hostedMachineId),
-			AssociatedMachineCatalog: types.StringValue(machineCatalogId),
-			AssociatedDeliveryGroup:  types.StringValue(deliveryGroupId),
-		})
+	for _, model := range vdas {
+		res = append(res, NewVdaModel(ctx, diagnostics, model))
 	}
 
 	r.Vdas = res
 
 	return r
 }
+
+// <summary>
+// Helper function to convert a machine returned by Orchestration to the VDA data model
+// </summary>
+// <param name="ctx">Context</param>
+// <param name="diagnostics">Diagnostics</param>
+// <param name="model">Machine returned by Orchestration</param>
+// <returns>VDA data model</returns>
+func NewVdaModel(ctx context.Context, diagnostics *diag.Diagnostics, model citrixorchestration.MachineResponseModel) VdaModel {
+	machineName := model.GetName()
+	hosting := model.GetHosting()
+	hostedMachineId := hosting.GetHostedMachineId()
+	machineCatalog := model.GetMachineCatalog()
+	machineCatalogId := machineCatalog.GetId()
+	deliveryGroup := model.GetDeliveryGroup()
+	deliveryGroupId := deliveryGroup.GetId()
+
+	vda := VdaModel{
+		MachineName:              types.StringValue(machineName),
+		HostedMachineId:          types.StringValue(hostedMachineId),
+		AssociatedMachineCatalog: types.StringValue(machineCatalogId),
+		AssociatedDeliveryGroup:  types.StringValue(deliveryGroupId),
+		RegistrationState:        types.StringValue(string(model.GetRegistrationState())),
+		PowerState:               types.StringValue(string(model.GetPowerState())),
+		InMaintenanceMode:        types.BoolValue(model.GetInMaintenanceMode()),
+		AgentVersion:             types.StringValue(model.GetAgentVersion()),
+		OsType:                   types.StringValue(model.GetOSType()),
+		SessionCount:             types.Int64Value(int64(model.GetSessionCount())),
+		LastDeregistrationReason: types.StringValue(string(model.GetLastDeregistrationReason())),
+		IpAddress:                types.StringValue(model.GetIPAddress()),
+		Tags:                     types.ListNull(types.StringType),
+	}
+	if model.Tags != nil {
+		vda.Tags = util.StringArrayToStringList(ctx, diagnostics, model.GetTags())
+	}
+
+	return vda
+}
+
+// <summary>
+// Helper function to check whether a VDA matches the filters of the data source.
+// The filters are applied by the Orchestration service already, this ensures the result does not depend on how the service interprets them.
+// </summary>
+// <param name="vda">VDA returned by the Orchestration service</param>
+// <returns>Whether the VDA matches all filters</returns>
+func (r VdaDataSourceModel) matchesFilters(vda citrixorchestration.MachineResponseModel) bool {
+	if !r.RegistrationState.IsNull() && !strings.EqualFold(string(vda.GetRegistrationState()), r.RegistrationState.ValueString()) {
+		return false
+	}
+	if !r.InMaintenanceMode.IsNull() && vda.GetInMaintenanceMode() != r.InMaintenanceMode.ValueBool() {
+		return false
+	}
+	if !r.Tag.IsNull() && !slices.ContainsFunc(vda.GetTags(), func(tag string) bool { return strings.EqualFold(tag, r.Tag.ValueString()) }) {
+		return false
+	}
+	if !r.NamePattern.IsNull() && !matchesNamePattern(vda.GetName(), r.NamePattern.ValueString()) {
+		return false
+	}
+	return true
+}
+
+func (r VdaDataSourceModel) hasFilters() bool {
+	return !r.RegistrationState.IsNull() || !r.InMaintenanceMode.IsNull() || !r.Tag.IsNull() || !r.NamePattern.IsNull()
+}
+
+// matchesNamePattern matches a name against a case-insensitive pattern where * matches any sequence of characters
+func matchesNamePattern(name, pattern string) bool {
+	name = strings.ToLower(name)
+	parts := strings.Split(strings.ToLower(pattern), "*")
+	if len(parts) == 1 {
+		return name == parts[0]
+	}
+	if !strings.HasPrefix(name, parts[0]) {
+		return false
+	}
+	name = name[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(name, part)
+		if index < 0 {
+			return false
+		}
+		name = name[index+len(part):]
+	}
+	return len(name) >= len(last) && strings.HasSuffix(name, last)
+}
@@ -4,6 +4,7 @@ package zone
 
 import (
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -12,12 +13,14 @@ import (
 type ZoneDataSourceModel struct {
 	Id   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
+	Site types.String `tfsdk:"site"`
 }
 
 func (ZoneDataSourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Read data of an existing zone.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the zone.",
 				Computed:    true,
@@ -26,12 +26,14 @@ type ZoneResourceModel struct {
 	ResourceLocationId types.String `tfsdk:"resource_location_id"`
 	Description        types.String `tfsdk:"description"`
 	Metadata           types.List   `tfsdk:"metadata"` // []utils.NameValueStringPairModel
+	Site               types.String `tfsdk:"site"`
 }
 
 func (ZoneResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "CVAD --- Manages a zone.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the zone.",
 				Computed:    true,
@@ -0,0 +1,98 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package zone
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &ZonesDataSource{}
+)
+
+func NewZonesDataSource() datasource.DataSource {
+	return &ZonesDataSource{}
+}
+
+// ZonesDataSource defines the data source implementation.
+type ZonesDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *ZonesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zones"
+}
+
+func (d *ZonesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ZonesDataSourceModel{}.GetSchema()
+}
+
+func (d *ZonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+func (d *ZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data ZonesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := util.NewListFilter(data.NameRegex.ValueString(), "", "")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		return
+	}
+
+	getZonesRequest := d.client.ApiClient.ZonesAPIsDAAS.ZonesGetZones(ctx).Limit(util.ListDataSourcePageSize)
+	zones, err := util.GetAllPages[citrixorchestration.ZoneResponseModel](&resp.Diagnostics, "Error listing Zones", func(continuationToken string) (*citrixorchestration.ZoneResponseModelCollection, *http.Response, error) {
+		if continuationToken != "" {
+			getZonesRequest = getZonesRequest.ContinuationToken(continuationToken)
+		}
+		return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.ZoneResponseModelCollection](getZonesRequest, d.client)
+	})
+	if err != nil {
+		return
+	}
+
+	result := []citrixorchestration.ZoneResponseModel{}
+	for _, zone := range zones {
+		if filter.MatchesName(zone.GetName()) {
+			result = append(result, zone)
+		}
+	}
+
+	data = data.RefreshPropertyValues(result)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,75 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package zone
+
+import (
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ZonesDataSourceModel defines the Zones data source implementation.
+type ZonesDataSourceModel struct {
+	NameRegex types.String       `tfsdk:"name_regex"`
+	Zones     []ZoneSummaryModel `tfsdk:"zones"`
+	Site      types.String       `tfsdk:"site"`
+}
+
+func (ZonesDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source for the list of zones, optionally filtered by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"site":       util.GetSiteDataSourceSchema(),
+			"name_regex": util.GetNameRegexFilterDataSourceSchema("zones"),
+			"zones": schema.ListNestedAttribute{
+				Description:  "The zones matching the filters.",
+				Computed:     true,
+				NestedObject: ZoneSummaryModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+// ZoneSummaryModel defines the summary of a zone returned by the Zones data source.
+type ZoneSummaryModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (ZoneSummaryModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the zone.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the zone.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the zone.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r ZonesDataSourceModel) RefreshPropertyValues(zones []citrixorchestration.ZoneResponseModel) ZonesDataSourceModel {
+	res := []ZoneSummaryModel{}
+	for _, zone := range zones {
+		res = append(res, ZoneSummaryModel{
+			Id:          types.StringValue(zone.GetId()),
+			Name:        types.StringValue(zone.GetName()),
+			Description: types.StringValue(zone.GetDescription()),
+		})
+	}
+
+	r.Zones = res
+
+	return r
+}
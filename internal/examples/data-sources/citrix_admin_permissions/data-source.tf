@@ -0,0 +1,12 @@
+# Get all the predefined permissions that can be assigned to an admin role
+data "citrix_admin_permissions" "all" {
+}
+
+# Create an admin role with all the read-only permissions of the Director group
+resource "citrix_admin_role" "director_read_only" {
+    name        = "Director Read Only"
+    permissions = [
+        for permission in data.citrix_admin_permissions.all.permissions : permission.id
+        if permission.group_name == "Director" && permission.is_read_only
+    ]
+}
@@ -0,0 +1,9 @@
+# Get Admin Role resource by name
+data "citrix_admin_role" "test_role_by_name" {
+    name = "Full Administrator"
+}
+
+# Get Admin Role resource by id
+data "citrix_admin_role" "test_role_by_id" {
+    id = "00000000-0000-0000-0000-000000000000"
+}
@@ -0,0 +1,4 @@
+# Get all admin roles whose name contains "Administrator"
+data "citrix_admin_roles" "administrator_roles" {
+    name_regex = "Administrator"
+}
@@ -0,0 +1,10 @@
+# Get Admin User resource by name and domain
+data "citrix_admin_user" "test_admin_user_by_name" {
+    name        = "{Admin User Name}"
+    domain_name = "{Domain Name}"
+}
+
+# Get Admin User resource by id
+data "citrix_admin_user" "test_admin_user_by_id" {
+    id = "{Admin User SID}"
+}
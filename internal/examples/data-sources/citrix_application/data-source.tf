@@ -0,0 +1,10 @@
+# Get Application resource by id
+data "citrix_application" "application_by_id" {
+    id = "00000000-0000-0000-0000-000000000000"
+}
+
+# Get Application resource by name in an application folder
+data "citrix_application" "application_by_name" {
+    name                    = "{Application Name}"
+    application_folder_path = "{Application Folder Path}"
+}
@@ -0,0 +1,5 @@
+# Get the applications of an application folder and of all its subfolders
+data "citrix_application_folder_details" "example_application_folder_details" {
+    path      = "{Application Folder Path}"
+    recursive = true
+}
@@ -0,0 +1,9 @@
+# Get Application Group resource by id
+data "citrix_application_group" "application_group_by_id" {
+    id = "00000000-0000-0000-0000-000000000000"
+}
+
+# Get Application Group resource by name
+data "citrix_application_group" "application_group_by_name" {
+    name = "{Application Group Name}"
+}
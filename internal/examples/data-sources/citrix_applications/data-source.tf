@@ -0,0 +1,10 @@
+# Get all applications in the Office application folder
+data "citrix_applications" "office_applications" {
+    application_folder_path = "Office"
+}
+
+# Get all applications with a tag whose name contains "word", ignoring case
+data "citrix_applications" "tagged_applications" {
+    name_regex = "(?i)word"
+    tag        = "{Tag Name or Id}"
+}
@@ -0,0 +1,4 @@
+# Get the Cloud Connectors of a Citrix Cloud Resource Location
+data "citrix_cloud_connectors" "example_cloud_connectors" {
+    resource_location_id = "00000000-0000-0000-0000-000000000000"
+}
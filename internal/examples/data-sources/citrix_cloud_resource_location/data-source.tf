@@ -0,0 +1,9 @@
+# Get Citrix Cloud Resource Location by Id
+data "citrix_cloud_resource_location" "resource_location_by_id" {
+    id = "00000000-0000-0000-0000-000000000000"
+}
+
+# Get Citrix Cloud Resource Location by name
+data "citrix_cloud_resource_location" "resource_location_by_name" {
+    name = "exampleResourceLocation"
+}
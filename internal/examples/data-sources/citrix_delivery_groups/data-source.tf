@@ -0,0 +1,11 @@
+# Get all delivery groups whose name starts with "prod-" in the Production admin folder
+data "citrix_delivery_groups" "production_delivery_groups" {
+    name_regex        = "^prod-"
+    admin_folder_path = "Production"
+}
+
+# Get all delivery groups with a tag in a delegated admin scope
+data "citrix_delivery_groups" "tagged_delivery_groups" {
+    tag   = "{Tag Name or Id}"
+    scope = "{Admin Scope Name or Id}"
+}
@@ -0,0 +1,5 @@
+# Get all resource pools of a hypervisor whose name ends with "-pool"
+data "citrix_hypervisor_resource_pools" "resource_pools" {
+    hypervisor_name = "{Hypervisor Name or Id}"
+    name_regex      = "-pool$"
+}
@@ -0,0 +1,23 @@
+# Get all networks of a hypervisor resource pool
+data "citrix_hypervisor_resources" "networks" {
+    hypervisor_name               = "{Hypervisor Name or Id}"
+    hypervisor_resource_pool_name = "{Resource Pool Name or Id}"
+    resource_type                 = "Network"
+}
+
+# Get the snapshots of an Azure VM whose name starts with "golden-"
+data "citrix_hypervisor_resources" "snapshots" {
+    hypervisor_name               = "{Hypervisor Name or Id}"
+    hypervisor_resource_pool_name = "{Resource Pool Name or Id}"
+    folder_path                   = "image.folder\\{Resource Group Name}.resourcegroup\\{VM Name}.vm"
+    resource_type                 = "Snapshot"
+    name_regex                    = "^golden-"
+}
+
+# Get the disk encryption sets available to an Azure resource pool
+data "citrix_hypervisor_resources" "disk_encryption_sets" {
+    hypervisor_name               = "{Hypervisor Name or Id}"
+    hypervisor_resource_pool_name = "{Resource Pool Name or Id}"
+    folder_path                   = "diskencryptionset.folder"
+    resource_type                 = "DiskEncryptionSet"
+}
@@ -0,0 +1,9 @@
+# Get all hypervisors in a delegated admin scope
+data "citrix_hypervisors" "scoped_hypervisors" {
+    scope = "{Admin Scope Name or Id}"
+}
+
+# Get all hypervisors whose name starts with "azure-"
+data "citrix_hypervisors" "azure_hypervisors" {
+    name_regex = "^azure-"
+}
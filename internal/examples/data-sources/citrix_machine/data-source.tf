@@ -0,0 +1,9 @@
+# Get Machine details by name
+data "citrix_machine" "machine_by_name" {
+    name = "{Domain}\\{Machine Name}"
+}
+
+# Get Machine details by SID
+data "citrix_machine" "machine_by_sid" {
+    sid = "{Machine SID}"
+}
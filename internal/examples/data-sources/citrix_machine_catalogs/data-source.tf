@@ -0,0 +1,16 @@
+# Get all machine catalogs whose name starts with "prod-" in the Production\Europe admin folder
+data "citrix_machine_catalogs" "production_catalogs" {
+    name_regex        = "^prod-"
+    admin_folder_path = "Production\\Europe"
+}
+
+# Get all machine catalogs with a tag in a delegated admin scope
+data "citrix_machine_catalogs" "tagged_catalogs" {
+    tag   = "{Tag Name or Id}"
+    scope = "{Admin Scope Name or Id}"
+}
+
+# Map the name of each machine catalog to its number of machines
+output "production_catalog_machines" {
+    value = { for catalog in data.citrix_machine_catalogs.production_catalogs.machine_catalogs : catalog.name => catalog.total_machines }
+}
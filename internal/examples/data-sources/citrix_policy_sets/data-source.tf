@@ -0,0 +1,9 @@
+# Get all policy sets in a delegated admin scope
+data "citrix_policy_sets" "scoped_policy_sets" {
+    scope = "{Admin Scope Name or Id}"
+}
+
+# Get all policy sets whose name starts with "prod-"
+data "citrix_policy_sets" "production_policy_sets" {
+    name_regex = "^prod-"
+}
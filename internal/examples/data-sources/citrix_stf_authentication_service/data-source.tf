@@ -0,0 +1,5 @@
+# Get details of a StoreFront Authentication Service, including its authentication protocols
+data "citrix_stf_authentication_service" "example_stf_authentication_service" {
+    site_id      = "1"
+    virtual_path = "/Citrix/Authentication"
+}
@@ -0,0 +1,4 @@
+# Get details of the StoreFront Deployment of an IIS site
+data "citrix_stf_deployment" "example_stf_deployment" {
+    site_id = "1"
+}
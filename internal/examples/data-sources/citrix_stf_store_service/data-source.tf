@@ -0,0 +1,6 @@
+# Get details of a StoreFront Store Service and its StoreFront Controller
+data "citrix_stf_store_service" "example_stf_store_service" {
+    site_id      = "1"
+    virtual_path = "/Citrix/Store"
+    farm_names   = ["Controller1"]
+}
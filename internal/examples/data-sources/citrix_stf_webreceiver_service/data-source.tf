@@ -0,0 +1,5 @@
+# Get details of a StoreFront WebReceiver Service
+data "citrix_stf_webreceiver_service" "example_stf_webreceiver_service" {
+    site_id      = "1"
+    virtual_path = "/Citrix/StoreWeb"
+}
@@ -0,0 +1,7 @@
+# Get all zones
+data "citrix_zones" "all_zones" {}
+
+# Get all zones whose name starts with "eu-"
+data "citrix_zones" "european_zones" {
+    name_regex = "^eu-"
+}
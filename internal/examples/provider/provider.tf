@@ -5,6 +5,20 @@ provider "citrix" {
       client_id     = ""
       # secret can be specified via the CITRIX_CLIENT_SECRET environment variable
     }
+    # Optional: retry throttled requests and limit the number of concurrent requests
+    retry = {
+      max_attempts            = 5
+      max_concurrent_requests = 10
+    }
+}
+
+# Cloud Provider authenticating with a token issued by an external token broker
+provider "citrix" {
+    cvad_config = {
+      customer_id       = ""
+      # token file can be specified via the CITRIX_ACCESS_TOKEN_FILE environment variable
+      access_token_file = "/var/run/secrets/citrix/token"
+    }
 }
 
 # On-Premises Provider
@@ -16,6 +30,26 @@ provider "citrix" {
     }
 }
 
+# Provider managing several sites. Resources and data sources select a site with their `site` attribute.
+provider "citrix" {
+    sites = {
+      cloud = {
+        cvad_config = {
+          customer_id   = ""
+          client_id     = ""
+          client_secret = ""
+        }
+      }
+      onprem = {
+        cvad_config = {
+          hostname      = "10.0.0.6"
+          client_id     = "foo.local\\admin"
+          client_secret = ""
+        }
+      }
+    }
+}
+
 # Storefront Provider
 provider "citrix" {
   storefront_remote_host = {
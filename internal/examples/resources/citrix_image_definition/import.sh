@@ -0,0 +1,2 @@
+# Image Definition can be imported by specifying the GUID
+terraform import citrix_image_definition.example-image-definition 06e5981e-dbaf-48db-b134-245fca2dc672
\ No newline at end of file
@@ -0,0 +1,7 @@
+resource "citrix_image_definition" "example-image-definition" {
+    name            = "example-image-definition"
+    description     = "Example image definition"
+    os_type         = "Windows"
+    session_support = "MultiSession"
+    hypervisor      = citrix_azure_hypervisor.example-azure-hypervisor.id
+}
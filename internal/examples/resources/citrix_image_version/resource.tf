@@ -0,0 +1,35 @@
+resource "citrix_image_version" "example-azure-image-version" {
+    image_definition         = citrix_image_definition.example-image-definition.id
+    description              = "Example Azure image version"
+    hypervisor               = citrix_azure_hypervisor.example-azure-hypervisor.id
+    hypervisor_resource_pool = citrix_azure_hypervisor_resource_pool.example-azure-hypervisor-resource-pool.id
+    azure_image_specs = {
+        service_offering = "Standard_D2_v2"
+        storage_type     = "Standard_LRS"
+        resource_group   = "<Image resource group name>"
+        master_image     = "<Image snapshot or managed disk name>"
+    }
+
+    # Create the new image version before deleting the previous one, so that
+    # linked machine catalogs are moved to the new image version first
+    lifecycle {
+        create_before_destroy = true
+    }
+}
+
+resource "citrix_image_version" "example-vsphere-image-version" {
+    image_definition         = citrix_image_definition.example-image-definition.id
+    description              = "Example vSphere image version"
+    hypervisor               = citrix_vsphere_hypervisor.vsphere-hypervisor-1.id
+    hypervisor_resource_pool = citrix_vsphere_hypervisor_resource_pool.vsphere-hypervisor-rp-1.id
+    vsphere_image_specs = {
+        master_image_vm = "<Image VM name>"
+        image_snapshot  = "<Snapshot 1>/<Snapshot 2>/<Snapshot 3>/..."
+        cpu_count       = 2
+        memory_mb       = 4096
+    }
+
+    lifecycle {
+        create_before_destroy = true
+    }
+}
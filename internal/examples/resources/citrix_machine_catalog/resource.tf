@@ -44,7 +44,18 @@ resource "citrix_machine_catalog" "example-azure-mtsession" {
 			}
         }
 		availability_zones = ["1","2"]
+		# Apply image updates to 20% of the machines first, and wait for them to re-register before updating the rest
+		image_rollout_strategy = {
+			canary_percentage    = 20
+			registration_timeout = 30
+			failure_threshold    = 0
+		}
 		number_of_total_machines = 	1
+		# When number_of_total_machines is decreased, remove unregistered machines first, then the oldest machines
+		scale_in_policy = {
+			prefer                 = ["Unregistered", "Oldest"]
+			machine_account_action = "Disable"
+		}
 		machine_account_creation_rules ={
 			naming_scheme =     "az-multi-##"
 			naming_scheme_type ="Numeric"
@@ -155,6 +166,38 @@ resource "citrix_machine_catalog" "example-vsphere-mtsession" {
     }
 }
 
+resource "citrix_machine_catalog" "example-vsphere-prepared-image-mtsession" {
+    name                        = "example-vsphere-prepared-image-mtsession"
+    description                 = "Example multi-session catalog on vSphere hypervisor using a prepared image version"
+    zone                        = "<zone Id>"
+    allocation_type             = "Random"
+    session_support             = "MultiSession"
+    provisioning_type           = "MCS"
+    provisioning_scheme         = {
+        hypervisor = citrix_vsphere_hypervisor.vsphere-hypervisor-1.id
+        hypervisor_resource_pool = citrix_vsphere_hypervisor_resource_pool.vsphere-hypervisor-rp-1.id
+        identity_type = "ActiveDirectory"
+        machine_domain_identity = {
+            domain                   = "<DomainFQDN>"
+            service_account          = "<Admin Username>"
+            service_account_password = "<Admin Password>"
+        }
+        prepared_image = {
+            image_definition = citrix_image_definition.example-image-definition.id
+            image_version    = citrix_image_version.example-vsphere-image-version.id
+        }
+        vsphere_machine_config = {
+            cpu_count = 2
+            memory_mb = 4096
+        }
+        number_of_total_machines = 1
+        machine_account_creation_rules = {
+            naming_scheme = "catalog-prepared-##"
+            naming_scheme_type = "Numeric"
+        }
+    }
+}
+
 resource "citrix_machine_catalog" "example-xenserver-mtsession" {
     name                        = "example-xenserver-mtsession"
     description                 = "Example multi-session catalog on XenServer hypervisor"
@@ -309,6 +352,13 @@ resource "citrix_machine_catalog" "example-manual-power-managed-mtsession" {
             ]
         }
     ]
+    machine_settings = {
+        "DOMAIN\\MachineName" = {
+            in_maintenance_mode = true
+            power_state         = "Off"
+            tags                = ["Patching"]
+        }
+    }
 }
 
 resource "citrix_machine_catalog" "example-manual-non-power-managed-mtsession" {
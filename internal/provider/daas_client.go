@@ -0,0 +1,147 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ccadmins "github.com/citrix/citrix-daas-rest-go/ccadmins"
+	ccresourcelocations "github.com/citrix/citrix-daas-rest-go/ccresourcelocations"
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/citrix-daas-rest-go/globalappconfiguration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+)
+
+// <summary>
+// Helper function to initialize the Citrix DaaS client with an HTTP client configured by the provider, e.g. with a proxy, CA certificate or client certificate.
+// citrixclient.NewCitrixDaasClient signs in and reads the site configuration with its own HTTP clients, so the API clients are set up here instead
+// and every request, including sign in and the site configuration requests, is sent through the given HTTP client.
+// </summary>
+// <param name="ctx">Context</param>
+// <param name="client">Citrix DaaS client to initialize</param>
+// <param name="httpClient">HTTP client used for all requests</param>
+// <param name="endpoints">Endpoints of the Citrix services</param>
+// <param name="customerId">Citrix Cloud customer ID, or CitrixOnPremises</param>
+// <param name="clientId">Client ID of the Citrix Cloud API client, or the domain admin username for on-premises sites</param>
+// <param name="clientSecret">Client secret of the Citrix Cloud API client, or the domain admin password for on-premises sites</param>
+// <param name="onPremises">Whether the provider targets an on-premises site</param>
+// <param name="userAgent">User agent of the provider</param>
+// <param name="middlewareFunc">Middleware that authorizes the requests</param>
+// <param name="middlewareFuncWithCustomerIdHeader">Middleware that authorizes the requests to the Citrix Cloud administrators service</param>
+// <returns>The HTTP response of the last request, and error if any</returns>
+func newCitrixDaasClientWithHttpClient(ctx context.Context, client *citrixclient.CitrixDaasClient, httpClient *http.Client, endpoints citrixEndpoints, customerId, clientId, clientSecret string, onPremises bool, userAgent string, middlewareFunc, middlewareFuncWithCustomerIdHeader citrixclient.MiddlewareAuthFunction) (*http.Response, error) {
+	apiCfg := citrixorchestration.NewConfiguration()
+	apiCfg.Host = endpoints.Hostname
+	apiCfg.Scheme = "https"
+	apiCfg.HTTPClient = httpClient
+	if onPremises || !endpoints.ApiGateway {
+		apiCfg.Servers = citrixorchestration.ServerConfigurations{
+			{
+				URL: apiCfg.Scheme + "://" + endpoints.Hostname + "/citrix/orchestration/api",
+			},
+		}
+	}
+	apiCfg.Middleware = func(r *http.Request) {
+		middlewareFunc(client, r)
+	}
+	client.ApiClient = citrixorchestration.NewAPIClient(apiCfg)
+
+	resourceLocationsCfg := ccresourcelocations.NewConfiguration()
+	resourceLocationsCfg.Scheme = "https"
+	resourceLocationsCfg.HTTPClient = httpClient
+	resourceLocationsCfg.Servers = ccresourcelocations.ServerConfigurations{
+		{
+			URL: resourceLocationsCfg.Scheme + "://" + endpoints.CcUrl + "/resourcelocations",
+		},
+	}
+	resourceLocationsCfg.Middleware = func(r *http.Request) {
+		middlewareFunc(client, r)
+	}
+	client.ResourceLocationsClient = ccresourcelocations.NewAPIClient(resourceLocationsCfg)
+
+	ccAdminsCfg := ccadmins.NewConfiguration()
+	ccAdminsCfg.Scheme = "https"
+	ccAdminsCfg.HTTPClient = httpClient
+	ccAdminsCfg.Servers = ccadmins.ServerConfigurations{
+		{
+			URL: ccAdminsCfg.Scheme + "://" + endpoints.CcUrl + "/administrators",
+		},
+	}
+	ccAdminsCfg.Middleware = func(r *http.Request) {
+		middlewareFuncWithCustomerIdHeader(client, r)
+	}
+	client.CCAdminsClient = ccadmins.NewAPIClient(ccAdminsCfg)
+
+	gacCfg := globalappconfiguration.NewConfiguration()
+	gacCfg.Scheme = "https"
+	gacCfg.HTTPClient = httpClient
+	if endpoints.Hostname == "api.dev.cloud.com" || endpoints.Hostname == "api.cloudburrito.com" {
+		gacCfg.Servers = globalappconfiguration.ServerConfigurations{
+			{
+				URL: gacCfg.Scheme + "://wsaca.cloudburrito.com",
+			},
+		}
+	}
+	gacCfg.Middleware = func(r *http.Request) {
+		middlewareFunc(client, r)
+	}
+	client.GacClient = globalappconfiguration.NewAPIClient(gacCfg)
+
+	client.AuthConfig = &citrixclient.AuthenticationConfiguration{
+		AuthUrl:      endpoints.AuthUrl,
+		ClientId:     clientId,
+		ClientSecret: clientSecret,
+		OnPremises:   onPremises,
+		ApiGateway:   endpoints.ApiGateway,
+		IsGov:        endpoints.IsGov,
+	}
+	util.SetSignInHttpClient(client, httpClient)
+
+	token, httpResp, err := util.SignIn(client)
+	if err != nil {
+		return httpResp, err
+	}
+	me, httpResp, err := client.ApiClient.MeAPIsDAAS.MeGetMe(ctx).Authorization(token).CitrixCustomerId(customerId).Execute()
+	if err != nil {
+		return httpResp, err
+	}
+	if me == nil || len(me.Customers) == 0 || len(me.Customers[0].Sites) == 0 {
+		return httpResp, fmt.Errorf("customer does not exist or does not have a valid site")
+	}
+
+	clientCfg := &citrixclient.ClientConfiguration{
+		CustomerId: customerId,
+		SiteId:     me.Customers[0].Sites[0].Id,
+		Accept:     "application/json",
+		UserAgent:  userAgent,
+	}
+	client.ClientConfig = clientCfg
+
+	if onPremises || !endpoints.ApiGateway {
+		// add CustomerId and SiteId to base path for on-prem. The following APIs will no longer work.
+		// HealthCheck, Me, and Ping
+		apiCfg.Servers[0].URL += "/" + clientCfg.CustomerId
+	}
+
+	token, httpResp, err = util.SignIn(client)
+	if err != nil {
+		return httpResp, err
+	}
+	site, httpResp, err := client.ApiClient.SitesAPIsDAAS.SitesGetSite(ctx, clientCfg.SiteId).Authorization(token).CitrixCustomerId(customerId).Execute()
+	if err != nil {
+		return httpResp, err
+	}
+
+	clientCfg.ProductVersion = site.GetProductVersion()
+	clientCfg.OrchestrationApiVersion = site.GetOrchestationApiVersion()
+
+	if onPremises || !endpoints.ApiGateway {
+		// add CustomerId and SiteId to base path for on-prem. The Sites API will no longer work.
+		apiCfg.Servers[0].URL += "/" + clientCfg.SiteId
+	}
+
+	return httpResp, nil
+}
@@ -0,0 +1,63 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	citrixclient "github.com/citrix/citrix-daas-rest-go/client"
+)
+
+func TestNewCitrixDaasClientWithHttpClient(t *testing.T) {
+	var requests []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/citrix/orchestration/api/tokens":
+			w.Write([]byte(`{"Token":"token","ExpiresAt":"` + time.Now().UTC().Add(time.Hour).Format(time.RFC3339) + `"}`))
+		case "/citrix/orchestration/api/me":
+			w.Write([]byte(`{"Customers":[{"Id":"CitrixOnPremises","Sites":[{"Id":"site-id","Name":"site"}]}]}`))
+		case "/citrix/orchestration/api/CitrixOnPremises/Sites/site-id":
+			w.Write([]byte(`{"Id":"site-id","ProductVersion":"7.41","OrchestationApiVersion":120}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	hostname := strings.TrimPrefix(server.URL, "https://")
+	endpoints := citrixEndpoints{
+		Hostname: hostname,
+		AuthUrl:  server.URL + "/citrix/orchestration/api/tokens",
+	}
+	noopMiddleware := func(authClient *citrixclient.CitrixDaasClient, r *http.Request) {}
+
+	// The test server certificate is only trusted by the HTTP client of the test server, so every request has to go through it
+	client := &citrixclient.CitrixDaasClient{}
+	_, err := newCitrixDaasClientWithHttpClient(context.Background(), client, server.Client(), endpoints, "CitrixOnPremises", "admin", "password", true, "test", noopMiddleware, noopMiddleware)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedRequests := []string{
+		"POST /citrix/orchestration/api/tokens",
+		"GET /citrix/orchestration/api/me",
+		"GET /citrix/orchestration/api/CitrixOnPremises/Sites/site-id",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expectedRequests, "\n") {
+		t.Errorf("expected requests %v, got %v", expectedRequests, requests)
+	}
+	if client.ClientConfig.SiteId != "site-id" || client.ClientConfig.ProductVersion != "7.41" {
+		t.Errorf("unexpected client configuration %+v", client.ClientConfig)
+	}
+	expectedUrl := server.URL + "/citrix/orchestration/api/CitrixOnPremises/site-id"
+	if url := client.ApiClient.GetConfig().Servers[0].URL; url != expectedUrl {
+		t.Errorf("expected server URL %s, got %s", expectedUrl, url)
+	}
+}
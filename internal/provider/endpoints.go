@@ -0,0 +1,229 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const customEnvironment = "Custom"
+
+// endpointOverrides holds the endpoints configured explicitly for the Custom environment
+type endpointOverrides struct {
+	AuthUrl              string
+	ApiGatewayUrl        string
+	ResourceLocationsUrl string
+	GacUrl               string
+	QuickCreateUrl       string
+}
+
+func (o endpointOverrides) isEmpty() bool {
+	return o.AuthUrl == "" && o.ApiGatewayUrl == "" && o.ResourceLocationsUrl == "" && o.GacUrl == "" && o.QuickCreateUrl == ""
+}
+
+// citrixEndpoints holds the endpoints of the Citrix services used by the provider
+type citrixEndpoints struct {
+	// Hostname of the Orchestration service, either the API gateway or the DDC
+	Hostname string
+	// Host of the Citrix Cloud API gateway, used for the Resource Locations and Citrix Cloud administrators services
+	CcUrl      string
+	AuthUrl    string
+	ApiGateway bool
+	IsGov      bool
+	// Host and path of the QuickCreate service, without scheme
+	QuickCreateHostname string
+	// Base URL of the Resource Locations service. Empty when the default URL of the API gateway is used
+	ResourceLocationsUrl string
+	// Base URL of the Global App Configuration service. Empty when the default URL of the environment is used
+	GacUrl string
+}
+
+// <summary>
+// Helper function to build the endpoints of the Citrix services for an environment
+// </summary>
+// <param name="environment">Citrix Cloud environment</param>
+// <param name="customerId">Citrix Cloud customer ID, or CitrixOnPremises</param>
+// <param name="hostname">Hostname of the DDC or override of the Citrix DaaS service hostname</param>
+// <param name="quickCreateHostName">Override of the QuickCreate service hostname</param>
+// <param name="onPremises">Whether the provider targets an on-premises site</param>
+// <param name="overrides">Endpoints configured for the Custom environment</param>
+// <returns>Endpoints of the Citrix services, and an error if the endpoint overrides are invalid</returns>
+func getCitrixEndpoints(environment, customerId, hostname, quickCreateHostName string, onPremises bool, overrides endpointOverrides) (citrixEndpoints, error) {
+	if environment == customEnvironment {
+		return getCustomEnvironmentEndpoints(customerId, hostname, quickCreateHostName, onPremises, overrides)
+	}
+
+	if !overrides.isEmpty() {
+		return citrixEndpoints{}, fmt.Errorf("auth_url, api_gateway_url, resource_locations_url, gac_url and quick_create_url are only supported when environment is set to %s", customEnvironment)
+	}
+
+	endpoints := citrixEndpoints{
+		Hostname:   hostname,
+		ApiGateway: true,
+	}
+
+	if !onPremises {
+		if environment == "Production" {
+			endpoints.CcUrl = "api.cloud.com"
+		} else if environment == "Staging" {
+			endpoints.CcUrl = "api.cloudburrito.com"
+		} else if environment == "Japan" {
+			endpoints.CcUrl = "api.citrixcloud.jp"
+		} else if environment == "JapanStaging" {
+			endpoints.CcUrl = "api.citrixcloudstaging.jp"
+		} else if environment == "Gov" {
+			endpoints.CcUrl = fmt.Sprintf("registry.citrixworkspacesapi.us/%s", customerId)
+		} else if environment == "GovStaging" {
+			endpoints.CcUrl = fmt.Sprintf("registry.ctxwsstgapi.us/%s", customerId)
+		}
+		if hostname == "" {
+			if environment == "Gov" {
+				endpoints.Hostname = fmt.Sprintf("%s.xendesktop.us", customerId)
+				endpoints.ApiGateway = false
+			} else if environment == "GovStaging" {
+				endpoints.Hostname = fmt.Sprintf("%s.xdstaging.us", customerId)
+				endpoints.ApiGateway = false
+			} else {
+				endpoints.Hostname = endpoints.CcUrl
+			}
+		} else if !strings.HasPrefix(hostname, "api.") {
+			// When a cloud customer sets explicit hostname to the cloud DDC, bypass API Gateway
+			endpoints.ApiGateway = false
+		}
+	}
+
+	if onPremises {
+		endpoints.AuthUrl = fmt.Sprintf("https://%s/citrix/orchestration/api/tokens", endpoints.Hostname)
+	} else {
+		if environment == "Production" {
+			endpoints.AuthUrl = fmt.Sprintf("https://api.cloud.com/cctrustoauth2/%s/tokens/clients", customerId)
+		} else if environment == "Staging" {
+			endpoints.AuthUrl = fmt.Sprintf("https://api.cloudburrito.com/cctrustoauth2/%s/tokens/clients", customerId)
+		} else if environment == "Japan" {
+			endpoints.AuthUrl = fmt.Sprintf("https://api.citrixcloud.jp/cctrustoauth2/%s/tokens/clients", customerId)
+		} else if environment == "JapanStaging" {
+			endpoints.AuthUrl = fmt.Sprintf("https://api.citrixcloudstaging.jp/cctrustoauth2/%s/tokens/clients", customerId)
+		} else if environment == "Gov" {
+			endpoints.AuthUrl = fmt.Sprintf("https://trust.citrixworkspacesapi.us/%s/tokens/clients", customerId)
+			endpoints.IsGov = true
+		} else if environment == "GovStaging" {
+			endpoints.AuthUrl = fmt.Sprintf("https://trust.ctxwsstgapi.us/%s/tokens/clients", customerId)
+			endpoints.IsGov = true
+		} else {
+			endpoints.AuthUrl = fmt.Sprintf("https://%s/cctrustoauth2/%s/tokens/clients", endpoints.Hostname, customerId)
+		}
+	}
+
+	if quickCreateHostName != "" {
+		// If customer specified a quick create host name, use it
+		endpoints.QuickCreateHostname = quickCreateHostName
+	} else {
+		if environment == "Production" {
+			endpoints.QuickCreateHostname = "api.cloud.com/quickcreateservice"
+		} else if environment == "Staging" {
+			endpoints.QuickCreateHostname = "api.cloudburrito.com/quickcreateservice"
+		} else if environment == "Japan" {
+			endpoints.QuickCreateHostname = "api.citrixcloud.jp/quickcreateservice"
+		} else if environment == "JapanStaging" {
+			endpoints.QuickCreateHostname = "api.citrixcloudstaging.jp/quickcreateservice"
+		} else if environment == "Gov" {
+			endpoints.QuickCreateHostname = "quickcreate.apps.cloud.us"
+		} else if environment == "GovStaging" {
+			endpoints.QuickCreateHostname = "quickcreate.apps.cloudstaging.us"
+		}
+	}
+
+	return endpoints, nil
+}
+
+func getCustomEnvironmentEndpoints(customerId, hostname, quickCreateHostName string, onPremises bool, overrides endpointOverrides) (citrixEndpoints, error) {
+	if onPremises {
+		return citrixEndpoints{}, fmt.Errorf("environment %s is only applicable to Citrix Cloud customers, customer_id must be set", customEnvironment)
+	}
+
+	authUrl, err := parseEndpointUrl("auth_url", overrides.AuthUrl, true, true)
+	if err != nil {
+		return citrixEndpoints{}, err
+	}
+
+	// The Citrix DaaS client only accepts a host for the API gateway and builds the service paths itself
+	apiGatewayUrl, err := parseEndpointUrl("api_gateway_url", overrides.ApiGatewayUrl, true, false)
+	if err != nil {
+		return citrixEndpoints{}, err
+	}
+
+	resourceLocationsUrl, err := parseEndpointUrl("resource_locations_url", overrides.ResourceLocationsUrl, false, true)
+	if err != nil {
+		return citrixEndpoints{}, err
+	}
+
+	gacUrl, err := parseEndpointUrl("gac_url", overrides.GacUrl, false, true)
+	if err != nil {
+		return citrixEndpoints{}, err
+	}
+
+	quickCreateUrl, err := parseEndpointUrl("quick_create_url", overrides.QuickCreateUrl, false, true)
+	if err != nil {
+		return citrixEndpoints{}, err
+	}
+
+	endpoints := citrixEndpoints{
+		Hostname:             hostname,
+		CcUrl:                apiGatewayUrl.Host,
+		AuthUrl:              authUrl.String(),
+		ApiGateway:           true,
+		ResourceLocationsUrl: getEndpointUrlString(resourceLocationsUrl),
+		GacUrl:               getEndpointUrlString(gacUrl),
+	}
+
+	if hostname == "" {
+		endpoints.Hostname = endpoints.CcUrl
+	} else if hostname != endpoints.CcUrl {
+		// When a cloud customer sets explicit hostname to the cloud DDC, bypass API Gateway
+		endpoints.ApiGateway = false
+	}
+
+	if quickCreateUrl != nil {
+		endpoints.QuickCreateHostname = quickCreateUrl.Host + strings.TrimSuffix(quickCreateUrl.Path, "/")
+	} else if quickCreateHostName != "" {
+		endpoints.QuickCreateHostname = quickCreateHostName
+	} else {
+		endpoints.QuickCreateHostname = endpoints.CcUrl + "/quickcreateservice"
+	}
+
+	return endpoints, nil
+}
+
+// parseEndpointUrl validates that an endpoint is an absolute https URL without query or fragment
+func parseEndpointUrl(attributeName, value string, required bool, allowPath bool) (*url.URL, error) {
+	if value == "" {
+		if required {
+			return nil, fmt.Errorf("%s is required when environment is set to %s", attributeName, customEnvironment)
+		}
+		return nil, nil
+	}
+
+	endpointUrl, err := url.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s `%s` is not a valid URL: %s", attributeName, value, err.Error())
+	}
+	if endpointUrl.Scheme != "https" || endpointUrl.Host == "" {
+		return nil, fmt.Errorf("%s `%s` must be an absolute URL with the https scheme", attributeName, value)
+	}
+	if endpointUrl.RawQuery != "" || endpointUrl.Fragment != "" || endpointUrl.User != nil {
+		return nil, fmt.Errorf("%s `%s` must not contain user information, a query or a fragment", attributeName, value)
+	}
+	if !allowPath && strings.Trim(endpointUrl.Path, "/") != "" {
+		return nil, fmt.Errorf("%s `%s` must not contain a path", attributeName, value)
+	}
+	return endpointUrl, nil
+}
+
+func getEndpointUrlString(endpointUrl *url.URL) string {
+	if endpointUrl == nil {
+		return ""
+	}
+	return strings.TrimSuffix(endpointUrl.String(), "/")
+}
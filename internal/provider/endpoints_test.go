@@ -0,0 +1,217 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetCitrixEndpoints(t *testing.T) {
+	tests := []struct {
+		name                string
+		environment         string
+		customerId          string
+		hostname            string
+		quickCreateHostName string
+		onPremises          bool
+		overrides           endpointOverrides
+		expected            citrixEndpoints
+	}{
+		{
+			name:        "OnPremises",
+			environment: "Production",
+			customerId:  "CitrixOnPremises",
+			hostname:    "ddc.example.com",
+			onPremises:  true,
+			expected: citrixEndpoints{
+				Hostname:            "ddc.example.com",
+				AuthUrl:             "https://ddc.example.com/citrix/orchestration/api/tokens",
+				ApiGateway:          true,
+				QuickCreateHostname: "api.cloud.com/quickcreateservice",
+			},
+		},
+		{
+			name:        "Production",
+			environment: "Production",
+			customerId:  "customer",
+			expected: citrixEndpoints{
+				Hostname:            "api.cloud.com",
+				CcUrl:               "api.cloud.com",
+				AuthUrl:             "https://api.cloud.com/cctrustoauth2/customer/tokens/clients",
+				ApiGateway:          true,
+				QuickCreateHostname: "api.cloud.com/quickcreateservice",
+			},
+		},
+		{
+			name:        "ProductionWithCloudDdcHostname",
+			environment: "Production",
+			customerId:  "customer",
+			hostname:    "customer.xendesktop.net",
+			expected: citrixEndpoints{
+				Hostname:            "customer.xendesktop.net",
+				CcUrl:               "api.cloud.com",
+				AuthUrl:             "https://api.cloud.com/cctrustoauth2/customer/tokens/clients",
+				ApiGateway:          false,
+				QuickCreateHostname: "api.cloud.com/quickcreateservice",
+			},
+		},
+		{
+			name:                "JapanWithQuickCreateHostName",
+			environment:         "Japan",
+			customerId:          "customer",
+			quickCreateHostName: "quickcreate.example.com",
+			expected: citrixEndpoints{
+				Hostname:            "api.citrixcloud.jp",
+				CcUrl:               "api.citrixcloud.jp",
+				AuthUrl:             "https://api.citrixcloud.jp/cctrustoauth2/customer/tokens/clients",
+				ApiGateway:          true,
+				QuickCreateHostname: "quickcreate.example.com",
+			},
+		},
+		{
+			name:        "Gov",
+			environment: "Gov",
+			customerId:  "customer",
+			expected: citrixEndpoints{
+				Hostname:            "customer.xendesktop.us",
+				CcUrl:               "registry.citrixworkspacesapi.us/customer",
+				AuthUrl:             "https://trust.citrixworkspacesapi.us/customer/tokens/clients",
+				ApiGateway:          false,
+				IsGov:               true,
+				QuickCreateHostname: "quickcreate.apps.cloud.us",
+			},
+		},
+		{
+			name:        "Custom",
+			environment: "Custom",
+			customerId:  "customer",
+			overrides: endpointOverrides{
+				AuthUrl:       "https://auth.example.com/cctrustoauth2/customer/tokens/clients",
+				ApiGatewayUrl: "https://api.example.com/",
+			},
+			expected: citrixEndpoints{
+				Hostname:            "api.example.com",
+				CcUrl:               "api.example.com",
+				AuthUrl:             "https://auth.example.com/cctrustoauth2/customer/tokens/clients",
+				ApiGateway:          true,
+				QuickCreateHostname: "api.example.com/quickcreateservice",
+			},
+		},
+		{
+			name:        "CustomWithAllOverrides",
+			environment: "Custom",
+			customerId:  "customer",
+			hostname:    "customer.ddc.example.com",
+			overrides: endpointOverrides{
+				AuthUrl:              "https://auth.example.com/tokens",
+				ApiGatewayUrl:        "https://api.example.com:8443",
+				ResourceLocationsUrl: "https://rl.example.com/resourcelocations/",
+				GacUrl:               "https://gac.example.com",
+				QuickCreateUrl:       "https://qcs.example.com/quickcreateservice/",
+			},
+			expected: citrixEndpoints{
+				Hostname:             "customer.ddc.example.com",
+				CcUrl:                "api.example.com:8443",
+				AuthUrl:              "https://auth.example.com/tokens",
+				ApiGateway:           false,
+				QuickCreateHostname:  "qcs.example.com/quickcreateservice",
+				ResourceLocationsUrl: "https://rl.example.com/resourcelocations",
+				GacUrl:               "https://gac.example.com",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			endpoints, err := getCitrixEndpoints(test.environment, test.customerId, test.hostname, test.quickCreateHostName, test.onPremises, test.overrides)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if endpoints != test.expected {
+				t.Errorf("expected %+v, got %+v", test.expected, endpoints)
+			}
+		})
+	}
+}
+
+func TestGetCitrixEndpointsInvalidConfiguration(t *testing.T) {
+	validOverrides := endpointOverrides{
+		AuthUrl:       "https://auth.example.com/tokens",
+		ApiGatewayUrl: "https://api.example.com",
+	}
+
+	tests := []struct {
+		name          string
+		environment   string
+		onPremises    bool
+		overrides     endpointOverrides
+		expectedError string
+	}{
+		{
+			name:          "OverridesWithoutCustomEnvironment",
+			environment:   "Production",
+			overrides:     validOverrides,
+			expectedError: "only supported when environment is set to Custom",
+		},
+		{
+			name:          "CustomOnPremises",
+			environment:   "Custom",
+			onPremises:    true,
+			overrides:     validOverrides,
+			expectedError: "only applicable to Citrix Cloud customers",
+		},
+		{
+			name:          "MissingAuthUrl",
+			environment:   "Custom",
+			overrides:     endpointOverrides{ApiGatewayUrl: "https://api.example.com"},
+			expectedError: "auth_url is required",
+		},
+		{
+			name:          "MissingApiGatewayUrl",
+			environment:   "Custom",
+			overrides:     endpointOverrides{AuthUrl: "https://auth.example.com/tokens"},
+			expectedError: "api_gateway_url is required",
+		},
+		{
+			name:          "HttpAuthUrl",
+			environment:   "Custom",
+			overrides:     endpointOverrides{AuthUrl: "http://auth.example.com/tokens", ApiGatewayUrl: "https://api.example.com"},
+			expectedError: "must be an absolute URL with the https scheme",
+		},
+		{
+			name:          "RelativeApiGatewayUrl",
+			environment:   "Custom",
+			overrides:     endpointOverrides{AuthUrl: "https://auth.example.com/tokens", ApiGatewayUrl: "api.example.com"},
+			expectedError: "must be an absolute URL with the https scheme",
+		},
+		{
+			name:          "ApiGatewayUrlWithPath",
+			environment:   "Custom",
+			overrides:     endpointOverrides{AuthUrl: "https://auth.example.com/tokens", ApiGatewayUrl: "https://api.example.com/citrix"},
+			expectedError: "must not contain a path",
+		},
+		{
+			name:        "GacUrlWithQuery",
+			environment: "Custom",
+			overrides: endpointOverrides{
+				AuthUrl:       "https://auth.example.com/tokens",
+				ApiGatewayUrl: "https://api.example.com",
+				GacUrl:        "https://gac.example.com?region=us",
+			},
+			expectedError: "must not contain user information, a query or a fragment",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := getCitrixEndpoints(test.environment, "customer", "", "", test.onPremises, test.overrides)
+			if err == nil {
+				t.Fatalf("expected error containing %q", test.expectedError)
+			}
+			if !strings.Contains(err.Error(), test.expectedError) {
+				t.Errorf("expected error containing %q, got %q", test.expectedError, err.Error())
+			}
+		})
+	}
+}
@@ -5,21 +5,19 @@ package provider
 import (
 	"context"
 	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
-	"path/filepath"
-	"slices"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/citrix/citrix-daas-rest-go/ccresourcelocations"
 	citrixclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/citrix-daas-rest-go/globalappconfiguration"
 	cc_admin_user "github.com/citrix/terraform-provider-citrix/internal/citrixcloud/admin_user"
 	"github.com/citrix/terraform-provider-citrix/internal/citrixcloud/gac_settings"
 	"github.com/citrix/terraform-provider-citrix/internal/citrixcloud/resource_locations"
@@ -43,16 +41,18 @@ import (
 	"github.com/citrix/terraform-provider-citrix/internal/daas/delivery_group"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/hypervisor"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/hypervisor_resource_pool"
+	"github.com/citrix/terraform-provider-citrix/internal/daas/image_definition"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/machine_catalog"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/policies"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/zone"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	"github.com/google/uuid"
-	"golang.org/x/mod/semver"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -86,6 +86,15 @@ type citrixProvider struct {
 
 // citrixProviderModel maps provider schema data to a Go type.
 type citrixProviderModel struct {
+	CvadConfig           *cvadConfig           `tfsdk:"cvad_config"`
+	StoreFrontRemoteHost *storefrontConfig     `tfsdk:"storefront_remote_host"`
+	Sites                map[string]siteConfig `tfsdk:"sites"`
+	Retry                *retryConfig          `tfsdk:"retry"`
+	VersionCheck         *versionCheckConfig   `tfsdk:"version_check"`
+	AuditLogFile         types.String          `tfsdk:"audit_log_file"`
+}
+
+type siteConfig struct {
 	CvadConfig           *cvadConfig       `tfsdk:"cvad_config"`
 	StoreFrontRemoteHost *storefrontConfig `tfsdk:"storefront_remote_host"`
 }
@@ -96,7 +105,21 @@ type cvadConfig struct {
 	CustomerId             types.String `tfsdk:"customer_id"`
 	ClientId               types.String `tfsdk:"client_id"`
 	ClientSecret           types.String `tfsdk:"client_secret"`
+	AccessToken            types.String `tfsdk:"access_token"`
+	AccessTokenFile        types.String `tfsdk:"access_token_file"`
 	DisableSslVerification types.Bool   `tfsdk:"disable_ssl_verification"`
+	AuthUrl                types.String `tfsdk:"auth_url"`
+	ApiGatewayUrl          types.String `tfsdk:"api_gateway_url"`
+	ResourceLocationsUrl   types.String `tfsdk:"resource_locations_url"`
+	GacUrl                 types.String `tfsdk:"gac_url"`
+	QuickCreateUrl         types.String `tfsdk:"quick_create_url"`
+	CaCertificateFile      types.String `tfsdk:"ca_certificate_file"`
+	CaCertificatePem       types.String `tfsdk:"ca_certificate_pem"`
+	ClientCertificateFile  types.String `tfsdk:"client_certificate_file"`
+	ClientCertificatePem   types.String `tfsdk:"client_certificate_pem"`
+	ClientKeyFile          types.String `tfsdk:"client_key_file"`
+	ClientKeyPem           types.String `tfsdk:"client_key_pem"`
+	ProxyUrl               types.String `tfsdk:"proxy_url"`
 }
 
 type storefrontConfig struct {
@@ -105,6 +128,15 @@ type storefrontConfig struct {
 	AdAdminPassword types.String `tfsdk:"ad_admin_password"`
 }
 
+type retryConfig struct {
+	MaxAttempts           types.Int64 `tfsdk:"max_attempts"`
+	BackoffBaseSeconds    types.Int64 `tfsdk:"backoff_base_seconds"`
+	BackoffCapSeconds     types.Int64 `tfsdk:"backoff_cap_seconds"`
+	Jitter                types.Bool  `tfsdk:"jitter"`
+	RespectRetryAfter     types.Bool  `tfsdk:"respect_retry_after"`
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+}
+
 // Metadata returns the provider type name.
 func (p *citrixProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "citrix"
@@ -116,84 +148,91 @@ func (p *citrixProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 	resp.Schema = schema.Schema{
 		Description: "Manage and deploy Citrix resources easily using the Citrix Terraform provider. The provider currently supports both Citrix Virtual Apps & Desktops(CVAD) and Citrix Desktop as a Service (DaaS) solutions. You can automate creation of site setup including host connections, machine catalogs and delivery groups etc for both CVAD and Citrix DaaS. You can deploy resources in Citrix supported hypervisors and public clouds. Currently, we support deployments in Nutanix, VMware vSphere, XenServer, Microsoft Azure, AWS EC2 and Google Cloud Compute. Additionally, you can also use Manual provisioning or RemotePC to add workloads. The provider is developed and maintained by Citrix.",
 		Attributes: map[string]schema.Attribute{
-			"cvad_config": schema.SingleNestedAttribute{
-				Description: "Configuration for CVAD service.",
-				Optional:    true,
+			"cvad_config":            getCvadConfigSchema(),
+			"storefront_remote_host": getStoreFrontRemoteHostSchema(),
+			"sites": schema.MapNestedAttribute{
+				Description: "Named CVAD and StoreFront configurations, keyed by site name. " + "<br />" +
+					"Resources and data sources select a named configuration with their `site` attribute, and use `cvad_config` and `storefront_remote_host` otherwise. " +
+					"The client of a site is only initialized when a resource or data source of the site is used. Environment variables are not applied to named configurations.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cvad_config":            getCvadConfigSchema(),
+						"storefront_remote_host": getStoreFrontRemoteHostSchema(),
+					},
+				},
+			},
+			"retry": schema.SingleNestedAttribute{
+				Description: "Retry and rate limit policy applied to all requests sent to the Citrix APIs. " + "<br />" +
+					"Requests throttled with status code `429` or rejected with status code `503` are always retried. Requests failing with status code `502` or `504` are only retried when they are idempotent.",
+				Optional: true,
 				Attributes: map[string]schema.Attribute{
-					"hostname": schema.StringAttribute{
-						Description: "Host name / base URL of Citrix DaaS service. " + "<br />" +
-							"For Citrix on-premises customers (Required): Use this to specify Delivery Controller hostname. " + "<br />" +
-							"For Citrix Cloud customers (Optional): Use this to force override the Citrix DaaS service hostname." + "<br />" +
-							"Can be set via Environment Variable **CITRIX_HOSTNAME**.",
-						Optional: true,
+					"max_attempts": schema.Int64Attribute{
+						Description: fmt.Sprintf("Maximum number of attempts for a request, including the first attempt. Defaults to `%d`.", util.DefaultRetryMaxAttempts),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
-					"environment": schema.StringAttribute{
-						Description: "Citrix Cloud environment of the customer. Only applicable for Citrix Cloud customers. Available options: `Production`, `Staging`, `Japan`, `JapanStaging`, `Gov`, `GovStaging`. " + "<br />" +
-							"Can be set via Environment Variable **CITRIX_ENVIRONMENT**.",
-						Optional: true,
-						Validators: []validator.String{
-							stringvalidator.OneOf(
-								"Production",
-								"Staging",
-								"Japan",
-								"JapanStaging",
-								"Gov",
-								"GovStaging",
-							),
+					"backoff_base_seconds": schema.Int64Attribute{
+						Description: fmt.Sprintf("Delay in seconds before the first retry. The delay is doubled for every subsequent retry. Defaults to `%d`.", util.DefaultRetryBackoffBaseSeconds),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
 						},
 					},
-					"customer_id": schema.StringAttribute{
-						Description: "Citrix Cloud customer ID. Only applicable for Citrix Cloud customers." + "<br />" +
-							"Can be set via Environment Variable **CITRIX_CUSTOMER_ID**.",
-						Optional: true,
+					"backoff_cap_seconds": schema.Int64Attribute{
+						Description: fmt.Sprintf("Maximum delay in seconds between two attempts. Must be greater than or equal to `backoff_base_seconds`. Defaults to `%d`.", util.DefaultRetryBackoffCapSeconds),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
-					"client_id": schema.StringAttribute{
-						Description: "Client Id for Citrix DaaS service authentication. " + "<br />" +
-							"For Citrix On-Premises customers: Use this to specify a DDC administrator username. " + "<br />" +
-							"For Citrix Cloud customers: Use this to specify Cloud API Key Client Id." + "<br />" +
-							"Can be set via Environment Variable **CITRIX_CLIENT_ID**.",
-						Optional: true,
+					"jitter": schema.BoolAttribute{
+						Description: "Randomize the delay between two attempts to avoid retrying concurrent requests at the same time. Defaults to `true`.",
+						Optional:    true,
 					},
-					"client_secret": schema.StringAttribute{
-						Description: "Client Secret for Citrix DaaS service authentication. " + "<br />" +
-							"For Citrix on-premises customers: Use this to specify a DDC administrator password. " + "<br />" +
-							"For Citrix Cloud customers: Use this to specify Cloud API Key Client Secret." + "<br />" +
-							"Can be set via Environment Variable **CITRIX_CLIENT_SECRET**.",
-						Optional:  true,
-						Sensitive: true,
+					"respect_retry_after": schema.BoolAttribute{
+						Description: "Wait for the duration requested by the `Retry-After` response header instead of the computed backoff delay when the header is present. Defaults to `true`.",
+						Optional:    true,
 					},
-					"disable_ssl_verification": schema.BoolAttribute{
-						Description: "Disable SSL verification against the target DDC. " + "<br />" +
-							"Only applicable to on-premises customers. Citrix Cloud customers should omit this option. Set to true to skip SSL verification only when the target DDC does not have a valid SSL certificate issued by a trusted CA. " + "<br />" +
-							"When set to true, please make sure that your provider config is set for a known DDC hostname. " + "<br />" +
-							"[It is recommended to configure a valid certificate for the target DDC](https://docs.citrix.com/en-us/citrix-virtual-apps-desktops/install-configure/install-core/secure-web-studio-deployment) " + "<br />" +
-							"Can be set via Environment Variable **CITRIX_DISABLE_SSL_VERIFICATION**.",
-						Optional: true,
+					"max_concurrent_requests": schema.Int64Attribute{
+						Description: "Maximum number of requests sent to the Citrix APIs at the same time by the provider. Defaults to unlimited.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
 				},
 			},
-			"storefront_remote_host": schema.SingleNestedAttribute{
-				Description: "StoreFront Remote Host for Citrix DaaS service. " + "<br />" +
-					"Only applicable for Citrix on-premises StoreFront. Use this to specify StoreFront Remote Host. " + "<br />",
+			"audit_log_file": schema.StringAttribute{
+				Description: "Path of a file the provider appends an audit trail of all changes to, as one JSON object per line. " + "<br />" +
+					"Every mutating request sent to the Citrix APIs is recorded with its timestamp, transaction ID, method, path, status code, duration and request body. " +
+					"StoreFront changes are recorded with the Terraform operation as method and the resource type as path. " + "<br />" +
+					"Values of sensitive attributes, such as passwords and secrets, are redacted from the recorded bodies. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_AUDIT_LOG_FILE**.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"version_check": schema.SingleNestedAttribute{
+				Description: "Check for newer versions of the provider in the Terraform Registry. " + "<br />" +
+					"The check is sent through the `proxy_url` and trusts the `ca_certificate_file` or `ca_certificate_pem` of `cvad_config`. The latest version is cached for 24 hours.",
 				Optional: true,
 				Attributes: map[string]schema.Attribute{
-					"computer_name": schema.StringAttribute{
-						Required: true,
-						Description: "StoreFront server computer Name " + "<br />" +
-							"Only applicable for Citrix on-premises customers. Use this to specify StoreFront server computer name " + "<br />" +
-							"Can be set via Environment Variable **SF_COMPUTER_NAME**.",
-					},
-					"ad_admin_username": schema.StringAttribute{
-						Description: "Active Directory Admin Username to connect to storefront server " + "<br />" +
-							"Only applicable for Citrix on-premises customers. Use this to specify AD admin username " + "<br />" +
-							"Can be set via Environment Variable **SF_AD_ADMIN_USERNAME**.",
-						Required: true,
+					"disabled": schema.BoolAttribute{
+						Description: "Disable the version check, e.g. in air-gapped environments. Defaults to `false`. " + "<br />" +
+							"Can be set via Environment Variable **CITRIX_DISABLE_VERSION_CHECK**.",
+						Optional: true,
 					},
-					"ad_admin_password": schema.StringAttribute{
-						Description: "Active Directory Admin Password to connect to storefront server " + "<br />" +
-							"Only applicable for Citrix on-premises customers. Use this to specify AD admin password" + "<br />" +
-							"Can be set via Environment Variable **SF_AD_ADMIN_PASSWORD**.",
-						Required: true,
+					"cache_directory": schema.StringAttribute{
+						Description: "Directory of the file caching the latest version of the provider. Defaults to the temporary directory of the operating system. " + "<br />" +
+							"Can be set via Environment Variable **CITRIX_VERSION_CHECK_CACHE_DIRECTORY**.",
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
 					},
 				},
 			},
@@ -201,10 +240,196 @@ func (p *citrixProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 	}
 }
 
+func getCvadConfigSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Configuration for CVAD service.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Description: "Host name / base URL of Citrix DaaS service. " + "<br />" +
+					"For Citrix on-premises customers (Required): Use this to specify Delivery Controller hostname. " + "<br />" +
+					"For Citrix Cloud customers (Optional): Use this to force override the Citrix DaaS service hostname." + "<br />" +
+					"Can be set via Environment Variable **CITRIX_HOSTNAME**.",
+				Optional: true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "Citrix Cloud environment of the customer. Only applicable for Citrix Cloud customers. Available options: `Production`, `Staging`, `Japan`, `JapanStaging`, `Gov`, `GovStaging`, `Custom`. " + "<br />" +
+					"Use `Custom` for sovereign and private Citrix Cloud environments, or when the Citrix Cloud endpoints are rewritten by a proxy, together with `auth_url` and `api_gateway_url`. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_ENVIRONMENT**.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"Production",
+						"Staging",
+						"Japan",
+						"JapanStaging",
+						"Gov",
+						"GovStaging",
+						customEnvironment,
+					),
+				},
+			},
+			"customer_id": schema.StringAttribute{
+				Description: "Citrix Cloud customer ID. Only applicable for Citrix Cloud customers." + "<br />" +
+					"Can be set via Environment Variable **CITRIX_CUSTOMER_ID**.",
+				Optional: true,
+			},
+			"client_id": schema.StringAttribute{
+				Description: "Client Id for Citrix DaaS service authentication. " + "<br />" +
+					"For Citrix On-Premises customers: Use this to specify a DDC administrator username. " + "<br />" +
+					"For Citrix Cloud customers: Use this to specify Cloud API Key Client Id." + "<br />" +
+					"Can be set via Environment Variable **CITRIX_CLIENT_ID**.",
+				Optional: true,
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "Client Secret for Citrix DaaS service authentication. " + "<br />" +
+					"For Citrix on-premises customers: Use this to specify a DDC administrator password. " + "<br />" +
+					"For Citrix Cloud customers: Use this to specify Cloud API Key Client Secret." + "<br />" +
+					"Can be set via Environment Variable **CITRIX_CLIENT_SECRET**.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "Pre-issued bearer token for Citrix DaaS service authentication. " + "<br />" +
+					"When set, the provider does not sign in with `client_id` and `client_secret`. The token is not renewed by the provider, use `access_token_file` for long running operations. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_ACCESS_TOKEN**.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("access_token_file")),
+				},
+			},
+			"access_token_file": schema.StringAttribute{
+				Description: "Path of a file containing a pre-issued bearer token for Citrix DaaS service authentication. " + "<br />" +
+					"When set, the provider does not sign in with `client_id` and `client_secret`. The file is read again when the token expires, so that an external token broker can renew the token during long running operations. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_ACCESS_TOKEN_FILE**.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"auth_url": schema.StringAttribute{
+				Description: "URL of the Citrix Cloud token endpoint, e.g. `https://api.example.com/cctrustoauth2/{customer_id}/tokens/clients`. " + "<br />" +
+					"Required and only applicable when `environment` is `Custom`. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_AUTH_URL**.",
+				Optional: true,
+			},
+			"api_gateway_url": schema.StringAttribute{
+				Description: "URL of the Citrix Cloud API gateway, e.g. `https://api.example.com`. The URL must not contain a path. " + "<br />" +
+					"Required and only applicable when `environment` is `Custom`. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_API_GATEWAY_URL**.",
+				Optional: true,
+			},
+			"resource_locations_url": schema.StringAttribute{
+				Description: "Base URL of the Citrix Cloud Resource Locations service. Defaults to `{api_gateway_url}/resourcelocations`. " + "<br />" +
+					"Only applicable when `environment` is `Custom`. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_RESOURCE_LOCATIONS_URL**.",
+				Optional: true,
+			},
+			"gac_url": schema.StringAttribute{
+				Description: "Base URL of the Citrix Cloud Global App Configuration service. Defaults to `https://wsaca.cloud.com`. " + "<br />" +
+					"Only applicable when `environment` is `Custom`. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_GAC_URL**.",
+				Optional: true,
+			},
+			"quick_create_url": schema.StringAttribute{
+				Description: "Base URL of the Citrix Cloud QuickCreate service. Defaults to `{api_gateway_url}/quickcreateservice`. " + "<br />" +
+					"Only applicable when `environment` is `Custom`. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_QUICK_CREATE_URL**.",
+				Optional: true,
+			},
+			"ca_certificate_file": schema.StringAttribute{
+				Description: "Path of a PEM encoded CA certificate bundle used to verify the certificates of the DDC(s), the Citrix Cloud APIs and the proxy, in addition to the system certificate pool. " + "<br />" +
+					"Use this when the DDC(s) use a certificate issued by an internal Certificate Authority instead of disabling SSL verification. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_CA_CERTIFICATE_FILE**.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ca_certificate_pem")),
+				},
+			},
+			"ca_certificate_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificate bundle used to verify the certificates of the DDC(s), the Citrix Cloud APIs and the proxy, in addition to the system certificate pool. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_CA_CERTIFICATE_PEM**.",
+				Optional: true,
+			},
+			"client_certificate_file": schema.StringAttribute{
+				Description: "Path of a PEM encoded client certificate for mutual TLS. Must be specified together with `client_key_file` or `client_key_pem`. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_CLIENT_CERTIFICATE_FILE**.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("client_certificate_pem")),
+				},
+			},
+			"client_certificate_pem": schema.StringAttribute{
+				Description: "PEM encoded client certificate for mutual TLS. Must be specified together with `client_key_file` or `client_key_pem`. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_CLIENT_CERTIFICATE_PEM**.",
+				Optional: true,
+			},
+			"client_key_file": schema.StringAttribute{
+				Description: "Path of the PEM encoded private key of the client certificate for mutual TLS. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_CLIENT_KEY_FILE**.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate for mutual TLS. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_CLIENT_KEY_PEM**.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy used for all requests sent by the provider, e.g. `http://proxy.example.com:8080`. Supported schemes are `http`, `https` and `socks5`. " + "<br />" +
+					"Defaults to the proxy configured with the **HTTPS_PROXY** and **NO_PROXY** environment variables. " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_PROXY_URL**.",
+				Optional: true,
+			},
+			"disable_ssl_verification": schema.BoolAttribute{
+				Description: "Disable SSL verification against the target DDC. " + "<br />" +
+					"Only applicable to on-premises customers. Citrix Cloud customers should omit this option. Set to true to skip SSL verification only when the target DDC does not have a valid SSL certificate issued by a trusted CA. " + "<br />" +
+					"When set to true, please make sure that your provider config is set for a known DDC hostname. " + "<br />" +
+					"[It is recommended to configure a valid certificate for the target DDC](https://docs.citrix.com/en-us/citrix-virtual-apps-desktops/install-configure/install-core/secure-web-studio-deployment) " + "<br />" +
+					"Can be set via Environment Variable **CITRIX_DISABLE_SSL_VERIFICATION**.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func getStoreFrontRemoteHostSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "StoreFront Remote Host for Citrix DaaS service. " + "<br />" +
+			"Only applicable for Citrix on-premises StoreFront. Use this to specify StoreFront Remote Host. " + "<br />",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"computer_name": schema.StringAttribute{
+				Required: true,
+				Description: "StoreFront server computer Name " + "<br />" +
+					"Only applicable for Citrix on-premises customers. Use this to specify StoreFront server computer name " + "<br />" +
+					"Can be set via Environment Variable **SF_COMPUTER_NAME**.",
+			},
+			"ad_admin_username": schema.StringAttribute{
+				Description: "Active Directory Admin Username to connect to storefront server " + "<br />" +
+					"Only applicable for Citrix on-premises customers. Use this to specify AD admin username " + "<br />" +
+					"Can be set via Environment Variable **SF_AD_ADMIN_USERNAME**.",
+				Required: true,
+			},
+			"ad_admin_password": schema.StringAttribute{
+				Description: "Active Directory Admin Password to connect to storefront server " + "<br />" +
+					"Only applicable for Citrix on-premises customers. Use this to specify AD admin password" + "<br />" +
+					"Can be set via Environment Variable **SF_AD_ADMIN_PASSWORD**.",
+				Required: true,
+			},
+		},
+	}
+}
+
 func middlewareAuthWithCustomerIdHeaderFunc(authClient *citrixclient.CitrixDaasClient, r *http.Request) {
 	// Auth
 	if authClient != nil && r.Header.Get("Authorization") == "" {
-		token, _, err := authClient.SignIn()
+		token, _, err := util.SignIn(authClient)
 		if err != nil {
 			tflog.Error(r.Context(), "Could not sign into Citrix DaaS, error: "+err.Error())
 		}
@@ -233,7 +458,7 @@ func middlewareAuthWithCustomerIdHeaderFunc(authClient *citrixclient.CitrixDaasC
 func middlewareAuthFunc(authClient *citrixclient.CitrixDaasClient, r *http.Request) {
 	// Auth
 	if authClient != nil && r.Header.Get("Authorization") == "" {
-		token, _, err := authClient.SignIn()
+		token, _, err := util.SignIn(authClient)
 		if err != nil {
 			tflog.Error(r.Context(), "Could not sign into Citrix DaaS, error: "+err.Error())
 		}
@@ -255,132 +480,113 @@ func middlewareAuthFunc(authClient *citrixclient.CitrixDaasClient, r *http.Reque
 	})
 }
 
-type registryResponse struct {
-	Version  string   `json:"version"`
-	Versions []string `json:"versions"`
-}
-
-func getVersionFromTerraformRegistry() (string, error) {
-	httpResp, err := http.Get("https://registry.terraform.io/v1/providers/citrix/citrix")
-	if err != nil {
-		return "", err
-	}
-	defer httpResp.Body.Close()
-	if httpResp.StatusCode != 200 {
-		return "", err
-	}
+// Configure prepares a Citrixdaas API client for data sources and resources.
+func (p *citrixProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Citrix Cloud client")
+	defer util.PanicHandler(&resp.Diagnostics)
 
-	body, err := io.ReadAll(httpResp.Body)
-	if err != nil {
-		return "", err
-	}
-	registryResp := registryResponse{}
-	err = json.Unmarshal(body, &registryResp)
-	if err != nil {
-		return "", err
+	// Retrieve provider data from configuration
+	var config citrixProviderModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// find the last stable version
-	// the versions are returned in order from oldest to newest so reverse it first
-	slices.Reverse(registryResp.Versions)
-	for _, ver := range registryResp.Versions {
-		if semver.Prerelease("v"+ver) == "" {
-			return ver, nil
-		}
+	if checker := getVersionChecker(config.VersionCheck, config.CvadConfig, os.Getenv); checker != nil {
+		p.versionCheck(resp, checker)
 	}
 
-	// if no stable version found just return the latest version
-	return registryResp.Version, nil
-}
-
-// best effort version check, if anything goes wrong just bail out
-func (p *citrixProvider) versionCheck(resp *provider.ConfigureResponse) {
-	if !semver.IsValid("v" + p.version) {
+	retryPolicy := getRetryPolicy(resp, config.Retry)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var registryVersion string
-	updateVersionFile := false
-
-	versionCheckFilePath := filepath.Join(os.TempDir(), "citrix_provider_version_check.txt")
-	info, err := os.Stat(versionCheckFilePath)
-	if err == nil && info != nil && time.Now().Before(info.ModTime().Add(time.Hour*time.Duration(24))) {
-		// use cached version for version check
-		txt, err := os.ReadFile(versionCheckFilePath)
-		if err != nil {
-			return
-		}
-		registryVersion = string(txt)
-	} else {
-		registryVersion, err = getVersionFromTerraformRegistry()
-		if err != nil {
-			return
-		}
-		updateVersionFile = true
+	auditLog := p.getAuditLog(ctx, resp, config.AuditLogFile)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if semver.Compare("v"+registryVersion, "v"+p.version) > 0 {
-		resp.Diagnostics.AddWarning(
-			"New version of the citrix/citrix provider is available",
-			fmt.Sprintf("Please update the provider version in terraform configuration to >=%s and then run `terraform init --upgrade` to get the latest version.", registryVersion))
+	client := p.configureClient(ctx, resp, config.CvadConfig, config.StoreFrontRemoteHost, true, retryPolicy, auditLog)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if updateVersionFile {
-		err = os.WriteFile(versionCheckFilePath, []byte(registryVersion), 0660)
-		if err != nil {
-			return
+	// Clients of named sites are only initialized when a resource or data source selects the site
+	if len(config.Sites) > 0 {
+		siteClients := util.NewSiteClients()
+		for siteName, site := range config.Sites {
+			site := site
+			siteClients.AddSite(siteName, func(ctx context.Context) (*citrixclient.CitrixDaasClient, diag.Diagnostics) {
+				siteResp := &provider.ConfigureResponse{}
+				siteClient := p.configureClient(ctx, siteResp, site.CvadConfig, site.StoreFrontRemoteHost, false, retryPolicy, auditLog)
+				return siteClient, siteResp.Diagnostics
+			})
 		}
+		util.RegisterSiteClients(client, siteClients)
 	}
-}
 
-// Configure prepares a Citrixdaas API client for data sources and resources.
-func (p *citrixProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	tflog.Info(ctx, "Configuring Citrix Cloud client")
-	defer util.PanicHandler(&resp.Diagnostics)
+	// Make the Citrix API client available during DataSource and Resource
+	// type Configure methods.
+	resp.DataSourceData = client
+	resp.ResourceData = client
 
-	p.versionCheck(resp)
+	tflog.Info(ctx, "Configured Citrix API client", map[string]any{"success": true})
+}
 
-	// Retrieve provider data from configuration
-	var config citrixProviderModel
-	diags := req.Config.Get(ctx, &config)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+// configureClient creates the StoreFront and Citrix DaaS clients for a CVAD and StoreFront configuration.
+// Environment variables are only used for the default configuration of the provider, not for named sites.
+func (p *citrixProvider) configureClient(ctx context.Context, resp *provider.ConfigureResponse, cvadConfig *cvadConfig, storefrontConfig *storefrontConfig, useEnvironmentVariables bool, retryPolicy *util.RetryPolicy, auditLog *util.AuditLog) *citrixclient.CitrixDaasClient {
+	getEnv := func(key string) string {
+		if !useEnvironmentVariables {
+			return ""
+		}
+		return os.Getenv(key)
 	}
 
 	// Set StoreFront Client
 	client := &citrixclient.CitrixDaasClient{}
 
-	storefront_computer_name := os.Getenv("SF_COMPUTER_NAME")
-	storefront_ad_admin_username := os.Getenv("SF_AD_ADMIN_USERNAME")
-	storefront_ad_admin_password := os.Getenv("SF_AD_ADMIN_PASSWORD")
+	storefront_computer_name := getEnv("SF_COMPUTER_NAME")
+	storefront_ad_admin_username := getEnv("SF_AD_ADMIN_USERNAME")
+	storefront_ad_admin_password := getEnv("SF_AD_ADMIN_PASSWORD")
 
 	// If practitioner provide a storefront configuration
-	if storefront_computer_name != "" || storefront_ad_admin_username != "" || storefront_ad_admin_password != "" || config.StoreFrontRemoteHost != nil {
-		if config.StoreFrontRemoteHost != nil {
-			if !config.StoreFrontRemoteHost.ComputerName.IsNull() {
-				storefront_computer_name = config.StoreFrontRemoteHost.ComputerName.ValueString()
+	if storefront_computer_name != "" || storefront_ad_admin_username != "" || storefront_ad_admin_password != "" || storefrontConfig != nil {
+		if storefrontConfig != nil {
+			if !storefrontConfig.ComputerName.IsNull() {
+				storefront_computer_name = storefrontConfig.ComputerName.ValueString()
 			}
-			if !config.StoreFrontRemoteHost.ADadminUserName.IsNull() {
-				storefront_ad_admin_username = config.StoreFrontRemoteHost.ADadminUserName.ValueString()
+			if !storefrontConfig.ADadminUserName.IsNull() {
+				storefront_ad_admin_username = storefrontConfig.ADadminUserName.ValueString()
 			}
-			if !config.StoreFrontRemoteHost.AdAdminPassword.IsNull() {
-				storefront_ad_admin_password = config.StoreFrontRemoteHost.AdAdminPassword.ValueString()
+			if !storefrontConfig.AdAdminPassword.IsNull() {
+				storefront_ad_admin_password = storefrontConfig.AdAdminPassword.ValueString()
 			}
 		}
 		client.NewStoreFrontClient(ctx, storefront_computer_name, storefront_ad_admin_username, storefront_ad_admin_password)
 	}
 
 	// Initialize cvad client
-	clientId := os.Getenv("CITRIX_CLIENT_ID")
-	clientSecret := os.Getenv("CITRIX_CLIENT_SECRET")
-	hostname := os.Getenv("CITRIX_HOSTNAME")
-	environment := os.Getenv("CITRIX_ENVIRONMENT")
-	customerId := os.Getenv("CITRIX_CUSTOMER_ID")
-	disableSslVerification := strings.EqualFold(os.Getenv("CITRIX_DISABLE_SSL_VERIFICATION"), "true")
-	quick_create_host_name := os.Getenv("CITRIX_QUICK_CREATE_HOST_NAME")
-
-	if cvadConfig := config.CvadConfig; cvadConfig != nil {
+	clientId := getEnv("CITRIX_CLIENT_ID")
+	clientSecret := getEnv("CITRIX_CLIENT_SECRET")
+	hostname := getEnv("CITRIX_HOSTNAME")
+	environment := getEnv("CITRIX_ENVIRONMENT")
+	customerId := getEnv("CITRIX_CUSTOMER_ID")
+	accessToken := getEnv("CITRIX_ACCESS_TOKEN")
+	accessTokenFile := getEnv("CITRIX_ACCESS_TOKEN_FILE")
+	disableSslVerification := strings.EqualFold(getEnv("CITRIX_DISABLE_SSL_VERIFICATION"), "true")
+	quick_create_host_name := getEnv("CITRIX_QUICK_CREATE_HOST_NAME")
+	transport := getTransportSettings(cvadConfig, getEnv)
+	overrides := endpointOverrides{
+		AuthUrl:              getEnv("CITRIX_AUTH_URL"),
+		ApiGatewayUrl:        getEnv("CITRIX_API_GATEWAY_URL"),
+		ResourceLocationsUrl: getEnv("CITRIX_RESOURCE_LOCATIONS_URL"),
+		GacUrl:               getEnv("CITRIX_GAC_URL"),
+		QuickCreateUrl:       getEnv("CITRIX_QUICK_CREATE_URL"),
+	}
+
+	if cvadConfig != nil {
 		if !cvadConfig.ClientId.IsNull() {
 			clientId = cvadConfig.ClientId.ValueString()
 		}
@@ -389,6 +595,16 @@ func (p *citrixProvider) Configure(ctx context.Context, req provider.ConfigureRe
 			clientSecret = cvadConfig.ClientSecret.ValueString()
 		}
 
+		if !cvadConfig.AccessToken.IsNull() {
+			accessToken = cvadConfig.AccessToken.ValueString()
+			accessTokenFile = ""
+		}
+
+		if !cvadConfig.AccessTokenFile.IsNull() {
+			accessTokenFile = cvadConfig.AccessTokenFile.ValueString()
+			accessToken = ""
+		}
+
 		if !cvadConfig.Hostname.IsNull() {
 			hostname = cvadConfig.Hostname.ValueString()
 		}
@@ -404,24 +620,57 @@ func (p *citrixProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		if !cvadConfig.DisableSslVerification.IsNull() {
 			disableSslVerification = cvadConfig.DisableSslVerification.ValueBool()
 		}
+
+		if !cvadConfig.AuthUrl.IsNull() {
+			overrides.AuthUrl = cvadConfig.AuthUrl.ValueString()
+		}
+
+		if !cvadConfig.ApiGatewayUrl.IsNull() {
+			overrides.ApiGatewayUrl = cvadConfig.ApiGatewayUrl.ValueString()
+		}
+
+		if !cvadConfig.ResourceLocationsUrl.IsNull() {
+			overrides.ResourceLocationsUrl = cvadConfig.ResourceLocationsUrl.ValueString()
+		}
+
+		if !cvadConfig.GacUrl.IsNull() {
+			overrides.GacUrl = cvadConfig.GacUrl.ValueString()
+		}
+
+		if !cvadConfig.QuickCreateUrl.IsNull() {
+			overrides.QuickCreateUrl = cvadConfig.QuickCreateUrl.ValueString()
+		}
 	}
 
-	if clientId != "" || clientSecret != "" || config.CvadConfig != nil {
-		p.validateAndInitializeDaaSClient(ctx, resp, client, clientId, clientSecret, hostname, environment, customerId, quick_create_host_name, disableSslVerification)
+	if clientId != "" || clientSecret != "" || accessToken != "" || accessTokenFile != "" || cvadConfig != nil {
+		p.validateAndInitializeDaaSClient(ctx, resp, client, clientId, clientSecret, accessToken, accessTokenFile, hostname, environment, customerId, quick_create_host_name, disableSslVerification, overrides, transport)
 		if resp.Diagnostics.HasError() {
-			return
+			return client
+		}
+
+		if retryPolicy != nil {
+			applyRetryPolicy(client, retryPolicy)
+		}
+		if auditLog != nil {
+			applyAuditLog(client, auditLog)
 		}
 	}
 
-	// Make the Citrix API client available during DataSource and Resource
-	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	if auditLog != nil {
+		util.SetAuditLog(client, auditLog)
+	}
 
-	tflog.Info(ctx, "Configured Citrix API client", map[string]any{"success": true})
+	return client
 }
 
-func (p *citrixProvider) validateAndInitializeDaaSClient(ctx context.Context, resp *provider.ConfigureResponse, client *citrixclient.CitrixDaasClient, clientId, clientSecret, hostname, environment, customerId, quick_create_host_name string, disableSslVerification bool) {
+func (p *citrixProvider) validateAndInitializeDaaSClient(ctx context.Context, resp *provider.ConfigureResponse, client *citrixclient.CitrixDaasClient, clientId, clientSecret, accessToken, accessTokenFile, hostname, environment, customerId, quick_create_host_name string, disableSslVerification bool, overrides endpointOverrides, transport transportSettings) {
+	useAccessToken := accessToken != "" || accessTokenFile != ""
+	if useAccessToken {
+		// Authenticate with the pre-issued access token instead of signing in with client credentials
+		clientId = util.AccessTokenClientCredentialPlaceholder
+		clientSecret = util.AccessTokenClientCredentialPlaceholder
+	}
+
 	if clientId == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_id"),
@@ -477,82 +726,30 @@ func (p *citrixProvider) validateAndInitializeDaaSClient(ctx context.Context, re
 		)
 	}
 
-	// Indicate whether Citrix Cloud requests should go through API Gateway
-	apiGateway := true
-	ccUrl := ""
-	if !onPremises {
-		if environment == "Production" {
-			ccUrl = "api.cloud.com"
-		} else if environment == "Staging" {
-			ccUrl = "api.cloudburrito.com"
-		} else if environment == "Japan" {
-			ccUrl = "api.citrixcloud.jp"
-		} else if environment == "JapanStaging" {
-			ccUrl = "api.citrixcloudstaging.jp"
-		} else if environment == "Gov" {
-			ccUrl = fmt.Sprintf("registry.citrixworkspacesapi.us/%s", customerId)
-		} else if environment == "GovStaging" {
-			ccUrl = fmt.Sprintf("registry.ctxwsstgapi.us/%s", customerId)
-		}
-		if hostname == "" {
-			if environment == "Gov" {
-				hostname = fmt.Sprintf("%s.xendesktop.us", customerId)
-				apiGateway = false
-			} else if environment == "GovStaging" {
-				hostname = fmt.Sprintf("%s.xdstaging.us", customerId)
-				apiGateway = false
-			} else {
-				hostname = ccUrl
-			}
-		} else if !strings.HasPrefix(hostname, "api.") {
-			// When a cloud customer sets explicit hostname to the cloud DDC, bypass API Gateway
-			apiGateway = false
-		}
+	endpoints, err := getCitrixEndpoints(environment, customerId, hostname, quick_create_host_name, onPremises, overrides)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment"),
+			"Invalid Citrix API endpoint configuration",
+			"The provider cannot create the Citrix API client as the endpoint configuration is invalid. \n\n"+
+				"Error: "+err.Error(),
+		)
 	}
-
-	authUrl := ""
-	isGov := false
-	if onPremises {
-		authUrl = fmt.Sprintf("https://%s/citrix/orchestration/api/tokens", hostname)
-	} else {
-		if environment == "Production" {
-			authUrl = fmt.Sprintf("https://api.cloud.com/cctrustoauth2/%s/tokens/clients", customerId)
-		} else if environment == "Staging" {
-			authUrl = fmt.Sprintf("https://api.cloudburrito.com/cctrustoauth2/%s/tokens/clients", customerId)
-		} else if environment == "Japan" {
-			authUrl = fmt.Sprintf("https://api.citrixcloud.jp/cctrustoauth2/%s/tokens/clients", customerId)
-		} else if environment == "JapanStaging" {
-			authUrl = fmt.Sprintf("https://api.citrixcloudstaging.jp/cctrustoauth2/%s/tokens/clients", customerId)
-		} else if environment == "Gov" {
-			authUrl = fmt.Sprintf("https://trust.citrixworkspacesapi.us/%s/tokens/clients", customerId)
-			isGov = true
-		} else if environment == "GovStaging" {
-			authUrl = fmt.Sprintf("https://trust.ctxwsstgapi.us/%s/tokens/clients", customerId)
-			isGov = true
-		} else {
-			authUrl = fmt.Sprintf("https://%s/cctrustoauth2/%s/tokens/clients", hostname, customerId)
+	var httpClient *http.Client
+	if transport.isConfigured() {
+		httpClient, err = newHttpClient(transport, disableSslVerification)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid TLS or proxy configuration",
+				"The provider cannot create the Citrix API client as the TLS or proxy configuration is invalid. \n\n"+
+					"Error: "+err.Error(),
+			)
 		}
 	}
-
-	quickCreateHostname := ""
-	if quick_create_host_name != "" {
-		// If customer specified a quick create host name, use it
-		quickCreateHostname = quick_create_host_name
-	} else {
-		if environment == "Production" {
-			quickCreateHostname = "api.cloud.com/quickcreateservice"
-		} else if environment == "Staging" {
-			quickCreateHostname = "api.cloudburrito.com/quickcreateservice"
-		} else if environment == "Japan" {
-			quickCreateHostname = "api.citrixcloud.jp/quickcreateservice"
-		} else if environment == "JapanStaging" {
-			quickCreateHostname = "api.citrixcloudstaging.jp/quickcreateservice"
-		} else if environment == "Gov" {
-			quickCreateHostname = "quickcreate.apps.cloud.us"
-		} else if environment == "GovStaging" {
-			quickCreateHostname = "quickcreate.apps.cloudstaging.us"
-		}
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	hostname = endpoints.Hostname
 
 	ctx = tflog.SetField(ctx, "citrix_hostname", hostname)
 	if !onPremises {
@@ -581,14 +778,62 @@ func (p *citrixProvider) validateAndInitializeDaaSClient(ctx context.Context, re
 		ctx = tflog.MaskAllFieldValuesStrings(ctx, customerId)
 	}
 
+	var accessTokenSource *util.AccessTokenSource
+	if useAccessToken {
+		accessTokenSource = util.NewAccessTokenSource(accessToken, accessTokenFile)
+		token, claims, err := accessTokenSource.Token()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("access_token_file"),
+				"Unable to read Citrix API access token",
+				"The provider cannot read the access token file. \n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+		ctx = tflog.SetField(ctx, "citrix_access_token", token)
+		ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "citrix_access_token")
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, token)
+
+		if claims.IsExpired() {
+			resp.Diagnostics.AddError(
+				"Citrix API access token expired",
+				fmt.Sprintf("The access token expired at %s. ", claims.ExpiresAt.Format(time.RFC3339))+
+					"Request a new token from your token broker before running Terraform, or use access_token_file so that the provider picks up renewed tokens.",
+			)
+			return
+		}
+
+		util.SetAccessTokenSource(client, accessTokenSource)
+		// Populate the token cache of the client so that it does not sign in with client credentials
+		if _, _, err := util.SignIn(client); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Citrix API Client",
+				"An unexpected error occurred when reading the Citrix API access token. \n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	tflog.Debug(ctx, "Creating Citrix API client")
 
 	userAgent := "citrix-terraform-provider/" + p.version + " (https://github.com/citrix/terraform-provider-citrix)"
 	// Create a new Citrix API client using the configuration values
-	httpResp, err := client.NewCitrixDaasClient(ctx, authUrl, ccUrl, hostname, customerId, clientId, clientSecret, onPremises, apiGateway, isGov, disableSslVerification, &userAgent, middlewareAuthFunc, middlewareAuthWithCustomerIdHeaderFunc)
+	var httpResp *http.Response
+	if httpClient != nil {
+		httpResp, err = newCitrixDaasClientWithHttpClient(ctx, client, httpClient, endpoints, customerId, clientId, clientSecret, onPremises, userAgent, middlewareAuthFunc, middlewareAuthWithCustomerIdHeaderFunc)
+	} else {
+		httpResp, err = client.NewCitrixDaasClient(ctx, endpoints.AuthUrl, endpoints.CcUrl, hostname, customerId, clientId, clientSecret, onPremises, endpoints.ApiGateway, endpoints.IsGov, disableSslVerification, &userAgent, middlewareAuthFunc, middlewareAuthWithCustomerIdHeaderFunc)
+	}
 	if err != nil {
 		if httpResp != nil {
-			if httpResp.StatusCode == 401 {
+			if useAccessToken && (httpResp.StatusCode == 401 || httpResp.StatusCode == 403) {
+				resp.Diagnostics.AddError(
+					"Access token rejected by Citrix DaaS service",
+					getAccessTokenRejectedErrorDetail(accessTokenSource, hostname, customerId),
+				)
+			} else if httpResp.StatusCode == 401 {
 				resp.Diagnostics.AddError(
 					"Invalid credential in provider config",
 					"Make sure client_id and client_secret is correct in provider config. ",
@@ -633,7 +878,8 @@ func (p *citrixProvider) validateAndInitializeDaaSClient(ctx context.Context, re
 			if len(cryptoErr.UnverifiedCertificates) > 0 {
 				resp.Diagnostics.AddError(
 					"DDC(s) does not have a valid SSL certificate issued by a trusted Certificate Authority",
-					"If you are running against on-premises DDC(s) that does not have an SSL certificate issue by a trusted CA, consider setting \"disable_ssl_verification\" to \"true\" in provider config.",
+					"If the DDC(s) or the proxy use an SSL certificate issued by an internal Certificate Authority, set \"ca_certificate_file\" or \"ca_certificate_pem\" in provider config to the certificate of the Certificate Authority. "+
+						"Only if the certificate cannot be trusted otherwise and you are running against on-premises DDC(s), consider setting \"disable_ssl_verification\" to \"true\" in provider config.",
 				)
 
 				return
@@ -659,9 +905,172 @@ func (p *citrixProvider) validateAndInitializeDaaSClient(ctx context.Context, re
 
 		return
 	}
+
+	// Apply the endpoints of the Custom environment which cannot be passed to the Citrix DaaS client
+	if endpoints.ResourceLocationsUrl != "" {
+		client.ResourceLocationsClient.GetConfig().Servers = ccresourcelocations.ServerConfigurations{
+			{
+				URL: endpoints.ResourceLocationsUrl,
+			},
+		}
+	}
+	if endpoints.GacUrl != "" {
+		client.GacClient.GetConfig().Servers = globalappconfiguration.ServerConfigurations{
+			{
+				URL: endpoints.GacUrl,
+			},
+		}
+	}
+
 	// Set Quick Create Client
-	if quickCreateHostname != "" {
-		client.NewQuickCreateClient(ctx, quickCreateHostname, middlewareAuthFunc)
+	if endpoints.QuickCreateHostname != "" {
+		client.NewQuickCreateClient(ctx, endpoints.QuickCreateHostname, middlewareAuthFunc)
+		if httpClient != nil {
+			client.QuickCreateClient.GetConfig().HTTPClient = httpClient
+		}
+	}
+}
+
+func getAccessTokenRejectedErrorDetail(accessTokenSource *util.AccessTokenSource, hostname, customerId string) string {
+	detail := "The access token is expired or was not issued for this Citrix DaaS service. "
+	_, claims, err := accessTokenSource.Token()
+	if err != nil || claims == nil {
+		return detail + fmt.Sprintf("Make sure the token was issued for customer %s and host %s.", customerId, hostname)
+	}
+	if claims.IsExpired() {
+		return fmt.Sprintf("The access token expired at %s. Request a new token from your token broker.", claims.ExpiresAt.Format(time.RFC3339))
+	}
+	detail += fmt.Sprintf("Make sure the token was issued for customer %s and host %s.", customerId, hostname)
+	if len(claims.Audience) > 0 {
+		detail += fmt.Sprintf(" The token audience is `%s`.", strings.Join(claims.Audience, ", "))
+	}
+	return detail
+}
+
+func getRetryPolicy(resp *provider.ConfigureResponse, retry *retryConfig) *util.RetryPolicy {
+	if retry == nil {
+		return nil
+	}
+
+	maxAttempts := util.DefaultRetryMaxAttempts
+	if !retry.MaxAttempts.IsNull() {
+		maxAttempts = retry.MaxAttempts.ValueInt64()
+	}
+
+	backoffBase := util.DefaultRetryBackoffBaseSeconds
+	if !retry.BackoffBaseSeconds.IsNull() {
+		backoffBase = retry.BackoffBaseSeconds.ValueInt64()
+	}
+
+	backoffCap := util.DefaultRetryBackoffCapSeconds
+	if !retry.BackoffCapSeconds.IsNull() {
+		backoffCap = retry.BackoffCapSeconds.ValueInt64()
+	}
+
+	if backoffCap < backoffBase {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry").AtName("backoff_cap_seconds"),
+			"Invalid retry configuration",
+			fmt.Sprintf("backoff_cap_seconds (%d) must be greater than or equal to backoff_base_seconds (%d).", backoffCap, backoffBase),
+		)
+		return nil
+	}
+
+	jitter := true
+	if !retry.Jitter.IsNull() {
+		jitter = retry.Jitter.ValueBool()
+	}
+
+	respectRetryAfter := true
+	if !retry.RespectRetryAfter.IsNull() {
+		respectRetryAfter = retry.RespectRetryAfter.ValueBool()
+	}
+
+	maxConcurrentRequests := 0
+	if !retry.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = int(retry.MaxConcurrentRequests.ValueInt64())
+	}
+
+	return util.NewRetryPolicy(int(maxAttempts), time.Duration(backoffBase)*time.Second, time.Duration(backoffCap)*time.Second, jitter, respectRetryAfter, maxConcurrentRequests)
+}
+
+// applyRetryPolicy replaces the HTTP clients of all Citrix API clients with clients sharing the same retry policy and concurrency limit
+func applyRetryPolicy(client *citrixclient.CitrixDaasClient, retryPolicy *util.RetryPolicy) {
+	if client.ApiClient != nil {
+		cfg := client.ApiClient.GetConfig()
+		cfg.HTTPClient = retryPolicy.WrapHttpClient(cfg.HTTPClient)
+	}
+	if client.GacClient != nil {
+		cfg := client.GacClient.GetConfig()
+		cfg.HTTPClient = retryPolicy.WrapHttpClient(cfg.HTTPClient)
+	}
+	if client.ResourceLocationsClient != nil {
+		cfg := client.ResourceLocationsClient.GetConfig()
+		cfg.HTTPClient = retryPolicy.WrapHttpClient(cfg.HTTPClient)
+	}
+	if client.CCAdminsClient != nil {
+		cfg := client.CCAdminsClient.GetConfig()
+		cfg.HTTPClient = retryPolicy.WrapHttpClient(cfg.HTTPClient)
+	}
+	if client.QuickCreateClient != nil {
+		cfg := client.QuickCreateClient.GetConfig()
+		cfg.HTTPClient = retryPolicy.WrapHttpClient(cfg.HTTPClient)
+	}
+}
+
+// getAuditLog opens the audit log file of the provider, or returns nil when no audit log is configured
+func (p *citrixProvider) getAuditLog(ctx context.Context, resp *provider.ConfigureResponse, auditLogFile types.String) *util.AuditLog {
+	filePath := os.Getenv("CITRIX_AUDIT_LOG_FILE")
+	if !auditLogFile.IsNull() {
+		filePath = auditLogFile.ValueString()
+	}
+	if filePath == "" {
+		return nil
+	}
+
+	// Redact the sensitive attributes of all resources from the recorded request bodies
+	sensitiveFields := map[string]bool{}
+	for _, newResource := range p.Resources(ctx) {
+		schemaResp := &resource.SchemaResponse{}
+		newResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+		for field := range util.GetSensitiveFieldsForAttribute(ctx, &schemaResp.Diagnostics, schemaResp.Schema.Attributes) {
+			sensitiveFields[field] = true
+		}
+	}
+
+	auditLog, err := util.NewAuditLog(filePath, sensitiveFields)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("audit_log_file"),
+			"Cannot open audit log file",
+			fmt.Sprintf("The provider cannot open the audit log file %s: %s", filePath, err.Error()),
+		)
+		return nil
+	}
+	return auditLog
+}
+
+// applyAuditLog replaces the HTTP clients of all Citrix API clients with clients writing mutating requests to the audit log
+func applyAuditLog(client *citrixclient.CitrixDaasClient, auditLog *util.AuditLog) {
+	if client.ApiClient != nil {
+		cfg := client.ApiClient.GetConfig()
+		cfg.HTTPClient = auditLog.WrapHttpClient(cfg.HTTPClient, "Orchestration")
+	}
+	if client.GacClient != nil {
+		cfg := client.GacClient.GetConfig()
+		cfg.HTTPClient = auditLog.WrapHttpClient(cfg.HTTPClient, "GlobalAppConfiguration")
+	}
+	if client.ResourceLocationsClient != nil {
+		cfg := client.ResourceLocationsClient.GetConfig()
+		cfg.HTTPClient = auditLog.WrapHttpClient(cfg.HTTPClient, "ResourceLocations")
+	}
+	if client.CCAdminsClient != nil {
+		cfg := client.CCAdminsClient.GetConfig()
+		cfg.HTTPClient = auditLog.WrapHttpClient(cfg.HTTPClient, "CitrixCloudAdministrators")
+	}
+	if client.QuickCreateClient != nil {
+		cfg := client.QuickCreateClient.GetConfig()
+		cfg.HTTPClient = auditLog.WrapHttpClient(cfg.HTTPClient, "QuickCreate")
 	}
 }
 
@@ -674,11 +1083,34 @@ func (p *citrixProvider) DataSources(_ context.Context) []func() datasource.Data
 		machine_catalog.NewMachineCatalogDataSource,
 		delivery_group.NewDeliveryGroupDataSource,
 		vda.NewVdaDataSource,
-		application.NewApplicationDataSourceSource,
+		vda.NewMachineDataSource,
+		application.NewApplicationFolderDetailsDataSource,
+		application.NewApplicationDataSource,
+		application.NewApplicationGroupDataSource,
 		admin_scope.NewAdminScopeDataSource,
 		machine_catalog.NewPvsDataSource,
+		admin_role.NewAdminRoleDataSource,
+		admin_role.NewAdminPermissionsDataSource,
+		admin_user.NewAdminUserDataSource,
+		// List DataSources
+		machine_catalog.NewMachineCatalogsDataSource,
+		delivery_group.NewDeliveryGroupsDataSource,
+		application.NewApplicationsDataSource,
+		hypervisor.NewHypervisorsDataSource,
+		hypervisor_resource_pool.NewHypervisorResourcePoolsDataSource,
+		hypervisor.NewHypervisorResourcesDataSource,
+		zone.NewZonesDataSource,
+		admin_role.NewAdminRolesDataSource,
+		policies.NewPolicySetsDataSource,
+		// Citrix Cloud DataSources
+		resource_locations.NewResourceLocationDataSource,
+		resource_locations.NewCloudConnectorsDataSource,
 		// StoreFront DataSources
 		stf_roaming.NewSTFRoamingServiceDataSource,
+		stf_deployment.NewSTFDeploymentDataSource,
+		stf_authentication.NewSTFAuthenticationServiceDataSource,
+		stf_store.NewSTFStoreServiceDataSource,
+		stf_webreceiver.NewSTFWebReceiverDataSource,
 		// QuickCreate DataSources
 		qcs_image.NewAwsWorkspacesImageDataSource,
 		qcs_account.NewAccountDataSource,
@@ -705,6 +1137,8 @@ func (p *citrixProvider) Resources(_ context.Context) []func() resource.Resource
 		hypervisor_resource_pool.NewVsphereHypervisorResourcePoolResource,
 		hypervisor_resource_pool.NewNutanixHypervisorResourcePoolResource,
 		hypervisor_resource_pool.NewSCVMMHypervisorResourcePoolResource,
+		image_definition.NewImageDefinitionResource,
+		image_definition.NewImageVersionResource,
 		machine_catalog.NewMachineCatalogResource,
 		delivery_group.NewDeliveryGroupResource,
 		storefront_server.NewStoreFrontServerResource,
@@ -0,0 +1,147 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// transportSettings holds the TLS and proxy settings of the HTTP clients used by the provider
+type transportSettings struct {
+	CaCertificateFile     string
+	CaCertificatePem      string
+	ClientCertificateFile string
+	ClientCertificatePem  string
+	ClientKeyFile         string
+	ClientKeyPem          string
+	ProxyUrl              string
+}
+
+func (s transportSettings) isConfigured() bool {
+	return s != transportSettings{}
+}
+
+// getTransportSettings reads the TLS and proxy settings of a CVAD configuration, falling back to the given environment lookup function
+func getTransportSettings(cvadConfig *cvadConfig, getEnv func(string) string) transportSettings {
+	settings := transportSettings{
+		CaCertificateFile:     getEnv("CITRIX_CA_CERTIFICATE_FILE"),
+		CaCertificatePem:      getEnv("CITRIX_CA_CERTIFICATE_PEM"),
+		ClientCertificateFile: getEnv("CITRIX_CLIENT_CERTIFICATE_FILE"),
+		ClientCertificatePem:  getEnv("CITRIX_CLIENT_CERTIFICATE_PEM"),
+		ClientKeyFile:         getEnv("CITRIX_CLIENT_KEY_FILE"),
+		ClientKeyPem:          getEnv("CITRIX_CLIENT_KEY_PEM"),
+		ProxyUrl:              getEnv("CITRIX_PROXY_URL"),
+	}
+
+	if cvadConfig == nil {
+		return settings
+	}
+
+	if !cvadConfig.CaCertificateFile.IsNull() {
+		settings.CaCertificateFile = cvadConfig.CaCertificateFile.ValueString()
+	}
+	if !cvadConfig.CaCertificatePem.IsNull() {
+		settings.CaCertificatePem = cvadConfig.CaCertificatePem.ValueString()
+	}
+	if !cvadConfig.ClientCertificateFile.IsNull() {
+		settings.ClientCertificateFile = cvadConfig.ClientCertificateFile.ValueString()
+	}
+	if !cvadConfig.ClientCertificatePem.IsNull() {
+		settings.ClientCertificatePem = cvadConfig.ClientCertificatePem.ValueString()
+	}
+	if !cvadConfig.ClientKeyFile.IsNull() {
+		settings.ClientKeyFile = cvadConfig.ClientKeyFile.ValueString()
+	}
+	if !cvadConfig.ClientKeyPem.IsNull() {
+		settings.ClientKeyPem = cvadConfig.ClientKeyPem.ValueString()
+	}
+	if !cvadConfig.ProxyUrl.IsNull() {
+		settings.ProxyUrl = cvadConfig.ProxyUrl.ValueString()
+	}
+	return settings
+}
+
+// <summary>
+// Helper function to create an HTTP client with the TLS and proxy settings of the provider
+// </summary>
+// <param name="settings">TLS and proxy settings</param>
+// <param name="disableSslVerification">Whether to skip the verification of the server certificate</param>
+// <returns>HTTP client, or an error if the settings are invalid</returns>
+func newHttpClient(settings transportSettings, disableSslVerification bool) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: disableSslVerification,
+	}
+
+	caCertificatePem, err := getPemContent("ca_certificate", settings.CaCertificateFile, settings.CaCertificatePem)
+	if err != nil {
+		return nil, err
+	}
+	if caCertificatePem != nil {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caCertificatePem) {
+			return nil, fmt.Errorf("no valid PEM encoded certificate found in ca_certificate")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	clientCertificatePem, err := getPemContent("client_certificate", settings.ClientCertificateFile, settings.ClientCertificatePem)
+	if err != nil {
+		return nil, err
+	}
+	clientKeyPem, err := getPemContent("client_key", settings.ClientKeyFile, settings.ClientKeyPem)
+	if err != nil {
+		return nil, err
+	}
+	if clientCertificatePem != nil || clientKeyPem != nil {
+		if clientCertificatePem == nil || clientKeyPem == nil {
+			return nil, fmt.Errorf("client_certificate and client_key must be specified together for mutual TLS")
+		}
+		clientCertificate, err := tls.X509KeyPair(clientCertificatePem, clientKeyPem)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %s", err.Error())
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCertificate}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if settings.ProxyUrl != "" {
+		proxyUrl, err := url.Parse(settings.ProxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("proxy_url `%s` is not a valid URL: %s", settings.ProxyUrl, err.Error())
+		}
+		if proxyUrl.Host == "" || (proxyUrl.Scheme != "http" && proxyUrl.Scheme != "https" && proxyUrl.Scheme != "socks5") {
+			return nil, fmt.Errorf("proxy_url `%s` must be an absolute URL with the http, https or socks5 scheme", settings.ProxyUrl)
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
+
+// getPemContent returns the PEM content of a setting specified either as a file or inline
+func getPemContent(settingName, file, pem string) ([]byte, error) {
+	if file != "" && pem != "" {
+		return nil, fmt.Errorf("only one of %s_file and %s_pem can be specified", settingName, settingName)
+	}
+	if pem != "" {
+		return []byte(pem), nil
+	}
+	if file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s_file: %s", settingName, err.Error())
+		}
+		return content, nil
+	}
+	return nil, nil
+}
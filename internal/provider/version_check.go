@@ -0,0 +1,165 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/mod/semver"
+)
+
+const terraformRegistryProviderUrl = "https://registry.terraform.io/v1/providers/citrix/citrix"
+
+// The version check must never delay the provider noticeably when the registry cannot be reached
+const versionCheckTimeout = 5 * time.Second
+
+const versionCheckCacheFileName = "citrix_provider_version_check.txt"
+
+const versionCheckCacheDuration = 24 * time.Hour
+
+type versionCheckConfig struct {
+	Disabled       types.Bool   `tfsdk:"disabled"`
+	CacheDirectory types.String `tfsdk:"cache_directory"`
+}
+
+// versionChecker looks up the latest stable version of the provider in the Terraform Registry
+type versionChecker struct {
+	registryUrl    string
+	cacheDirectory string
+	httpClient     *http.Client
+}
+
+type registryResponse struct {
+	Version  string   `json:"version"`
+	Versions []string `json:"versions"`
+}
+
+// <summary>
+// Helper function to create the version checker from the provider configuration, falling back to the given environment lookup function
+// </summary>
+// <param name="config">Version check configuration</param>
+// <param name="cvadConfig">Default CVAD configuration holding the proxy and CA certificate settings</param>
+// <param name="getEnv">Environment lookup function</param>
+// <returns>Version checker, or nil when the version check is disabled or cannot be configured</returns>
+func getVersionChecker(config *versionCheckConfig, cvadConfig *cvadConfig, getEnv func(string) string) *versionChecker {
+	disabled, _ := strconv.ParseBool(getEnv("CITRIX_DISABLE_VERSION_CHECK"))
+	cacheDirectory := getEnv("CITRIX_VERSION_CHECK_CACHE_DIRECTORY")
+	if config != nil {
+		if !config.Disabled.IsNull() {
+			disabled = config.Disabled.ValueBool()
+		}
+		if !config.CacheDirectory.IsNull() {
+			cacheDirectory = config.CacheDirectory.ValueString()
+		}
+	}
+	if disabled {
+		return nil
+	}
+	if cacheDirectory == "" {
+		cacheDirectory = os.TempDir()
+	}
+
+	// The registry is reached through the proxy and CA certificates of the default configuration
+	httpClient, err := newHttpClient(getTransportSettings(cvadConfig, getEnv), false)
+	if err != nil {
+		return nil
+	}
+	httpClient.Timeout = versionCheckTimeout
+
+	return &versionChecker{
+		registryUrl:    terraformRegistryProviderUrl,
+		cacheDirectory: cacheDirectory,
+		httpClient:     httpClient,
+	}
+}
+
+// <summary>
+// Helper function to get the latest stable version of the provider, either from the cache file or from the Terraform Registry
+// </summary>
+// <returns>Latest version of the provider, and error if any</returns>
+func (c *versionChecker) getLatestVersion() (string, error) {
+	versionCheckFilePath := filepath.Join(c.cacheDirectory, versionCheckCacheFileName)
+	info, err := os.Stat(versionCheckFilePath)
+	if err == nil && info != nil && time.Now().Before(info.ModTime().Add(versionCheckCacheDuration)) {
+		// use cached version for version check
+		txt, err := os.ReadFile(versionCheckFilePath)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(txt)), nil
+	}
+
+	registryVersion, err := c.getVersionFromTerraformRegistry()
+	if err != nil {
+		return "", err
+	}
+
+	// caching is best effort, the version is still returned if the cache file cannot be written
+	_ = os.WriteFile(versionCheckFilePath, []byte(registryVersion), 0660)
+	return registryVersion, nil
+}
+
+func (c *versionChecker) getVersionFromTerraformRegistry() (string, error) {
+	httpResp, err := c.httpClient.Get(c.registryUrl)
+	if err != nil {
+		return "", err
+	}
+	defer httpResp.Body.Close()
+	if httpResp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d from the Terraform Registry", httpResp.StatusCode)
+	}
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return "", err
+	}
+	registryResp := registryResponse{}
+	err = json.Unmarshal(body, &registryResp)
+	if err != nil {
+		return "", err
+	}
+
+	// find the last stable version
+	// the versions are returned in order from oldest to newest so reverse it first
+	slices.Reverse(registryResp.Versions)
+	for _, ver := range registryResp.Versions {
+		if semver.Prerelease("v"+ver) == "" {
+			return ver, nil
+		}
+	}
+
+	// if no stable version found just return the latest version
+	if registryResp.Version == "" {
+		return "", fmt.Errorf("no version found in the Terraform Registry response")
+	}
+	return registryResp.Version, nil
+}
+
+// best effort version check, if anything goes wrong just bail out
+func (p *citrixProvider) versionCheck(resp *provider.ConfigureResponse, checker *versionChecker) {
+	if !semver.IsValid("v" + p.version) {
+		return
+	}
+
+	registryVersion, err := checker.getLatestVersion()
+	if err != nil {
+		return
+	}
+
+	if semver.Compare("v"+registryVersion, "v"+p.version) > 0 {
+		resp.Diagnostics.AddWarning(
+			"New version of the citrix/citrix provider is available",
+			fmt.Sprintf("Please update the provider version in terraform configuration to >=%s and then run `terraform init --upgrade` to get the latest version.", registryVersion))
+	}
+}
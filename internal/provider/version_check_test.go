@@ -0,0 +1,197 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newStandInRegistry starts a local server answering like the Terraform Registry provider endpoint and counts the requests it receives
+func newStandInRegistry(t *testing.T, statusCode int, body string) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newTestVersionChecker(t *testing.T, registryUrl string) *versionChecker {
+	checker := getVersionChecker(nil, nil, func(key string) string {
+		if key == "CITRIX_VERSION_CHECK_CACHE_DIRECTORY" {
+			return t.TempDir()
+		}
+		return ""
+	})
+	if checker == nil {
+		t.Fatal("expected version checker")
+	}
+	checker.registryUrl = registryUrl
+	return checker
+}
+
+func TestVersionCheckReturnsLatestStableVersion(t *testing.T) {
+	registry, requests := newStandInRegistry(t, http.StatusOK, `{"version":"1.1.0-beta","versions":["0.9.0","1.0.0","1.0.1","1.1.0-beta"]}`)
+	checker := newTestVersionChecker(t, registry.URL)
+
+	version, err := checker.getLatestVersion()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if version != "1.0.1" {
+		t.Errorf("expected version 1.0.1, got %s", version)
+	}
+
+	cached, err := os.ReadFile(filepath.Join(checker.cacheDirectory, versionCheckCacheFileName))
+	if err != nil {
+		t.Fatalf("expected cache file in %s: %s", checker.cacheDirectory, err.Error())
+	}
+	if string(cached) != "1.0.1" {
+		t.Errorf("expected cached version 1.0.1, got %s", string(cached))
+	}
+
+	// the second check is answered from the cache file
+	if _, err := checker.getLatestVersion(); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if *requests != 1 {
+		t.Errorf("expected 1 request to the registry, got %d", *requests)
+	}
+}
+
+func TestVersionCheckIgnoresExpiredCache(t *testing.T) {
+	registry, requests := newStandInRegistry(t, http.StatusOK, `{"version":"1.0.1","versions":["1.0.0","1.0.1"]}`)
+	checker := newTestVersionChecker(t, registry.URL)
+
+	cacheFile := filepath.Join(checker.cacheDirectory, versionCheckCacheFileName)
+	if err := os.WriteFile(cacheFile, []byte("0.9.0"), 0660); err != nil {
+		t.Fatal(err)
+	}
+	expired := time.Now().Add(-versionCheckCacheDuration - time.Minute)
+	if err := os.Chtimes(cacheFile, expired, expired); err != nil {
+		t.Fatal(err)
+	}
+
+	version, err := checker.getLatestVersion()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if version != "1.0.1" || *requests != 1 {
+		t.Errorf("expected version 1.0.1 from 1 registry request, got %s from %d requests", version, *requests)
+	}
+}
+
+func TestVersionCheckRegistryErrorDoesNotWriteCache(t *testing.T) {
+	registry, _ := newStandInRegistry(t, http.StatusServiceUnavailable, `{}`)
+	checker := newTestVersionChecker(t, registry.URL)
+
+	if _, err := checker.getLatestVersion(); err == nil {
+		t.Fatal("expected error for unavailable registry")
+	}
+	if _, err := os.Stat(filepath.Join(checker.cacheDirectory, versionCheckCacheFileName)); !os.IsNotExist(err) {
+		t.Errorf("expected no cache file after a failed check")
+	}
+}
+
+func TestVersionCheckTimesOut(t *testing.T) {
+	unblock := make(chan struct{})
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	t.Cleanup(registry.Close)
+	t.Cleanup(func() { close(unblock) })
+
+	checker := newTestVersionChecker(t, registry.URL)
+	if checker.httpClient.Timeout != versionCheckTimeout {
+		t.Errorf("expected timeout %s, got %s", versionCheckTimeout, checker.httpClient.Timeout)
+	}
+	checker.httpClient.Timeout = 100 * time.Millisecond
+
+	start := time.Now()
+	if _, err := checker.getLatestVersion(); err == nil {
+		t.Fatal("expected timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the check to time out, took %s", elapsed)
+	}
+}
+
+func TestVersionCheckUsesProxy(t *testing.T) {
+	proxy, requests := newStandInRegistry(t, http.StatusOK, `{"version":"1.0.0","versions":["1.0.0"]}`)
+	checker := getVersionChecker(nil, nil, func(key string) string {
+		switch key {
+		case "CITRIX_PROXY_URL":
+			return proxy.URL
+		case "CITRIX_VERSION_CHECK_CACHE_DIRECTORY":
+			return t.TempDir()
+		}
+		return ""
+	})
+	checker.registryUrl = "http://registry.example.invalid/v1/providers/citrix/citrix"
+
+	version, err := checker.getLatestVersion()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if version != "1.0.0" || *requests != 1 {
+		t.Errorf("expected version 1.0.0 through the proxy, got %s with %d proxy requests", version, *requests)
+	}
+}
+
+func TestVersionCheckDisabled(t *testing.T) {
+	disabledByEnv := func(key string) string {
+		if key == "CITRIX_DISABLE_VERSION_CHECK" {
+			return "true"
+		}
+		return ""
+	}
+	if getVersionChecker(nil, nil, disabledByEnv) != nil {
+		t.Error("expected version check to be disabled by environment variable")
+	}
+
+	config := &versionCheckConfig{Disabled: types.BoolValue(true)}
+	if getVersionChecker(config, nil, func(string) string { return "" }) != nil {
+		t.Error("expected version check to be disabled by provider attribute")
+	}
+
+	config = &versionCheckConfig{Disabled: types.BoolValue(false)}
+	if getVersionChecker(config, nil, disabledByEnv) == nil {
+		t.Error("expected provider attribute to take precedence over environment variable")
+	}
+}
+
+func TestVersionCheckWarnsAboutNewerVersion(t *testing.T) {
+	registry, _ := newStandInRegistry(t, http.StatusOK, `{"version":"1.0.1","versions":["1.0.0","1.0.1"]}`)
+
+	tests := []struct {
+		version         string
+		expectedWarning bool
+	}{
+		{version: "1.0.0", expectedWarning: true},
+		{version: "1.0.1", expectedWarning: false},
+		{version: "dev", expectedWarning: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			p := &citrixProvider{version: test.version}
+			resp := &provider.ConfigureResponse{}
+			p.versionCheck(resp, newTestVersionChecker(t, registry.URL))
+			if hasWarning := resp.Diagnostics.WarningsCount() > 0; hasWarning != test.expectedWarning {
+				t.Errorf("expected warning %t, got %t", test.expectedWarning, hasWarning)
+			}
+		})
+	}
+}
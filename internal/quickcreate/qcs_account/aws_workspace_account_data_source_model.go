@@ -20,12 +20,14 @@ type AwsWorkspacesAccountDataSourceModel struct {
 	AwsAccount            types.String `tfsdk:"aws_account"`
 	AwsRegion             types.String `tfsdk:"aws_region"`
 	AwsByolFeatureEnabled types.Bool   `tfsdk:"aws_byol_feature_enabled"`
+	Site                  types.String `tfsdk:"site"`
 }
 
 func (AwsWorkspacesAccountDataSourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "DaaS Quick Deploy - AWS Workspaces Core --- Data source to get details of an AWS Workspaces account.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the account.",
 				Optional:    true,
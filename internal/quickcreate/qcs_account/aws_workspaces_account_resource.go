@@ -57,6 +57,11 @@ func (r *awsWorkspaceAccountResource) Configure(_ context.Context, req resource.
 func (r *awsWorkspaceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AwsWorkspacesAccountResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -120,6 +125,11 @@ func (r *awsWorkspaceAccountResource) Create(ctx context.Context, req resource.C
 func (r *awsWorkspaceAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state AwsWorkspacesAccountResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -157,6 +167,11 @@ func (r *awsWorkspaceAccountResource) Read(ctx context.Context, req resource.Rea
 func (r *awsWorkspaceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AwsWorkspacesAccountResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -267,6 +282,11 @@ func (r *awsWorkspaceAccountResource) Update(ctx context.Context, req resource.U
 func (r *awsWorkspaceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state AwsWorkspacesAccountResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -311,6 +331,11 @@ func (r *awsWorkspaceAccountResource) ValidateConfig(ctx context.Context, req re
 func (r *awsWorkspaceAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.QuickCreateClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -26,12 +26,14 @@ type AwsWorkspacesAccountResourceModel struct {
 	AwsSecretAccessKey    types.String `tfsdk:"aws_secret_access_key"`
 	AwsRoleArn            types.String `tfsdk:"aws_role_arn"`
 	AwsByolFeatureEnabled types.Bool   `tfsdk:"aws_byol_feature_enabled"`
+	Site                  types.String `tfsdk:"site"`
 }
 
 func (AwsWorkspacesAccountResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "DaaS Quick Deploy - AWS Workspaces Core --- Manages an AWS Workspaces account.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the account.",
 				Computed:    true,
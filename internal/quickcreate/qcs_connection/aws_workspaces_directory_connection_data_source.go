@@ -45,6 +45,11 @@ func (d *AwsWorkspacesDirectoryConnectionDataSource) Configure(ctx context.Conte
 }
 
 func (d *AwsWorkspacesDirectoryConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	var data AwsWorkspacesDirectoryConnectionDataSourceModel
 
 	// Read Terraform configuration data into the model
@@ -27,12 +27,14 @@ type AwsWorkspacesDirectoryConnectionDataSourceModel struct {
 	UserEnabledAsLocalAdministrator types.Bool   `tfsdk:"user_enabled_as_local_administrator"`
 	SecurityGroup                   types.String `tfsdk:"security_group"`
 	DefaultOu                       types.String `tfsdk:"default_ou"`
+	Site                            types.String `tfsdk:"site"`
 }
 
 func (AwsWorkspacesDirectoryConnectionDataSourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "DaaS Quick Deploy - AWS Workspaces Core --- Data Source of an AWS Workspaces directory connection.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the directory connection.",
 				Optional:    true,
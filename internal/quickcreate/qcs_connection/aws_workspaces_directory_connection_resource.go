@@ -56,6 +56,11 @@ func (r *awsWorkspacesDirectoryConnectionResource) Configure(_ context.Context,
 func (r *awsWorkspacesDirectoryConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AwsWorkspacesDirectoryConnectionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -82,7 +87,8 @@ func (r *awsWorkspacesDirectoryConnectionResource) Create(ctx context.Context, r
 	directoryDetails.SetEnableMaintenanceMode(false)
 
 	// Add the Directory Connection
-	addDirectoryConnectionResponse, _, err := addAwsWorkspacesDirectoryConnection(ctx, r.client, &resp.Diagnostics, plan.AccountId.ValueString(), directoryDetails)
+	timeouts := util.ObjectValueToTypedObject[AwsWorkspacesDirectoryConnectionTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	addDirectoryConnectionResponse, _, err := addAwsWorkspacesDirectoryConnection(ctx, r.client, &resp.Diagnostics, plan.AccountId.ValueString(), directoryDetails, util.GetTimeoutValue(timeouts.Create, util.DefaultQcsDirectoryConnectionCreateTimeout)*60)
 	if err != nil {
 		// Error was logged in addAwsWorkspacesDirectoryConnection. Just return
 		return
@@ -109,6 +115,11 @@ func (r *awsWorkspacesDirectoryConnectionResource) Create(ctx context.Context, r
 func (r *awsWorkspacesDirectoryConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state AwsWorkspacesDirectoryConnectionResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -147,6 +158,11 @@ func (r *awsWorkspacesDirectoryConnectionResource) Read(ctx context.Context, req
 func (r *awsWorkspacesDirectoryConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AwsWorkspacesDirectoryConnectionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -203,6 +219,11 @@ func (r *awsWorkspacesDirectoryConnectionResource) Update(ctx context.Context, r
 func (r *awsWorkspacesDirectoryConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state AwsWorkspacesDirectoryConnectionResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -259,7 +280,7 @@ func (r *awsWorkspacesDirectoryConnectionResource) ValidateConfig(ctx context.Co
 	tflog.Debug(ctx, "Validate Config - "+schemaType, configValuesForSchema)
 }
 
-func addAwsWorkspacesDirectoryConnection(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, accountId string, requestBody citrixquickcreate.AddAwsEdcDirectoryConnection) (*citrixquickcreate.ResourceConnectionTask, *http.Response, error) {
+func addAwsWorkspacesDirectoryConnection(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, accountId string, requestBody citrixquickcreate.AddAwsEdcDirectoryConnection, maxWaitTimeInSeconds int) (*citrixquickcreate.ResourceConnectionTask, *http.Response, error) {
 	addDirectoryConnectionRequest := client.QuickCreateClient.ConnectionQCS.AddResourceConnectionAsync(ctx, client.ClientConfig.CustomerId, accountId)
 	addDirectoryConnectionRequest = addDirectoryConnectionRequest.Body(requestBody)
 	// Initiate the addition of the Directory Connection
@@ -274,7 +295,7 @@ func addAwsWorkspacesDirectoryConnection(ctx context.Context, client *citrixdaas
 	}
 
 	// Wait for the task
-	pollTaskResponse, httpResp, err := util.PollQcsTask(ctx, client, diagnostics, directoryConnectionTask.GetTaskId(), 10, 300)
+	pollTaskResponse, httpResp, err := util.PollQcsTask(ctx, client, diagnostics, directoryConnectionTask.GetTaskId(), 10, maxWaitTimeInSeconds)
 	if err != nil {
 		// Error messages logged in pollQcsTask. Just return
 		return nil, httpResp, err
@@ -337,6 +358,11 @@ func removeAwsWorkspacesDirectoryConnection(ctx context.Context, client *citrixd
 func (r *awsWorkspacesDirectoryConnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.QuickCreateClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
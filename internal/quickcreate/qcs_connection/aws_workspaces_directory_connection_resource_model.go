@@ -32,12 +32,31 @@ type AwsWorkspacesDirectoryConnectionResourceModel struct {
 	UserEnabledAsLocalAdministrator types.Bool   `tfsdk:"user_enabled_as_local_administrator"`
 	SecurityGroup                   types.String `tfsdk:"security_group"`
 	DefaultOu                       types.String `tfsdk:"default_ou"`
+	Timeouts                        types.Object `tfsdk:"timeouts"` // AwsWorkspacesDirectoryConnectionTimeouts
+	Site                            types.String `tfsdk:"site"`
+}
+
+type AwsWorkspacesDirectoryConnectionTimeouts struct {
+	Create types.Int64 `tfsdk:"create"`
+}
+
+func (AwsWorkspacesDirectoryConnectionTimeouts) GetSchema() schema.SingleNestedAttribute {
+	return util.GetTimeoutsSchema("AWS Workspaces directory connection", util.TimeoutConfigs{
+		Create:        true,
+		CreateDefault: util.DefaultQcsDirectoryConnectionCreateTimeout,
+		CreateMin:     1,
+	})
+}
+
+func (AwsWorkspacesDirectoryConnectionTimeouts) GetAttributes() map[string]schema.Attribute {
+	return AwsWorkspacesDirectoryConnectionTimeouts{}.GetSchema().Attributes
 }
 
 func (AwsWorkspacesDirectoryConnectionResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "DaaS Quick Deploy - AWS Workspaces Core --- Manages an AWS Workspaces directory connection.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the directory connection.",
 				Computed:    true,
@@ -145,6 +164,7 @@ func (AwsWorkspacesDirectoryConnectionResourceModel) GetSchema() schema.Schema {
 					stringvalidator.RegexMatches(regexp.MustCompile(util.OuPathFormat), "The organizational unit path provided contains invalid characters or is not in the correct format."),
 				},
 			},
+			"timeouts": AwsWorkspacesDirectoryConnectionTimeouts{}.GetSchema(),
 		},
 	}
 }
@@ -21,12 +21,14 @@ type AwsWorkspacesDeploymentDataSourceModel struct {
 	AccountId   types.String `tfsdk:"account_id"`
 	DirectoryId types.String `tfsdk:"directory_connection_id"`
 	ImageId     types.String `tfsdk:"image_id"`
+	Site        types.String `tfsdk:"site"`
 }
 
 func (AwsWorkspacesDeploymentDataSourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "DaaS Quick Deploy - AWS Workspaces Core --- Data source to get details of an AWS Workspaces deployment.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the deployment.",
 				Optional:    true,
@@ -62,6 +62,11 @@ func (r *awsWorkspacesDeploymentResource) Configure(_ context.Context, req resou
 func (r *awsWorkspacesDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AwsWorkspacesDeploymentResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -90,7 +95,8 @@ func (r *awsWorkspacesDeploymentResource) Create(ctx context.Context, req resour
 	}
 
 	deploymentId := initiateDeploymentResponse.GetDeploymentId()
-	deploymentResult, err := WaitForQcsDeployment(ctx, &resp.Diagnostics, r.client, 120, deploymentId)
+	timeouts := util.ObjectValueToTypedObject[AwsWorkspacesDeploymentTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	deploymentResult, err := WaitForQcsDeployment(ctx, &resp.Diagnostics, r.client, util.GetTimeoutValue(timeouts.Create, util.DefaultQcsDeploymentCreateTimeout), deploymentId)
 	if err != nil {
 		if deploymentResult != nil &&
 			(deploymentResult.GetDeploymentState() == citrixquickcreate.DEPLOYMENTSTATE_ERROR ||
@@ -159,6 +165,11 @@ func (r *awsWorkspacesDeploymentResource) Create(ctx context.Context, req resour
 func (r *awsWorkspacesDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state AwsWorkspacesDeploymentResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -195,6 +206,11 @@ func (r *awsWorkspacesDeploymentResource) Read(ctx context.Context, req resource
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *awsWorkspacesDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// Retrieve values from plan
 	var plan AwsWorkspacesDeploymentResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -211,6 +227,10 @@ func (r *awsWorkspacesDeploymentResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	timeouts := util.ObjectValueToTypedObject[AwsWorkspacesDeploymentTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	settingsUpdateTimeoutInSeconds := util.GetTimeoutValue(timeouts.Update, util.DefaultQcsDeploymentSettingsUpdateTimeout) * 60
+	updateTimeoutInSeconds := util.GetTimeoutValue(timeouts.Update, util.DefaultQcsDeploymentUpdateTimeout) * 60
+
 	// 1. Update Image
 	if !strings.EqualFold(plan.ImageId.ValueString(), state.ImageId.ValueString()) {
 		var imageUpdateBody citrixquickcreate.ImageUpdateBody
@@ -226,7 +246,7 @@ func (r *awsWorkspacesDeploymentResource) Update(ctx context.Context, req resour
 			)
 			return
 		}
-		err = util.WaitForQcsDeploymentTaskWithDiags(ctx, &resp.Diagnostics, r.client, 600, updateImageTask.GetTaskId(), "Update image task", plan.Name.ValueString(), "updating image for")
+		err = util.WaitForQcsDeploymentTaskWithDiags(ctx, &resp.Diagnostics, r.client, settingsUpdateTimeoutInSeconds, updateImageTask.GetTaskId(), "Update image task", plan.Name.ValueString(), "updating image for")
 		if err != nil {
 			return
 		}
@@ -261,7 +281,7 @@ func (r *awsWorkspacesDeploymentResource) Update(ctx context.Context, req resour
 			)
 			return
 		}
-		err = util.WaitForQcsDeploymentTaskWithDiags(ctx, &resp.Diagnostics, r.client, 600, updatePropertiesTask.GetTaskId(), "Update deployment scale settings task", plan.Name.ValueString(), "updating scale settings for")
+		err = util.WaitForQcsDeploymentTaskWithDiags(ctx, &resp.Diagnostics, r.client, settingsUpdateTimeoutInSeconds, updatePropertiesTask.GetTaskId(), "Update deployment scale settings task", plan.Name.ValueString(), "updating scale settings for")
 		if err != nil {
 			return
 		}
@@ -274,9 +294,9 @@ func (r *awsWorkspacesDeploymentResource) Update(ctx context.Context, req resour
 	}
 
 	if !deployment.GetUserDecoupledWorkspaces() {
-		err = updateUserCoupledWorkspaces(ctx, &resp.Diagnostics, r.client, deployment, plan.Workspaces)
+		err = updateUserCoupledWorkspaces(ctx, &resp.Diagnostics, r.client, deployment, plan.Workspaces, updateTimeoutInSeconds)
 	} else {
-		err = updateUserDecoupledWorkspaces(ctx, &resp.Diagnostics, r.client, deployment, plan.Workspaces)
+		err = updateUserDecoupledWorkspaces(ctx, &resp.Diagnostics, r.client, deployment, plan.Workspaces, updateTimeoutInSeconds)
 	}
 	if err != nil {
 		return
@@ -303,6 +323,11 @@ func (r *awsWorkspacesDeploymentResource) Update(ctx context.Context, req resour
 func (r *awsWorkspacesDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state AwsWorkspacesDeploymentResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -323,7 +348,8 @@ func (r *awsWorkspacesDeploymentResource) Delete(ctx context.Context, req resour
 		return
 	}
 
-	err = util.WaitForQcsDeploymentTaskWithDiags(ctx, &resp.Diagnostics, r.client, 3600, deleteDeploymentTask.GetTaskId(), "Delete deployment task", state.Name.ValueString(), "deleting")
+	timeouts := util.ObjectValueToTypedObject[AwsWorkspacesDeploymentTimeouts](ctx, &resp.Diagnostics, state.Timeouts)
+	err = util.WaitForQcsDeploymentTaskWithDiags(ctx, &resp.Diagnostics, r.client, util.GetTimeoutValue(timeouts.Delete, util.DefaultQcsDeploymentDeleteTimeout)*60, deleteDeploymentTask.GetTaskId(), "Delete deployment task", state.Name.ValueString(), "deleting")
 	if err != nil {
 		return
 	}
@@ -549,7 +575,7 @@ func WaitForQcsDeployment(ctx context.Context, diagnostics *diag.Diagnostics, cl
 func generateBatchApiHeaders(ctx context.Context, client *citrixdaasclient.CitrixDaasClient) (context.Context, []citrixorchestration.NameValueStringPairModel, *http.Response, error) {
 	headers := []citrixorchestration.NameValueStringPairModel{}
 
-	cwsAuthToken, httpResp, err := client.SignIn()
+	cwsAuthToken, httpResp, err := util.SignIn(client)
 	ctx = tflog.SetField(ctx, "cws_auth_token", cwsAuthToken)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "cws_auth_token")
 	if err != nil {
@@ -656,7 +682,7 @@ func getUsernameMachineIdMap(deployment *citrixquickcreate.AwsEdcDeployment) map
 	return usernameMachineIdMap
 }
 
-func updateUserCoupledWorkspaces(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, deployment *citrixquickcreate.AwsEdcDeployment, planWorkspaces types.List) error {
+func updateUserCoupledWorkspaces(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, deployment *citrixquickcreate.AwsEdcDeployment, planWorkspaces types.List, maxWaitTimeInSeconds int) error {
 	// 1. Find usernames in plan
 	workspaceUsernamesInPlan := []string{}
 	workspaceUsernameConfigMapInPlan := map[string]AwsWorkspacesDeploymentWorkspaceModel{}
@@ -701,7 +727,7 @@ func updateUserCoupledWorkspaces(ctx context.Context, diagnostics *diag.Diagnost
 		if err != nil {
 			return err
 		}
-		err = deleteAwsWorkspaceMachines(ctx, diagnostics, client, deployment, workspaceIdsForDeletion)
+		err = deleteAwsWorkspaceMachines(ctx, diagnostics, client, deployment, workspaceIdsForDeletion, maxWaitTimeInSeconds)
 		if err != nil {
 			return err
 		}
@@ -719,7 +745,7 @@ func updateUserCoupledWorkspaces(ctx context.Context, diagnostics *diag.Diagnost
 			return err
 		}
 
-		err = util.WaitForQcsDeploymentTaskWithDiags(ctx, diagnostics, client, 3600, deploymentTask.GetTaskId(), "Update existing workspaces in deployment task", deployment.GetDeploymentName(), "updating existing workspaces in")
+		err = util.WaitForQcsDeploymentTaskWithDiags(ctx, diagnostics, client, maxWaitTimeInSeconds, deploymentTask.GetTaskId(), "Update existing workspaces in deployment task", deployment.GetDeploymentName(), "updating existing workspaces in")
 		if err != nil {
 			return err
 		}
@@ -763,7 +789,7 @@ func updateUserCoupledWorkspaces(ctx context.Context, diagnostics *diag.Diagnost
 			)
 			return err
 		}
-		err = util.WaitForQcsDeploymentTaskWithDiags(ctx, diagnostics, client, 3600, deploymentTask.GetTaskId(), "Add workspaces to deployment task", deployment.GetDeploymentName(), "adding new workspaces to")
+		err = util.WaitForQcsDeploymentTaskWithDiags(ctx, diagnostics, client, maxWaitTimeInSeconds, deploymentTask.GetTaskId(), "Add workspaces to deployment task", deployment.GetDeploymentName(), "adding new workspaces to")
 		if err != nil {
 			return err
 		}
@@ -783,7 +809,7 @@ func updateUserCoupledWorkspaces(ctx context.Context, diagnostics *diag.Diagnost
 	return nil
 }
 
-func updateUserDecoupledWorkspaces(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, deployment *citrixquickcreate.AwsEdcDeployment, plannedWorkspacesList types.List) error {
+func updateUserDecoupledWorkspaces(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, deployment *citrixquickcreate.AwsEdcDeployment, plannedWorkspacesList types.List, maxWaitTimeInSeconds int) error {
 	brokerIdMapForMaintenace := map[string]bool{}
 	workspaceIdsForDeletion := []string{}
 	for _, workspace := range deployment.GetWorkspaces() {
@@ -798,7 +824,7 @@ func updateUserDecoupledWorkspaces(ctx context.Context, diagnostics *diag.Diagno
 	}
 
 	// 2. Delete existing workspaces
-	err = deleteAwsWorkspaceMachines(ctx, diagnostics, client, deployment, workspaceIdsForDeletion)
+	err = deleteAwsWorkspaceMachines(ctx, diagnostics, client, deployment, workspaceIdsForDeletion, maxWaitTimeInSeconds)
 	if err != nil {
 		return err
 	}
@@ -829,7 +855,7 @@ func updateUserDecoupledWorkspaces(ctx context.Context, diagnostics *diag.Diagno
 			)
 			return err
 		}
-		err = util.WaitForQcsDeploymentTaskWithDiags(ctx, diagnostics, client, 3600, deploymentTask.GetTaskId(), "Add workspaces to deployment task", deployment.GetDeploymentName(), "adding new workspaces to")
+		err = util.WaitForQcsDeploymentTaskWithDiags(ctx, diagnostics, client, maxWaitTimeInSeconds, deploymentTask.GetTaskId(), "Add workspaces to deployment task", deployment.GetDeploymentName(), "adding new workspaces to")
 		if err != nil {
 			return err
 		}
@@ -858,7 +884,7 @@ func updateUserDecoupledWorkspaces(ctx context.Context, diagnostics *diag.Diagno
 	return nil
 }
 
-func deleteAwsWorkspaceMachines(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, deployment *citrixquickcreate.AwsEdcDeployment, workspaceIdsForDeletion []string) error {
+func deleteAwsWorkspaceMachines(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, deployment *citrixquickcreate.AwsEdcDeployment, workspaceIdsForDeletion []string, maxWaitTimeInSeconds int) error {
 	var removeMachinesBody citrixquickcreate.MachinesDeleteBody
 	removeMachinesBody.SetAccountType(citrixquickcreate.ACCOUNTTYPE_AWSEDC)
 	removeMachinesBody.SetMachineIds(workspaceIdsForDeletion)
@@ -873,7 +899,7 @@ func deleteAwsWorkspaceMachines(ctx context.Context, diagnostics *diag.Diagnosti
 		)
 		return err
 	}
-	err = util.WaitForQcsDeploymentTaskWithDiags(ctx, diagnostics, client, 3600, deploymentTask.GetTaskId(), "Update deployment task", deployment.GetDeploymentName(), "updating")
+	err = util.WaitForQcsDeploymentTaskWithDiags(ctx, diagnostics, client, maxWaitTimeInSeconds, deploymentTask.GetTaskId(), "Update deployment task", deployment.GetDeploymentName(), "updating")
 	if err != nil {
 		return err
 	}
@@ -883,6 +909,11 @@ func deleteAwsWorkspaceMachines(ctx context.Context, diagnostics *diag.Diagnosti
 func (r *awsWorkspacesDeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.QuickCreateClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -3,6 +3,7 @@ package qcs_deployment
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 
 	"github.com/citrix/citrix-daas-rest-go/citrixquickcreate"
@@ -182,12 +183,50 @@ type AwsWorkspacesDeploymentResourceModel struct {
 	ScaleSettings           types.Object `tfsdk:"scale_settings"` // AwsWorkspacesScaleSettingsModel
 	UserDecoupledWorkspaces types.Bool   `tfsdk:"user_decoupled_workspaces"`
 	Workspaces              types.List   `tfsdk:"workspaces"` // List[AwsWorkspacesDeploymentWorkspaceModel]
+	Timeouts                types.Object `tfsdk:"timeouts"`   // AwsWorkspacesDeploymentTimeouts
+	Site                    types.String `tfsdk:"site"`
+}
+
+type AwsWorkspacesDeploymentTimeouts struct {
+	Create types.Int64 `tfsdk:"create"`
+	Update types.Int64 `tfsdk:"update"`
+	Delete types.Int64 `tfsdk:"delete"`
+}
+
+func (AwsWorkspacesDeploymentTimeouts) GetSchema() schema.SingleNestedAttribute {
+	timeoutsSchema := util.GetTimeoutsSchema("AWS Workspaces deployment", util.TimeoutConfigs{
+		Create:        true,
+		CreateDefault: util.DefaultQcsDeploymentCreateTimeout,
+		CreateMin:     5,
+
+		Update:        true,
+		UpdateDefault: util.DefaultQcsDeploymentUpdateTimeout,
+		UpdateMin:     5,
+
+		Delete:        true,
+		DeleteDefault: util.DefaultQcsDeploymentDeleteTimeout,
+		DeleteMin:     5,
+	})
+
+	// The image and scale settings tasks are shorter than the workspace tasks, so they keep a separate default
+	if updateTimeout, ok := timeoutsSchema.Attributes["update"].(schema.Int64Attribute); ok {
+		updateTimeout.Description = fmt.Sprintf("Timeout in minutes to wait for each task updating the AWS Workspaces deployment. "+
+			"When omitted, the image and scale settings update tasks time out after `%d` minutes, and the workspace update tasks time out after `%d` minutes.", util.DefaultQcsDeploymentSettingsUpdateTimeout, util.DefaultQcsDeploymentUpdateTimeout)
+		timeoutsSchema.Attributes["update"] = updateTimeout
+	}
+
+	return timeoutsSchema
+}
+
+func (AwsWorkspacesDeploymentTimeouts) GetAttributes() map[string]schema.Attribute {
+	return AwsWorkspacesDeploymentTimeouts{}.GetSchema().Attributes
 }
 
 func (AwsWorkspacesDeploymentResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "DaaS Quick Deploy - AWS Workspaces Core --- Manages an AWS Workspaces deployment.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the deployment.",
 				Computed:    true,
@@ -328,6 +367,7 @@ func (AwsWorkspacesDeploymentResourceModel) GetSchema() schema.Schema {
 				Optional:     true,
 				NestedObject: AwsWorkspacesDeploymentWorkspaceModel{}.GetSchema(),
 			},
+			"timeouts": AwsWorkspacesDeploymentTimeouts{}.GetSchema(),
 		},
 	}
 }
@@ -45,6 +45,11 @@ func (d *AwsWorkspacesImageDataSource) Configure(ctx context.Context, req dataso
 }
 
 func (d *AwsWorkspacesImageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	var data AwsWorkspacesImageDataSourceModel
 
 	// Read Terraform configuration data into the model
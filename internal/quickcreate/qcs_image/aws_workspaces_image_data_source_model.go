@@ -26,12 +26,14 @@ type AwsWorkspacesImageDataSourceModel struct {
 	Tenancy          types.String `tfsdk:"tenancy"`
 	IngestionProcess types.String `tfsdk:"ingestion_process"`
 	State            types.String `tfsdk:"state"`
+	Site             types.String `tfsdk:"site"`
 }
 
 func (AwsWorkspacesImageDataSourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "DaaS Quick Deploy - AWS Workspaces Core --- Data Source of an AWS Workspaces image.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the image.",
 				Optional:    true,
@@ -58,6 +58,11 @@ func (r *awsWorkspacesImageResource) Configure(_ context.Context, req resource.C
 func (r *awsWorkspacesImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AwsWorkspacesImageResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -107,7 +112,8 @@ func (r *awsWorkspacesImageResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	// Try getting the new AWS Workspaces Image
-	image, httpResp, err := waitForImageImportCompletion(ctx, r.client, &resp.Diagnostics, importImageResponse)
+	timeouts := util.ObjectValueToTypedObject[AwsWorkspacesImageTimeouts](ctx, &resp.Diagnostics, plan.Timeouts)
+	image, httpResp, err := waitForImageImportCompletion(ctx, r.client, &resp.Diagnostics, importImageResponse, util.GetTimeoutValue(timeouts.Create, util.DefaultQcsImageCreateTimeout))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting AWS Workspaces Image: "+plan.Name.ValueString(),
@@ -139,6 +145,11 @@ func (r *awsWorkspacesImageResource) Create(ctx context.Context, req resource.Cr
 func (r *awsWorkspacesImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state AwsWorkspacesImageResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -170,16 +181,41 @@ func (r *awsWorkspacesImageResource) Read(ctx context.Context, req resource.Read
 func (r *awsWorkspacesImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
-	resp.Diagnostics.AddError(
-		"Error updating AWS Workspaces Image",
-		"Update operation is not supported for AWS Workspaces Image",
-	)
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All image properties require replacement, only the timeouts can be updated in place
+	var plan AwsWorkspacesImageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state AwsWorkspacesImageResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete is the implementation of the Delete method in the resource.Resource interface.
 func (r *awsWorkspacesImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state AwsWorkspacesImageResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -236,6 +272,11 @@ func (r *awsWorkspacesImageResource) ValidateConfig(ctx context.Context, req res
 func (r *awsWorkspacesImageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client != nil && r.client.QuickCreateClient == nil {
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
@@ -265,13 +306,13 @@ func getAwsWorkspacesImageWithId(ctx context.Context, client *citrixdaasclient.C
 	return image, httpResp, nil
 }
 
-func waitForImageImportCompletion(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, image *citrixquickcreate.AwsEdcImage) (*citrixquickcreate.AwsEdcImage, *http.Response, error) {
+func waitForImageImportCompletion(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, image *citrixquickcreate.AwsEdcImage, maxWaitTimeInMinutes int) (*citrixquickcreate.AwsEdcImage, *http.Response, error) {
 	// default polling to every 10 seconds
 	startTime := time.Now()
 	imageId := image.GetImageId()
 
 	for {
-		if time.Since(startTime) > time.Minute*time.Duration(120) {
+		if time.Since(startTime) > time.Minute*time.Duration(maxWaitTimeInMinutes) {
 			break
 		}
 
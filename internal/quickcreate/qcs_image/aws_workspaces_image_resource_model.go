@@ -27,12 +27,31 @@ type AwsWorkspacesImageResourceModel struct {
 	Tenancy          types.String `tfsdk:"tenancy"`
 	IngestionProcess types.String `tfsdk:"ingestion_process"`
 	State            types.String `tfsdk:"state"`
+	Timeouts         types.Object `tfsdk:"timeouts"` // AwsWorkspacesImageTimeouts
+	Site             types.String `tfsdk:"site"`
+}
+
+type AwsWorkspacesImageTimeouts struct {
+	Create types.Int64 `tfsdk:"create"`
+}
+
+func (AwsWorkspacesImageTimeouts) GetSchema() schema.SingleNestedAttribute {
+	return util.GetTimeoutsSchema("AWS Workspaces image", util.TimeoutConfigs{
+		Create:        true,
+		CreateDefault: util.DefaultQcsImageCreateTimeout,
+		CreateMin:     5,
+	})
+}
+
+func (AwsWorkspacesImageTimeouts) GetAttributes() map[string]schema.Attribute {
+	return AwsWorkspacesImageTimeouts{}.GetSchema().Attributes
 }
 
 func (AwsWorkspacesImageResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "DaaS Quick Deploy - AWS Workspaces Core --- Manages an AWS Workspaces image.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"id": schema.StringAttribute{
 				Description: "GUID identifier of the image.",
 				Computed:    true,
@@ -118,6 +137,7 @@ func (AwsWorkspacesImageResourceModel) GetSchema() schema.Schema {
 				Description: "The state of ingestion process of the image.",
 				Computed:    true,
 			},
+			"timeouts": AwsWorkspacesImageTimeouts{}.GetSchema(),
 		},
 	}
 }
@@ -0,0 +1,96 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package stf_authentication
+
+import (
+	"context"
+	"fmt"
+
+	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &STFAuthenticationServiceDataSource{}
+)
+
+func NewSTFAuthenticationServiceDataSource() datasource.DataSource {
+	return &STFAuthenticationServiceDataSource{}
+}
+
+// STFAuthenticationServiceDataSource defines the data source implementation.
+type STFAuthenticationServiceDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata implements datasource.DataSource.
+func (*STFAuthenticationServiceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stf_authentication_service"
+}
+
+// Schema implements datasource.DataSource.
+func (*STFAuthenticationServiceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = STFAuthenticationServiceDataSourceModel{}.GetSchema()
+}
+
+func (d *STFAuthenticationServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+// Read implements datasource.DataSource.
+func (d *STFAuthenticationServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data STFAuthenticationServiceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authService, err := getSTFAuthenticationService(ctx, &resp.Diagnostics, d.client, STFAuthenticationServiceResourceModel{SiteId: data.SiteId, VirtualPath: data.VirtualPath})
+	if err != nil {
+		return
+	}
+	if authService == nil {
+		resp.Diagnostics.AddError(
+			"StoreFront Authentication Service not found",
+			"StoreFront Authentication Service was not found.",
+		)
+		return
+	}
+
+	// The authentication service of the protocols is passed to the PowerShell cmdlet as an object
+	var getProtocolsBody citrixstorefront.GetSTFAuthenticationServiceProtocolRequestModel
+	getProtocolsBody.SetAuthenticationService(fmt.Sprintf("(Get-STFAuthenticationService -SiteId %d -VirtualPath '%s')", *authService.SiteId.Get(), *authService.VirtualPath.Get()))
+	getProtocolsRequest := d.client.StorefrontClient.AuthenticationServiceSF.STFWebReceiverGetSTFAuthenticationProtocols(ctx, getProtocolsBody)
+	protocols, err := getProtocolsRequest.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get StoreFront Authentication Service protocols",
+			"Error message: "+err.Error(),
+		)
+		return
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, authService, protocols)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,100 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package stf_authentication
+
+import (
+	"context"
+
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// STFAuthenticationServiceDataSourceModel defines the StoreFront Authentication Service data source implementation.
+type STFAuthenticationServiceDataSourceModel struct {
+	SiteId            types.String                     `tfsdk:"site_id"`
+	VirtualPath       types.String                     `tfsdk:"virtual_path"`
+	FriendlyName      types.String                     `tfsdk:"friendly_name"`
+	ClaimsFactoryName types.String                     `tfsdk:"claims_factory_name"`
+	Protocols         []STFAuthenticationProtocolModel `tfsdk:"protocols"`
+	Site              types.String                     `tfsdk:"site"`
+}
+
+// STFAuthenticationProtocolModel defines the protocol data model of the StoreFront Authentication Service data source.
+type STFAuthenticationProtocolModel struct {
+	Name    types.String `tfsdk:"name"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+func (STFAuthenticationServiceDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "StoreFront --- Data source to get details regarding an existing StoreFront Authentication Service, including its authentication protocols.",
+		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
+			"site_id": schema.StringAttribute{
+				Description: "The IIS site of the authentication service. Defaults to `1`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"virtual_path": schema.StringAttribute{
+				Description: "The IIS virtual path of the authentication service. Defaults to `/Citrix/Authentication`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"friendly_name": schema.StringAttribute{
+				Description: "The friendly name of the authentication service.",
+				Computed:    true,
+			},
+			"claims_factory_name": schema.StringAttribute{
+				Description: "The claims factory name used by the authentication service.",
+				Computed:    true,
+			},
+			"protocols": schema.ListNestedAttribute{
+				Description:  "The authentication protocols installed in the authentication service.",
+				Computed:     true,
+				NestedObject: STFAuthenticationProtocolModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+func (STFAuthenticationProtocolModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the authentication protocol.",
+				Computed:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Indicates whether the authentication protocol is enabled.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r STFAuthenticationServiceDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, authService *citrixstorefront.STFAuthenticationServiceResponseModel, protocols []citrixstorefront.STFAuthenticationServiceProtocolResponseModel) STFAuthenticationServiceDataSourceModel {
+	// Refresh the authentication service in the same way as the StoreFront Authentication Service resource
+	var authServiceModel STFAuthenticationServiceResourceModel
+	authServiceModel.RefreshPropertyValues(ctx, diagnostics, authService)
+
+	r.SiteId = authServiceModel.SiteId
+	r.VirtualPath = authServiceModel.VirtualPath
+	r.FriendlyName = authServiceModel.FriendlyName
+	r.ClaimsFactoryName = authServiceModel.ClaimsFactoryName
+
+	res := []STFAuthenticationProtocolModel{}
+	for _, protocol := range protocols {
+		res = append(res, STFAuthenticationProtocolModel{
+			Name:    types.StringPointerValue(protocol.Name.Get()),
+			Enabled: types.BoolPointerValue(protocol.Enabled.Get()),
+		})
+	}
+	r.Protocols = res
+
+	return r
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
@@ -75,6 +76,11 @@ func (r *stfAuthenticationServiceResource) Schema(_ context.Context, _ resource.
 func (r *stfAuthenticationServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFAuthenticationServiceResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -83,6 +89,8 @@ func (r *stfAuthenticationServiceResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Create", "citrix_stf_authentication_service", &plan, time.Now())
+
 	// Generate API request body from plan
 	var body citrixstorefront.AddSTFAuthenticationServiceRequestModel
 
@@ -136,6 +144,11 @@ func (r *stfAuthenticationServiceResource) Create(ctx context.Context, req resou
 func (r *stfAuthenticationServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state STFAuthenticationServiceResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -172,6 +185,11 @@ func (r *stfAuthenticationServiceResource) Read(ctx context.Context, req resourc
 func (r *stfAuthenticationServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFAuthenticationServiceResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -180,6 +198,8 @@ func (r *stfAuthenticationServiceResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Update", "citrix_stf_authentication_service", &plan, time.Now())
+
 	var getBody citrixstorefront.GetSTFAuthenticationServiceRequestModel
 	if !plan.SiteId.IsNull() {
 		siteIdInt, err := strconv.ParseInt(plan.SiteId.ValueString(), 10, 64)
@@ -260,6 +280,11 @@ func (r *stfAuthenticationServiceResource) Update(ctx context.Context, req resou
 func (r *stfAuthenticationServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state STFAuthenticationServiceResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -268,6 +293,8 @@ func (r *stfAuthenticationServiceResource) Delete(ctx context.Context, req resou
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Delete", "citrix_stf_authentication_service", &state, time.Now())
+
 	var getBody citrixstorefront.GetSTFAuthenticationServiceRequestModel
 	if !state.SiteId.IsNull() {
 		siteIdInt, err := strconv.ParseInt(state.SiteId.ValueString(), 10, 64)
@@ -4,6 +4,7 @@ package stf_authentication
 
 import (
 	"context"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	"golang.org/x/exp/maps"
@@ -27,6 +28,7 @@ type STFAuthenticationServiceResourceModel struct {
 	VirtualPath       types.String `tfsdk:"virtual_path"`
 	FriendlyName      types.String `tfsdk:"friendly_name"`
 	ClaimsFactoryName types.String `tfsdk:"claims_factory_name"`
+	Site              types.String `tfsdk:"site"`
 }
 
 func (r *STFAuthenticationServiceResourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, authService *citrixstorefront.STFAuthenticationServiceResponseModel) {
@@ -60,6 +62,7 @@ func (STFAuthenticationServiceResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "StoreFront --- StoreFront Authentication Service.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"site_id": schema.StringAttribute{
 				Description: "The IIS site to configure the authentication service for. Defaults to `1`.",
 				Optional:    true,
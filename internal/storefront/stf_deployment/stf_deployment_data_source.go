@@ -0,0 +1,85 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package stf_deployment
+
+import (
+	"context"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &STFDeploymentDataSource{}
+)
+
+func NewSTFDeploymentDataSource() datasource.DataSource {
+	return &STFDeploymentDataSource{}
+}
+
+// STFDeploymentDataSource defines the data source implementation.
+type STFDeploymentDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata implements datasource.DataSource.
+func (*STFDeploymentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stf_deployment"
+}
+
+// Schema implements datasource.DataSource.
+func (*STFDeploymentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = STFDeploymentDataSourceModel{}.GetSchema()
+}
+
+func (d *STFDeploymentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+// Read implements datasource.DataSource.
+func (d *STFDeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data STFDeploymentDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := GetSTFDeployment(ctx, d.client, &resp.Diagnostics, data.SiteId.ValueStringPointer())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get StoreFront Deployment details",
+			"Error message: "+err.Error(),
+		)
+		return
+	}
+	if deployment == nil {
+		resp.Diagnostics.AddError(
+			"StoreFront Deployment not found",
+			"StoreFront Deployment was not found.",
+		)
+		return
+	}
+
+	data = data.RefreshPropertyValues(deployment)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,48 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package stf_deployment
+
+import (
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// STFDeploymentDataSourceModel defines the StoreFront Deployment data source implementation.
+type STFDeploymentDataSourceModel struct {
+	SiteId      types.String `tfsdk:"site_id"`
+	HostBaseUrl types.String `tfsdk:"host_base_url"`
+	Site        types.String `tfsdk:"site"`
+}
+
+func (r STFDeploymentDataSourceModel) RefreshPropertyValues(deployment *citrixstorefront.STFDeploymentDetailModel) STFDeploymentDataSourceModel {
+	// Refresh the deployment in the same way as the StoreFront Deployment resource
+	var deploymentModel STFDeploymentResourceModel
+	deploymentModel.RefreshPropertyValues(deployment)
+
+	r.SiteId = deploymentModel.SiteId
+	r.HostBaseUrl = deploymentModel.HostBaseUrl
+
+	return r
+}
+
+func (STFDeploymentDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "StoreFront --- Data source to get details regarding an existing StoreFront Deployment.",
+		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
+			"site_id": schema.StringAttribute{
+				Description: "The IIS site id of the StoreFront deployment. Defaults to `1`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"host_base_url": schema.StringAttribute{
+				Description: "Url used to access the StoreFront server group.",
+				Computed:    true,
+			},
+		},
+	}
+}
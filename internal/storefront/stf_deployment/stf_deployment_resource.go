@@ -5,6 +5,7 @@ import (
 	"context"
 	"strconv"
 	"strings"
+	"time"
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
@@ -72,6 +73,11 @@ func (r *stfDeploymentResource) Configure(_ context.Context, req resource.Config
 func (r *stfDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFDeploymentResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -80,6 +86,8 @@ func (r *stfDeploymentResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Create", "citrix_stf_deployment", &plan, time.Now())
+
 	// Generate API request body from plan
 	var body citrixstorefront.CreateSTFDeploymentRequestModel
 
@@ -122,6 +130,11 @@ func (r *stfDeploymentResource) Create(ctx context.Context, req resource.CreateR
 func (r *stfDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state STFDeploymentResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -156,6 +169,11 @@ func (r *stfDeploymentResource) Read(ctx context.Context, req resource.ReadReque
 func (r *stfDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFDeploymentResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -164,6 +182,8 @@ func (r *stfDeploymentResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Update", "citrix_stf_deployment", &plan, time.Now())
+
 	// Get refreshed STFDeployment
 	deployment, err := GetSTFDeployment(ctx, r.client, &resp.Diagnostics, plan.SiteId.ValueStringPointer())
 	if err != nil || deployment == nil {
@@ -213,6 +233,11 @@ func (r *stfDeploymentResource) Update(ctx context.Context, req resource.UpdateR
 func (r *stfDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state STFDeploymentResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -221,6 +246,8 @@ func (r *stfDeploymentResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Delete", "citrix_stf_deployment", &state, time.Now())
+
 	// Check if STFDeployment exists
 	deployment, err := GetSTFDeployment(ctx, r.client, &resp.Diagnostics, state.SiteId.ValueStringPointer())
 	if err != nil || deployment == nil {
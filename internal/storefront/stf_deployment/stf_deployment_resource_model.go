@@ -3,6 +3,7 @@
 package stf_deployment
 
 import (
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"strconv"
 	"strings"
 
@@ -19,6 +20,7 @@ import (
 type STFDeploymentResourceModel struct {
 	SiteId      types.String `tfsdk:"site_id"`
 	HostBaseUrl types.String `tfsdk:"host_base_url"`
+	Site        types.String `tfsdk:"site"`
 }
 
 func (r *STFDeploymentResourceModel) RefreshPropertyValues(deployment *citrixstorefront.STFDeploymentDetailModel) {
@@ -31,6 +33,7 @@ func (STFDeploymentResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "StoreFront --- StoreFront Deployment.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"site_id": schema.StringAttribute{
 				Description: "The IIS site id of the StoreFront deployment. Defaults to 1.",
 				Optional:    true,
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
@@ -73,6 +74,11 @@ func (r *stfUserFarmMappingResource) Schema(_ context.Context, _ resource.Schema
 func (r *stfUserFarmMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFUserFarmMappingResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -81,6 +87,8 @@ func (r *stfUserFarmMappingResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Create", "citrix_stf_user_farm_mapping", &plan, time.Now())
+
 	// Create and Get new STF UserFarmMapping
 	storeVirtualPath := plan.VirtualPath.ValueString()
 	storeVirtualPathNullableString := citrixstorefront.NewNullableString(&storeVirtualPath)
@@ -126,6 +134,11 @@ func (r *stfUserFarmMappingResource) Create(ctx context.Context, req resource.Cr
 func (r *stfUserFarmMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state STFUserFarmMappingResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -182,6 +195,11 @@ func (r *stfUserFarmMappingResource) Update(_ context.Context, _ resource.Update
 func (r *stfUserFarmMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state STFUserFarmMappingResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -190,6 +208,8 @@ func (r *stfUserFarmMappingResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Delete", "citrix_stf_user_farm_mapping", &state, time.Now())
+
 	storeVirtualPath := state.VirtualPath.ValueString()
 	storeVirtualPathNullableString := citrixstorefront.NewNullableString(&storeVirtualPath)
 
@@ -167,6 +167,7 @@ type STFUserFarmMappingResourceModel struct {
 	Name               types.String `tfsdk:"name"`
 	GroupMembers       types.List   `tfsdk:"group_members"`        // List of UserFarmMappingGroup
 	EquivalentFarmSets types.List   `tfsdk:"equivalent_farm_sets"` // List of EquivalentFarmSets
+	Site               types.String `tfsdk:"site"`
 }
 
 // Map response body to schema and populate Computed attribute values
@@ -192,6 +193,7 @@ func (STFUserFarmMappingResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "StoreFront --- StoreFront User Farm Mapping Resource",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"store_virtual_path": schema.StringAttribute{
 				Description: "The IIS VirtualPath at which the Store is configured to be accessed by Receivers.",
 				Required:    true,
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
@@ -69,6 +70,11 @@ func (*stfRoamingBeaconResource) Schema(_ context.Context, _ resource.SchemaRequ
 func (r *stfRoamingBeaconResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFRoamingBeaconResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -77,6 +83,8 @@ func (r *stfRoamingBeaconResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Create", "citrix_stf_roaming_beacon", &plan, time.Now())
+
 	var err error
 
 	var roamingBeaconInternalBody citrixstorefront.SetSTFRoamingInternalBeaconRequestModel
@@ -151,6 +159,11 @@ func (r *stfRoamingBeaconResource) Create(ctx context.Context, req resource.Crea
 // Read implements resource.Resource.
 func (r *stfRoamingBeaconResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// Get current state
 	var state STFRoamingBeaconResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -194,6 +207,11 @@ func (r *stfRoamingBeaconResource) Read(ctx context.Context, req resource.ReadRe
 func (r *stfRoamingBeaconResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFRoamingBeaconResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -202,6 +220,8 @@ func (r *stfRoamingBeaconResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Update", "citrix_stf_roaming_beacon", &plan, time.Now())
+
 	var err error
 
 	var roamingBeaconInternalBody citrixstorefront.SetSTFRoamingInternalBeaconRequestModel
@@ -274,6 +294,11 @@ func (r *stfRoamingBeaconResource) Update(ctx context.Context, req resource.Upda
 func (r *stfRoamingBeaconResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state STFRoamingBeaconResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -282,6 +307,8 @@ func (r *stfRoamingBeaconResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Delete", "citrix_stf_roaming_beacon", &state, time.Now())
+
 	var getRoamingServiceBody citrixstorefront.STFRoamingServiceRequestModel
 	getRoamingServiceBody.SetSiteId(state.SiteId.ValueInt64())
 	// Delete existing STF Roaming Gateway
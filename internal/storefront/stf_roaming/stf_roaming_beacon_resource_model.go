@@ -15,6 +15,7 @@ type STFRoamingBeaconResourceModel struct {
 	Internal types.String `tfsdk:"internal_ip"`
 	External types.List   `tfsdk:"external_ips"`
 	SiteId   types.Int64  `tfsdk:"site_id"`
+	Site     types.String `tfsdk:"site"`
 }
 
 func (r *STFRoamingBeaconResourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, roamInt *citrixstorefront.GetSTFRoamingInternalBeaconResponseModel, roamExt *citrixstorefront.GetSTFRoamingExternalBeaconResponseModel) {
@@ -30,6 +31,7 @@ func (STFRoamingBeaconResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "StoreFront --- This resource is used to manage the StoreFront Roaming Beacon.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"internal_ip": schema.StringAttribute{
 				Description: "Internal IP address of the beacon.",
 				Required:    true,
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
@@ -95,6 +96,11 @@ func (r *stfRoamingGatewayResource) Schema(_ context.Context, _ resource.SchemaR
 func (r *stfRoamingGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFRoamingGatewayResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -103,6 +109,8 @@ func (r *stfRoamingGatewayResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Create", "citrix_stf_roaming_gateway", &plan, time.Now())
+
 	siteIdInt, err := strconv.ParseInt(plan.SiteId.ValueString(), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -180,6 +188,11 @@ func (r *stfRoamingGatewayResource) Create(ctx context.Context, req resource.Cre
 func (r *stfRoamingGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state STFRoamingGatewayResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -233,6 +246,11 @@ func (r *stfRoamingGatewayResource) Read(ctx context.Context, req resource.ReadR
 func (r *stfRoamingGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFRoamingGatewayResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -241,6 +259,8 @@ func (r *stfRoamingGatewayResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Update", "citrix_stf_roaming_gateway", &plan, time.Now())
+
 	siteIdInt, err := strconv.ParseInt(plan.SiteId.ValueString(), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -316,6 +336,11 @@ func (r *stfRoamingGatewayResource) Update(ctx context.Context, req resource.Upd
 func (r *stfRoamingGatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state STFRoamingGatewayResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -323,6 +348,8 @@ func (r *stfRoamingGatewayResource) Delete(ctx context.Context, req resource.Del
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Delete", "citrix_stf_roaming_gateway", &state, time.Now())
 	var getRoamingGatewayBody citrixstorefront.GetSTFRoamingGatewayRequestModel
 	getRoamingGatewayBody.SetName(state.Name.ValueString())
 
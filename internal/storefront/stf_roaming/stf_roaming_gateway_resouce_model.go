@@ -92,6 +92,7 @@ type STFRoamingGatewayResourceModel struct {
 	StasBypassDuration         types.String `tfsdk:"stas_bypass_duration"`
 	GslbUrl                    types.String `tfsdk:"gslb_url"`
 	IsCloudGateway             types.Bool   `tfsdk:"is_cloud_gateway"`
+	Site                       types.String `tfsdk:"site"`
 }
 
 func (r *STFRoamingGatewayResourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, roamingGateway *citrixstorefrontModels.STFRoamingGatewayResponseModel) {
@@ -200,6 +201,7 @@ func (STFRoamingGatewayResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "StoreFront --- Manage a StoreFront roaming gateway in the global gateway list. Gateways for remote access and authentication are added to Stores from the globally managed list.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"site_id": schema.StringAttribute{
 				Description: "The IIS site id of the StoreFront roaming gateway.",
 				Required:    true,
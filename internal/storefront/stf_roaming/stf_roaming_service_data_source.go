@@ -46,6 +46,11 @@ func (d *STFRoamingServiceDataSource) Configure(ctx context.Context, req datasou
 func (d *STFRoamingServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data STFRoamingServiceDataSourceModel
 
 	// Read Terraform configuration data into the model
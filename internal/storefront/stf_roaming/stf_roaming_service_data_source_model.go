@@ -3,6 +3,7 @@ package stf_roaming
 
 import (
 	"context"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"strconv"
 
 	citrixstorefrontModels "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
@@ -20,6 +21,7 @@ type STFRoamingServiceDataSourceModel struct {
 	ConfigurationFile types.String `tfsdk:"configuration_file"`
 	TenantId          types.String `tfsdk:"tenant_id"`
 	PhysicalPath      types.String `tfsdk:"physical_path"`
+	Site              types.String `tfsdk:"site"`
 }
 
 func (r STFRoamingServiceDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, roamingService *citrixstorefrontModels.STFRoamingServiceResponseModel) STFRoamingServiceDataSourceModel {
@@ -56,6 +58,7 @@ func GetSTFRoamingServiceDataSourceSchema() schema.Schema {
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "StoreFront --- Data source to get details regarding a specific StoreFront Roaming Service instance.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteDataSourceSchema(),
 			"site_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the site where the StoreFront Roaming Service instance is created.",
 				Required:            true,
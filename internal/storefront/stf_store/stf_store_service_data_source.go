@@ -0,0 +1,170 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package stf_store
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &STFStoreServiceDataSource{}
+)
+
+func NewSTFStoreServiceDataSource() datasource.DataSource {
+	return &STFStoreServiceDataSource{}
+}
+
+// STFStoreServiceDataSource defines the data source implementation.
+type STFStoreServiceDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata implements datasource.DataSource.
+func (*STFStoreServiceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stf_store_service"
+}
+
+// Schema implements datasource.DataSource.
+func (*STFStoreServiceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = STFStoreServiceDataSourceModel{}.GetSchema()
+}
+
+func (d *STFStoreServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+// Read implements datasource.DataSource.
+func (d *STFStoreServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data STFStoreServiceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteId := data.SiteId.ValueString()
+	if data.SiteId.IsNull() {
+		siteId = "1"
+	}
+
+	// Refresh the StoreService in the same way as an imported StoreFront Store Service resource, so that the data source has the same values as the resource
+	storeModel := util.NewImportedResourceModel[STFStoreServiceResourceModel](ctx, &resp.Diagnostics, STFStoreServiceResourceModel{}.GetSchema(), map[string]any{
+		"site_id":      siteId,
+		"virtual_path": data.VirtualPath.ValueString(),
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storeService, err := storeModel.getSTFStoreService(ctx, d.client, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get StoreFront StoreService details",
+			"Error message: "+err.Error(),
+		)
+		return
+	}
+	if storeService == nil {
+		resp.Diagnostics.AddError(
+			"StoreFront Store Service not found",
+			"StoreFront Store Service with virtual path "+data.VirtualPath.ValueString()+" was not found.",
+		)
+		return
+	}
+
+	farmNames := util.StringListToStringArray(ctx, &resp.Diagnostics, data.FarmNames)
+	farms, err := storeModel.getStoreFarmsByName(ctx, d.client, &resp.Diagnostics, farmNames)
+	if err != nil {
+		return
+	}
+	for _, farmName := range farmNames {
+		if !slices.ContainsFunc(farms, func(farm citrixstorefront.StoreFarmModel) bool {
+			return farm.FarmName.Get() != nil && strings.EqualFold(*farm.FarmName.Get(), farmName)
+		}) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("farm_names"),
+				"StoreFront Controller not found",
+				"StoreFront Controller "+farmName+" was not found in the Store Service with virtual path "+data.VirtualPath.ValueString()+".",
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	storeModel.RefreshPropertyValues(ctx, &resp.Diagnostics, storeService, farms)
+
+	farmSettings, err := storeModel.getFarmSettingsGetRequest(ctx, d.client, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching STF StoreFarmConfigurations",
+			"Error message: "+err.Error(),
+		)
+		return
+	}
+	storeModel.RefreshFarmSettings(ctx, &resp.Diagnostics, farmSettings)
+
+	enumerationOptions, err := storeModel.getSTFStoreEnumerationOptions(ctx, d.client, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching StoreFront Store Enumeration Options",
+			"Error message: "+err.Error(),
+		)
+		return
+	}
+	storeModel.RefreshEnumerationOptions(ctx, &resp.Diagnostics, enumerationOptions)
+
+	launchOptions, err := storeModel.getSTFStoreLaunchOptions(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching StoreFront Store Launch Options",
+			"Error message: "+err.Error(),
+		)
+		return
+	}
+	storeModel.RefreshLaunchOptions(ctx, &resp.Diagnostics, launchOptions)
+
+	pna, err := storeModel.getSTFStorePNA(ctx, d.client, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	storeModel.RefreshPnaValues(ctx, &resp.Diagnostics, pna)
+
+	roamingAccount, err := storeModel.getSTFRoamingAccount(ctx, d.client, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching STF Roaming Account",
+			"Error message: "+err.Error(),
+		)
+		return
+	}
+	storeModel.RefreshRoamingAccount(ctx, &resp.Diagnostics, roamingAccount)
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, storeModel, storeService)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,101 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package stf_store
+
+import (
+	"context"
+
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// STFStoreServiceDataSourceModel defines the StoreFront Store Service data source implementation.
+type STFStoreServiceDataSourceModel struct {
+	SiteId                types.String `tfsdk:"site_id"`
+	VirtualPath           types.String `tfsdk:"virtual_path"`
+	FarmNames             types.List   `tfsdk:"farm_names"` // List[string]
+	FriendlyName          types.String `tfsdk:"friendly_name"`
+	AuthenticationService types.String `tfsdk:"authentication_service_virtual_path"`
+	StoreFarm             types.List   `tfsdk:"farms"`               // List[StoreFarm] without the XML validation secret
+	PNA                   types.Object `tfsdk:"pna"`                 // PNA
+	EnumerationOptions    types.Object `tfsdk:"enumeration_options"` // EnumerationOptions
+	LaunchOptions         types.Object `tfsdk:"launch_options"`      // LaunchOptions
+	FarmSettings          types.Object `tfsdk:"farm_settings"`       // FarmSettings
+	RoamingAccount        types.Object `tfsdk:"roaming_account"`     // RoamingAccount
+	Site                  types.String `tfsdk:"site"`
+}
+
+func (STFStoreServiceDataSourceModel) GetSchema() schema.Schema {
+	// Expose the same attributes as the StoreFront Store Service resource, except for the attributes which can not be read back from StoreFront
+	resourceAttributes := STFStoreServiceResourceModel{}.GetSchema().Attributes
+	delete(resourceAttributes, "site")
+	delete(resourceAttributes, "site_id")
+	delete(resourceAttributes, "virtual_path")
+	delete(resourceAttributes, "anonymous")
+	delete(resourceAttributes, "load_balance")
+
+	attributes := util.ResourceAttributesToDataSourceAttributes(resourceAttributes)
+	if farms, ok := attributes["farms"].(schema.ListNestedAttribute); ok {
+		delete(farms.NestedObject.Attributes, "xml_validation_secret")
+		farms.Description = "The StoreFront Controllers of the Store with the names specified in `farm_names`."
+		attributes["farms"] = farms
+	}
+	attributes["site"] = util.GetSiteDataSourceSchema()
+	attributes["site_id"] = schema.StringAttribute{
+		Description: "The IIS site id of the StoreFront storeservice. Defaults to `1`.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["virtual_path"] = schema.StringAttribute{
+		Description: "The IIS VirtualPath at which the Store is configured to be accessed by Receivers.",
+		Required:    true,
+	}
+	attributes["farm_names"] = schema.ListAttribute{
+		ElementType: types.StringType,
+		Description: "Names of the StoreFront Controllers of the Store to read. StoreFront does not support listing the Controllers of a Store, so only the Controllers specified here are returned in `farms`. Each Controller must exist in the Store.",
+		Optional:    true,
+	}
+
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "StoreFront --- Data source to get details regarding an existing StoreFront StoreService. Sensitive attributes of the StoreFront Controllers, such as the XML validation secret, are not exposed. " +
+			"StoreFront does not support listing the Controllers of a Store, so `farms` only contains the Controllers named in `farm_names`, and reading fails when one of them is not found.",
+		Attributes: attributes,
+	}
+}
+
+func (r STFStoreServiceDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, storeModel STFStoreServiceResourceModel, storeService *citrixstorefront.STFStoreDetailModel) STFStoreServiceDataSourceModel {
+	r.SiteId = storeModel.SiteId
+	r.VirtualPath = storeModel.VirtualPath
+	r.FriendlyName = storeModel.FriendlyName
+	r.AuthenticationService = types.StringNull()
+	if authenticationServiceVirtualPath := storeService.AuthenticationServiceVirtualPath.Get(); authenticationServiceVirtualPath != nil && *authenticationServiceVirtualPath != "" {
+		r.AuthenticationService = types.StringValue(*authenticationServiceVirtualPath)
+	}
+
+	dataSourceAttributes := r.GetSchema().Attributes
+	if farms, ok := util.ResourceValueToDataSourceValue(ctx, diagnostics, storeModel.StoreFarm, dataSourceAttributes["farms"].GetType()).(types.List); ok {
+		r.StoreFarm = farms
+	}
+	if pna, ok := util.ResourceValueToDataSourceValue(ctx, diagnostics, storeModel.PNA, dataSourceAttributes["pna"].GetType()).(types.Object); ok {
+		r.PNA = pna
+	}
+	if enumerationOptions, ok := util.ResourceValueToDataSourceValue(ctx, diagnostics, storeModel.EnumerationOptions, dataSourceAttributes["enumeration_options"].GetType()).(types.Object); ok {
+		r.EnumerationOptions = enumerationOptions
+	}
+	if launchOptions, ok := util.ResourceValueToDataSourceValue(ctx, diagnostics, storeModel.LaunchOptions, dataSourceAttributes["launch_options"].GetType()).(types.Object); ok {
+		r.LaunchOptions = launchOptions
+	}
+	if farmSettings, ok := util.ResourceValueToDataSourceValue(ctx, diagnostics, storeModel.FarmSettings, dataSourceAttributes["farm_settings"].GetType()).(types.Object); ok {
+		r.FarmSettings = farmSettings
+	}
+	if roamingAccount, ok := util.ResourceValueToDataSourceValue(ctx, diagnostics, storeModel.RoamingAccount, dataSourceAttributes["roaming_account"].GetType()).(types.Object); ok {
+		r.RoamingAccount = roamingAccount
+	}
+
+	return r
+}
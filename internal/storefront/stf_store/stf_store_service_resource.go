@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
@@ -56,6 +57,11 @@ func (*stfStoreServiceResource) Schema(_ context.Context, _ resource.SchemaReque
 func (r *stfStoreServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Skip modify plan when doing destroy action
 	if req.Plan.Raw.IsNull() {
 		return
@@ -87,6 +93,11 @@ func (r *stfStoreServiceResource) ModifyPlan(ctx context.Context, req resource.M
 func (r *stfStoreServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFStoreServiceResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -95,6 +106,8 @@ func (r *stfStoreServiceResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Create", "citrix_stf_store_service", &plan, time.Now())
+
 	// Generate API request body from plan
 	var body citrixstorefront.CreateSTFStoreRequestModel
 
@@ -253,6 +266,11 @@ func (r *stfStoreServiceResource) Create(ctx context.Context, req resource.Creat
 func (r *stfStoreServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state STFStoreServiceResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -359,6 +377,11 @@ func (r *stfStoreServiceResource) Read(ctx context.Context, req resource.ReadReq
 func (r *stfStoreServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFStoreServiceResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -367,6 +390,8 @@ func (r *stfStoreServiceResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Update", "citrix_stf_store_service", &plan, time.Now())
+
 	siteIdInt, err := strconv.ParseInt(plan.SiteId.ValueString(), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -526,6 +551,11 @@ func (r *stfStoreServiceResource) Update(ctx context.Context, req resource.Updat
 func (r *stfStoreServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state STFStoreServiceResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -534,6 +564,8 @@ func (r *stfStoreServiceResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Delete", "citrix_stf_store_service", &state, time.Now())
+
 	var body citrixstorefront.GetSTFStoreRequestModel
 	if state.SiteId.ValueString() != "" {
 		siteIdInt, err := strconv.ParseInt(state.SiteId.ValueString(), 10, 64)
@@ -1164,6 +1196,17 @@ func (farm StoreFarm) buildStoreFarmBody(ctx context.Context, client *citrixdaas
 }
 
 func (plan STFStoreServiceResourceModel) getStoreFarms(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics) ([]citrixstorefront.StoreFarmModel, error) {
+	farms := util.ObjectListToTypedArray[StoreFarm](ctx, diagnostics, plan.StoreFarm)
+	farmNames := []string{}
+	for _, farm := range farms {
+		farmNames = append(farmNames, farm.FarmName.ValueString())
+	}
+
+	return plan.getStoreFarmsByName(ctx, client, diagnostics, farmNames)
+}
+
+// Gets the Store Farms with the given names from the StoreService, since StoreFront has no API to list the farms of a StoreService
+func (plan STFStoreServiceResourceModel) getStoreFarmsByName(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, farmNames []string) ([]citrixstorefront.StoreFarmModel, error) {
 	siteIdInt, err := strconv.ParseInt(plan.SiteId.ValueString(), 10, 64)
 	if err != nil {
 		diagnostics.AddError(
@@ -1172,16 +1215,15 @@ func (plan STFStoreServiceResourceModel) getStoreFarms(ctx context.Context, clie
 		)
 		return nil, err
 	}
-	farms := util.ObjectListToTypedArray[StoreFarm](ctx, diagnostics, plan.StoreFarm)
 
 	getStoreBody := citrixstorefront.GetSTFStoreRequestModel{}
 	getStoreBody.SetSiteId(siteIdInt)
 	getStoreBody.SetVirtualPath(plan.VirtualPath.ValueString())
 
 	var storeFarms []citrixstorefront.StoreFarmModel
-	for _, farm := range farms {
+	for _, farmName := range farmNames {
 		var storeFarmGetBody citrixstorefront.GetSTFStoreFarmRequestModel
-		storeFarmGetBody.SetFarmName(farm.FarmName.ValueString())
+		storeFarmGetBody.SetFarmName(farmName)
 		getStoreFarmRequest := client.StorefrontClient.StoreSF.STFStoreGetStoreFarm(ctx, storeFarmGetBody, getStoreBody)
 		farm, err := getStoreFarmRequest.Execute()
 		if err != nil {
@@ -547,6 +547,7 @@ type STFStoreServiceResourceModel struct {
 	LaunchOptions         types.Object `tfsdk:"launch_options"`      // LaunchOptions
 	FarmSettings          types.Object `tfsdk:"farm_settings"`
 	RoamingAccount        types.Object `tfsdk:"roaming_account"` // RoamingAccount
+	Site                  types.String `tfsdk:"site"`
 }
 
 // PNA maps the resource schema data.
@@ -766,6 +767,7 @@ func (STFStoreServiceResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "StoreFront --- StoreFront StoreService.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"site_id": schema.StringAttribute{
 				Description: "The IIS site id of the StoreFront storeservice. Defaults to 1.",
 				Optional:    true,
@@ -833,3 +835,7 @@ func (STFStoreServiceResourceModel) GetSchema() schema.Schema {
 		},
 	}
 }
+
+func (STFStoreServiceResourceModel) GetAttributes() map[string]schema.Attribute {
+	return STFStoreServiceResourceModel{}.GetSchema().Attributes
+}
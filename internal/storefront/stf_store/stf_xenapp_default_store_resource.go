@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
@@ -58,6 +59,11 @@ func (*stfXenappDefaultStoreResource) Schema(_ context.Context, _ resource.Schem
 func (r *stfXenappDefaultStoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFXenappDefaultStoreResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -66,6 +72,8 @@ func (r *stfXenappDefaultStoreResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Create", "citrix_stf_xenapp_default_store", &plan, time.Now())
+
 	// Get the mutex for each deployment because only one instance are allowed for each store deployment
 	mutex, ok := mutexes[plan.StoreSiteID.ValueString()]
 	if !ok {
@@ -121,6 +129,11 @@ func (r *stfXenappDefaultStoreResource) Create(ctx context.Context, req resource
 func (r *stfXenappDefaultStoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state STFXenappDefaultStoreResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -169,6 +182,11 @@ func (r *stfXenappDefaultStoreResource) Read(ctx context.Context, req resource.R
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *stfXenappDefaultStoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// Retrieve values from plan
 	var plan STFXenappDefaultStoreResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -177,6 +195,8 @@ func (r *stfXenappDefaultStoreResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Update", "citrix_stf_xenapp_default_store", &plan, time.Now())
+
 	// Get the mutex for each deployment because only one instace are allowed for each store deployment
 	mutex, ok := mutexes[plan.StoreSiteID.ValueString()]
 	if !ok {
@@ -231,6 +251,11 @@ func (r *stfXenappDefaultStoreResource) Update(ctx context.Context, req resource
 func (r *stfXenappDefaultStoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state STFXenappDefaultStoreResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -239,6 +264,8 @@ func (r *stfXenappDefaultStoreResource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Delete", "citrix_stf_xenapp_default_store", &state, time.Now())
+
 	// Get the mutex for each deployment because only one instace are allowed for each store deployment
 	mutex, ok := mutexes[state.StoreSiteID.ValueString()]
 	if !ok {
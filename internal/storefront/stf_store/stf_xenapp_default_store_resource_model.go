@@ -4,6 +4,7 @@ package stf_store
 
 import (
 	"context"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	"github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -15,6 +16,7 @@ import (
 type STFXenappDefaultStoreResourceModel struct {
 	StoreVirtualPath types.String `tfsdk:"store_virtual_path"` // The Virtual Path of the StoreFront Default Store for XenApp Service.
 	StoreSiteID      types.String `tfsdk:"store_site_id"`      // The Site ID of the StoreFront Default Store for XenApp Service.
+	Site             types.String `tfsdk:"site"`
 }
 
 func (r *STFXenappDefaultStoreResourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, defaultStore models.STFPna) {
@@ -30,6 +32,7 @@ func (STFXenappDefaultStoreResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "StoreFront --- Default Storefront Store for XenApp Service.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"store_site_id": schema.StringAttribute{
 				Description: "The Site ID of the StoreFront Default Store for XenApp Service.",
 				Required:    true,
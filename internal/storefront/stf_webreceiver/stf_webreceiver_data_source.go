@@ -0,0 +1,186 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package stf_webreceiver
+
+import (
+	"context"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &STFWebReceiverDataSource{}
+)
+
+func NewSTFWebReceiverDataSource() datasource.DataSource {
+	return &STFWebReceiverDataSource{}
+}
+
+// STFWebReceiverDataSource defines the data source implementation.
+type STFWebReceiverDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata implements datasource.DataSource.
+func (*STFWebReceiverDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stf_webreceiver_service"
+}
+
+// Schema implements datasource.DataSource.
+func (*STFWebReceiverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = STFWebReceiverDataSourceModel{}.GetSchema()
+}
+
+func (d *STFWebReceiverDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient)
+}
+
+// Read implements datasource.DataSource.
+func (d *STFWebReceiverDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	d.client = util.GetClientForSite(ctx, &resp.Diagnostics, d.client, req.Config)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data STFWebReceiverDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteId := data.SiteId.ValueString()
+	if data.SiteId.IsNull() {
+		siteId = "1"
+	}
+	virtualPath := data.VirtualPath.ValueString()
+
+	webReceiverModel := newSTFWebReceiverResourceModelForDataSource(ctx, &resp.Diagnostics, siteId, virtualPath)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webReceiver, err := getSTFWebReceiver(ctx, d.client, &resp.Diagnostics, webReceiverModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get StoreFront WebReceiver details",
+			"Error message: "+err.Error(),
+		)
+		return
+	}
+	if webReceiver == nil {
+		resp.Diagnostics.AddError(
+			"StoreFront Web Receiver Service not found",
+			"StoreFront Web Receiver Service with virtual path "+virtualPath+" was not found.",
+		)
+		return
+	}
+
+	getWebReceiverRequestBody, err := constructGetWebReceiverRequestBody(&resp.Diagnostics, siteId, virtualPath)
+	if err != nil {
+		return
+	}
+
+	authMethods, err := d.client.StorefrontClient.WebReceiverSF.STFWebReceiverGetSTFWebReceiverAuthenticationMethods(ctx, getWebReceiverRequestBody).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching StoreFront WebReceiver Authentication Methods",
+			"Error message: "+err.Error(),
+		)
+		return
+	}
+	webReceiverModel.AuthenticationMethods = util.StringArrayToStringSet(ctx, &resp.Diagnostics, authMethods.Methods)
+
+	assistant, err := d.client.StorefrontClient.WebReceiverSF.STFWebReceiverPluginAssistantGet(ctx, getWebReceiverRequestBody).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching StoreFront WebReceiver Plugin Assistant",
+			"Error message: "+err.Error(),
+		)
+		return
+	}
+	webReceiverModel.RefreshPlugInAssistant(ctx, &resp.Diagnostics, &assistant)
+
+	appShortcutsResponse, err := getSTFWebReceiverApplicationShortcuts(ctx, &resp.Diagnostics, d.client, siteId, virtualPath)
+	if err != nil {
+		return
+	}
+	communicationResponse, err := getSTFWebReceiverCommunication(ctx, &resp.Diagnostics, d.client, siteId, virtualPath)
+	if err != nil {
+		return
+	}
+	stsResponse, err := getSTFWebReceiverStrictTransportSecurity(ctx, &resp.Diagnostics, d.client, siteId, virtualPath)
+	if err != nil {
+		return
+	}
+	authManagerResponse, err := getSTFWebReceiverAuthenticationManager(ctx, &resp.Diagnostics, d.client, siteId, virtualPath)
+	if err != nil {
+		return
+	}
+	uiResponse, err := getSTFWebReceiverUserInterface(ctx, &resp.Diagnostics, d.client, siteId, virtualPath)
+	if err != nil {
+		return
+	}
+	resourcesServiceResponse, err := getSTFWebReceiverResourcesService(ctx, &resp.Diagnostics, d.client, siteId, virtualPath)
+	if err != nil {
+		return
+	}
+	siteStyle, err := getSTFWebReceiverSiteStyle(ctx, &resp.Diagnostics, d.client, siteId, virtualPath)
+	if err != nil {
+		return
+	}
+
+	webReceiverModel.RefreshPropertyValues(ctx, &resp.Diagnostics, webReceiver, &appShortcutsResponse, &communicationResponse, &stsResponse, &authManagerResponse, &uiResponse, &resourcesServiceResponse, &siteStyle)
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, webReceiverModel, webReceiver)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newSTFWebReceiverResourceModelForDataSource creates a WebReceiver resource model with all nested objects set, so that the resource refresh functions refresh all of their attributes
+func newSTFWebReceiverResourceModelForDataSource(ctx context.Context, diagnostics *diag.Diagnostics, siteId string, virtualPath string) STFWebReceiverResourceModel {
+	webReceiverModel := util.NewImportedResourceModel[STFWebReceiverResourceModel](ctx, diagnostics, STFWebReceiverResourceModel{}.GetSchema(), map[string]any{
+		"site_id":      siteId,
+		"virtual_path": virtualPath,
+	})
+	if diagnostics.HasError() {
+		return webReceiverModel
+	}
+
+	webReceiverModel.PluginAssistant = objectWithUnknownAttributes(ctx, diagnostics, PluginAssistant{})
+	webReceiverModel.ApplicationShortcuts = objectWithUnknownAttributes(ctx, diagnostics, ApplicationShortcuts{})
+	webReceiverModel.Communication = objectWithUnknownAttributes(ctx, diagnostics, Communication{})
+	webReceiverModel.StrictTransportSecurity = objectWithUnknownAttributes(ctx, diagnostics, StrictTransportSecurity{})
+	webReceiverModel.AuthenticationManager = objectWithUnknownAttributes(ctx, diagnostics, AuthenticationManager{})
+	webReceiverModel.UserInterface = objectWithUnknownAttributes(ctx, diagnostics, UserInterface{})
+	webReceiverModel.ResourcesService = objectWithUnknownAttributes(ctx, diagnostics, ResourcesService{})
+	webReceiverModel.WebReceiverSiteStyle = objectWithUnknownAttributes(ctx, diagnostics, WebReceiverSiteStyle{})
+
+	return webReceiverModel
+}
+
+func objectWithUnknownAttributes(ctx context.Context, diagnostics *diag.Diagnostics, model util.ModelWithAttributes) types.Object {
+	attributesMap, err := util.AttributeMapFromObject(model)
+	if err != nil {
+		diagnostics.AddError("Error converting schema to attribute map", err.Error())
+		return types.ObjectNull(map[string]attr.Type{})
+	}
+	return util.ObjectWithUnknownAttributes(ctx, diagnostics, attributesMap)
+}
@@ -0,0 +1,88 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package stf_webreceiver
+
+import (
+	"context"
+
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+
+	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// STFWebReceiverDataSourceModel defines the StoreFront WebReceiver data source implementation.
+type STFWebReceiverDataSourceModel struct {
+	SiteId                  types.String `tfsdk:"site_id"`
+	VirtualPath             types.String `tfsdk:"virtual_path"`
+	FriendlyName            types.String `tfsdk:"friendly_name"`
+	StoreServiceVirtualPath types.String `tfsdk:"store_virtual_path"`
+	AuthenticationMethods   types.Set    `tfsdk:"authentication_methods"`    // Set[string]
+	PluginAssistant         types.Object `tfsdk:"plugin_assistant"`          // PluginAssistant
+	ApplicationShortcuts    types.Object `tfsdk:"application_shortcuts"`     // ApplicationShortcuts
+	Communication           types.Object `tfsdk:"communication"`             // Communication
+	StrictTransportSecurity types.Object `tfsdk:"strict_transport_security"` // StrictTransportSecurity
+	AuthenticationManager   types.Object `tfsdk:"authentication_manager"`    // AuthenticationManager
+	UserInterface           types.Object `tfsdk:"user_interface"`            // UserInterface
+	ResourcesService        types.Object `tfsdk:"resources_service"`         // ResourcesServiceModel
+	WebReceiverSiteStyle    types.Object `tfsdk:"web_receiver_site_style"`   // WebReceiverSiteStyle
+	Site                    types.String `tfsdk:"site"`
+}
+
+func (STFWebReceiverDataSourceModel) GetSchema() schema.Schema {
+	// Expose the same attributes as the StoreFront WebReceiver resource
+	resourceAttributes := STFWebReceiverResourceModel{}.GetSchema().Attributes
+	delete(resourceAttributes, "site")
+	delete(resourceAttributes, "site_id")
+	delete(resourceAttributes, "virtual_path")
+
+	attributes := util.ResourceAttributesToDataSourceAttributes(resourceAttributes)
+	attributes["site"] = util.GetSiteDataSourceSchema()
+	attributes["site_id"] = schema.StringAttribute{
+		Description: "The IIS site id of the StoreFront WebReceiver. Defaults to `1`.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["virtual_path"] = schema.StringAttribute{
+		Description: "The IIS VirtualPath at which the WebReceiver is configured to be accessed by Receivers.",
+		Required:    true,
+	}
+
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "StoreFront --- Data source to get details regarding an existing StoreFront WebReceiver.",
+		Attributes:  attributes,
+	}
+}
+
+func (r STFWebReceiverDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, webReceiverModel STFWebReceiverResourceModel, webReceiver *citrixstorefront.STFWebReceiverDetailModel) STFWebReceiverDataSourceModel {
+	r.SiteId = webReceiverModel.SiteId
+	r.VirtualPath = webReceiverModel.VirtualPath
+	r.FriendlyName = webReceiverModel.FriendlyName
+	r.StoreServiceVirtualPath = types.StringPointerValue(webReceiver.StoreServiceVirtualPath.Get())
+	r.AuthenticationMethods = webReceiverModel.AuthenticationMethods
+
+	// The attributes which are not returned by StoreFront remain unknown after the refresh of the WebReceiver, and are set to null
+	dataSourceAttributes := r.GetSchema().Attributes
+	r.PluginAssistant = refreshDataSourceObject(ctx, diagnostics, r.PluginAssistant, webReceiverModel.PluginAssistant, dataSourceAttributes["plugin_assistant"])
+	r.ApplicationShortcuts = refreshDataSourceObject(ctx, diagnostics, r.ApplicationShortcuts, webReceiverModel.ApplicationShortcuts, dataSourceAttributes["application_shortcuts"])
+	r.Communication = refreshDataSourceObject(ctx, diagnostics, r.Communication, webReceiverModel.Communication, dataSourceAttributes["communication"])
+	r.StrictTransportSecurity = refreshDataSourceObject(ctx, diagnostics, r.StrictTransportSecurity, webReceiverModel.StrictTransportSecurity, dataSourceAttributes["strict_transport_security"])
+	r.AuthenticationManager = refreshDataSourceObject(ctx, diagnostics, r.AuthenticationManager, webReceiverModel.AuthenticationManager, dataSourceAttributes["authentication_manager"])
+	r.UserInterface = refreshDataSourceObject(ctx, diagnostics, r.UserInterface, webReceiverModel.UserInterface, dataSourceAttributes["user_interface"])
+	r.ResourcesService = refreshDataSourceObject(ctx, diagnostics, r.ResourcesService, webReceiverModel.ResourcesService, dataSourceAttributes["resources_service"])
+	r.WebReceiverSiteStyle = refreshDataSourceObject(ctx, diagnostics, r.WebReceiverSiteStyle, webReceiverModel.WebReceiverSiteStyle, dataSourceAttributes["web_receiver_site_style"])
+
+	return r
+}
+
+// refreshDataSourceObject converts a refreshed nested object of the resource model to the nested object of the data source, or returns the current value if the conversion fails
+func refreshDataSourceObject(ctx context.Context, diagnostics *diag.Diagnostics, current types.Object, resourceValue types.Object, dataSourceAttribute schema.Attribute) types.Object {
+	value := util.UnknownValuesToNull(ctx, diagnostics, resourceValue)
+	if object, ok := util.ResourceValueToDataSourceValue(ctx, diagnostics, value, dataSourceAttribute.GetType()).(types.Object); ok {
+		return object
+	}
+	return current
+}
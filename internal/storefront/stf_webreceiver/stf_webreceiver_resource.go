@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
@@ -75,6 +76,11 @@ func (r *stfWebReceiverResource) Schema(_ context.Context, _ resource.SchemaRequ
 func (r *stfWebReceiverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Skip modify plan when doing destroy action
 	if req.Plan.Raw.IsNull() {
 		return
@@ -109,6 +115,11 @@ func (r *stfWebReceiverResource) ModifyPlan(ctx context.Context, req resource.Mo
 func (r *stfWebReceiverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFWebReceiverResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -117,6 +128,8 @@ func (r *stfWebReceiverResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Create", "citrix_stf_webreceiver_service", &plan, time.Now())
+
 	// Generate API request body from plan
 	var body citrixstorefront.CreateSTFWebReceiverRequestModel
 	siteIdInt, err := strconv.ParseInt(plan.SiteId.ValueString(), 10, 64)
@@ -313,6 +326,11 @@ func (r *stfWebReceiverResource) Create(ctx context.Context, req resource.Create
 func (r *stfWebReceiverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state STFWebReceiverResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -436,6 +454,11 @@ func (r *stfWebReceiverResource) Read(ctx context.Context, req resource.ReadRequ
 func (r *stfWebReceiverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.Plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan STFWebReceiverResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -444,6 +467,8 @@ func (r *stfWebReceiverResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Update", "citrix_stf_webreceiver_service", &plan, time.Now())
+
 	var getWebReceiverRequestBody citrixstorefront.GetSTFWebReceiverRequestModel
 	getWebReceiverRequestBody.SetVirtualPath(plan.VirtualPath.ValueString())
 	if plan.SiteId.ValueString() != "" {
@@ -580,6 +605,11 @@ func (r *stfWebReceiverResource) Update(ctx context.Context, req resource.Update
 func (r *stfWebReceiverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
+	r.client = util.GetClientForSite(ctx, &resp.Diagnostics, r.client, req.State)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state STFWebReceiverResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -588,6 +618,8 @@ func (r *stfWebReceiverResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	defer util.AuditStoreFrontOperation(ctx, r.client, &resp.Diagnostics, "Delete", "citrix_stf_webreceiver_service", &state, time.Now())
+
 	var body citrixstorefront.GetSTFWebReceiverRequestModel
 	body.SetVirtualPath(state.VirtualPath.ValueString())
 	if state.SiteId.ValueString() != "" {
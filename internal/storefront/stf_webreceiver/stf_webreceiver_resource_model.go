@@ -646,6 +646,7 @@ type STFWebReceiverResourceModel struct {
 	UserInterface           types.Object `tfsdk:"user_interface"`            // UserInterface
 	ResourcesService        types.Object `tfsdk:"resources_service"`         // ResourcesServiceModel
 	WebReceiverSiteStyle    types.Object `tfsdk:"web_receiver_site_style"`   // WebReceiverSiteStyle
+	Site                    types.String `tfsdk:"site"`
 }
 
 type ResourcesService struct {
@@ -985,6 +986,7 @@ func (STFWebReceiverResourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		Description: "StoreFront --- StoreFront WebReceiver.",
 		Attributes: map[string]schema.Attribute{
+			"site": util.GetSiteSchema(),
 			"site_id": schema.StringAttribute{
 				Description: "The IIS site id of the StoreFront WebReceiver. Defaults to 1.",
 				Optional:    true,
@@ -5,6 +5,7 @@ package test
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -26,6 +27,12 @@ func TestAdminRoleResource(t *testing.T) {
 			TestAdminRolePreCheck(t)
 		},
 		Steps: []resource.TestStep{
+			// Plan testing of a misspelled permission
+			{
+				Config:      BuildAdminRoleResource(t, adminRoleTestResource_misspelledPermission),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Did you mean Director_DismissAlerts\?`),
+			},
 			// Create and Read testing
 			{
 				Config: BuildAdminRoleResource(t, adminRoleTestResource),
@@ -72,12 +79,32 @@ func TestAdminRoleResource(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr("citrix_admin_role.test_role", "permissions.*", "AppLib_AddPackage"),
 				),
 			},
+			// Data source testing
+			{
+				Config: BuildAdminRoleResource(t, adminRoleTestResource_updated+adminRoleTestDataSources),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the admin role found by name
+					resource.TestCheckResourceAttrPair("data.citrix_admin_role.test_role", "id", "citrix_admin_role.test_role", "id"),
+					resource.TestCheckResourceAttr("data.citrix_admin_role.test_role", "permissions.#", "3"),
+					resource.TestCheckTypeSetElemAttr("data.citrix_admin_role.test_role", "permissions.*", "AppLib_AddPackage"),
+					// Verify the permissions of the role are in the predefined permissions
+					resource.TestCheckTypeSetElemNestedAttrs("data.citrix_admin_permissions.all", "permissions.*", map[string]string{
+						"id": "AppLib_AddPackage",
+					}),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
 var (
+	adminRoleTestResource_misspelledPermission = `
+	resource "citrix_admin_role" "test_role" {
+		name = "%s"
+		permissions = ["Director_DismisAlerts"]
+	}
+	`
 	adminRoleTestResource = `
 	resource "citrix_admin_role" "test_role" {
 		name = "%s"
@@ -94,6 +121,14 @@ var (
 		permissions = ["Director_DismissAlerts", "ApplicationGroup_AddScope", "AppLib_AddPackage"]
 	}
 	`
+	adminRoleTestDataSources = `
+	data "citrix_admin_role" "test_role" {
+		name = citrix_admin_role.test_role.name
+	}
+
+	data "citrix_admin_permissions" "all" {
+	}
+	`
 )
 
 func BuildAdminRoleResource(t *testing.T, adminRole string) string {
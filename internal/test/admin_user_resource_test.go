@@ -109,6 +109,27 @@ func TestAdminUserResource(t *testing.T) {
 				),
 				SkipFunc: skipForCloud(isOnPremises),
 			},
+			// Data source testing
+			{
+				Config: composeTestResourceTf(
+					adminUserTestDataSource,
+					BuildAdminUserResource(t, adminUserTestResource_updated),
+					BuildAdminScopeResource(t, adminScopeTestResource),
+					BuildDeliveryGroupResource(t, testDeliveryGroupResources),
+					BuildPolicySetResourceWithoutDeliveryGroup(t),
+					BuildMachineCatalogResourceAzure(t, machinecatalog_testResources_azure_updated, "", "ActiveDirectory"),
+					BuildHypervisorResourcePoolResourceAzure(t, hypervisor_resource_pool_testResource_azure),
+					BuildHypervisorResourceAzure(t, hypervisor_testResources),
+					BuildZoneResource(t, zoneInput, false),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the admin user found by name
+					resource.TestCheckResourceAttrPair("data.citrix_admin_user.test_admin_user", "id", "citrix_admin_user.test_admin_user", "id"),
+					resource.TestCheckResourceAttr("data.citrix_admin_user.test_admin_user", "rights.#", "2"),
+					resource.TestCheckResourceAttr("data.citrix_admin_user.test_admin_user", "is_enabled", "true"),
+				),
+				SkipFunc: skipForCloud(isOnPremises),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -128,6 +149,12 @@ var (
 		is_enabled = true
 	}
 	`
+	adminUserTestDataSource = `
+	data "citrix_admin_user" "test_admin_user" {
+		name        = citrix_admin_user.test_admin_user.name
+		domain_name = citrix_admin_user.test_admin_user.domain_name
+	}
+	`
 	adminUserTestResource_updated = `
 	resource "citrix_admin_user" "test_admin_user" {
 		name = "%s"
@@ -50,6 +50,24 @@ func TestApplicationGroupResource(t *testing.T) {
 					resource.TestCheckResourceAttr("citrix_application_group.testApplicationGroup", "delivery_groups.#", "1"),
 				),
 			},
+			// Data source testing
+			{
+				Config: composeTestResourceTf(
+					testApplicationGroupDataSource,
+					BuildApplicationGroupResource(t, testApplicationGroupResource),
+					BuildDeliveryGroupResource(t, testDeliveryGroupResources),
+					BuildMachineCatalogResourceAzure(t, machinecatalog_testResources_azure_updated, "", "ActiveDirectory"),
+					BuildHypervisorResourcePoolResourceAzure(t, hypervisor_resource_pool_testResource_azure),
+					BuildHypervisorResourceAzure(t, hypervisor_testResources),
+					BuildZoneResource(t, zoneInput, false),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the application group found by name
+					resource.TestCheckResourceAttrPair("data.citrix_application_group.testApplicationGroup", "id", "citrix_application_group.testApplicationGroup", "id"),
+					resource.TestCheckResourceAttr("data.citrix_application_group.testApplicationGroup", "description", "ApplicationGroup for testing"),
+					resource.TestCheckResourceAttr("data.citrix_application_group.testApplicationGroup", "delivery_groups.#", "1"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "citrix_application_group.testApplicationGroup",
@@ -98,6 +116,11 @@ resource "citrix_application_group" "testApplicationGroup" {
 	delivery_groups = [citrix_delivery_group.testDeliveryGroup.id]
 
 }`
+	testApplicationGroupDataSource = `
+data "citrix_application_group" "testApplicationGroup" {
+	name = citrix_application_group.testApplicationGroup.name
+}
+`
 )
 
 func BuildApplicationGroupResource(t *testing.T, applicationResource string) string {
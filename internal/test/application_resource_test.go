@@ -89,6 +89,31 @@ func TestApplicationResource(t *testing.T) {
 					resource.TestCheckResourceAttr("citrix_application.testApplication", "application_folder_path", fmt.Sprintf("%s\\", updated_folder_name)),
 				),
 			},
+			// Data source testing
+			{
+				Config: composeTestResourceTf(
+					testApplicationDataSources,
+					BuildApplicationResource(t, testApplicationResource_updated),
+					BuildApplicationFolderResource(t, testApplicationFolderResource_updated),
+					BuildDeliveryGroupResource(t, testDeliveryGroupResources),
+					BuildPolicySetResourceWithoutDeliveryGroup(t),
+					BuildMachineCatalogResourceAzure(t, machinecatalog_testResources_azure_updated, "", "ActiveDirectory"),
+					BuildHypervisorResourcePoolResourceAzure(t, hypervisor_resource_pool_testResource_azure),
+					BuildHypervisorResourceAzure(t, hypervisor_testResources),
+					BuildZoneResource(t, zoneInput, false),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the application found by name in its folder
+					resource.TestCheckResourceAttrPair("data.citrix_application.testApplication", "id", "citrix_application.testApplication", "id"),
+					resource.TestCheckResourceAttr("data.citrix_application.testApplication", "published_name", "TestApplication"),
+					resource.TestCheckResourceAttr("data.citrix_application.testApplication", "installed_app_properties.command_line_arguments", "update test arguments"),
+					resource.TestCheckResourceAttr("data.citrix_application.testApplication", "delivery_groups.#", "1"),
+					// Verify the application in the folder details
+					resource.TestCheckResourceAttr("data.citrix_application_folder_details.testApplicationFolder", "total_applications", "1"),
+					resource.TestCheckResourceAttrPair("data.citrix_application_folder_details.testApplicationFolder", "applications_list.0.id", "citrix_application.testApplication", "id"),
+					resource.TestCheckResourceAttr("data.citrix_application_folder_details.testApplicationFolder", "applications_list.0.limit_visibility_to_users.#", "0"),
+				),
+			},
 			// Delete testing
 		},
 	})
@@ -119,6 +144,18 @@ resource "citrix_application" "testApplication" {
 	delivery_groups = [citrix_delivery_group.testDeliveryGroup.id]
 	application_folder_path = citrix_application_folder.testApplicationFolder2.path
 }`
+	testApplicationDataSources = `
+data "citrix_application" "testApplication" {
+	name                    = citrix_application.testApplication.name
+	application_folder_path = citrix_application.testApplication.application_folder_path
+}
+
+data "citrix_application_folder_details" "testApplicationFolder" {
+	path                              = citrix_application.testApplication.application_folder_path
+	recursive                         = true
+	include_limit_visibility_to_users = true
+}
+`
 )
 
 func BuildApplicationResource(t *testing.T, applicationResource string) string {
@@ -2,6 +2,11 @@
 
 package test
 
+import (
+	"regexp"
+	"strings"
+)
+
 // Used to skip a test case if environment is cloud
 func skipForCloud(isOnPremises bool) func() (bool, error) {
 	return func() (bool, error) {
@@ -32,3 +37,8 @@ func composeTestResourceTf(resources ...string) string {
 	}
 	return result
 }
+
+// Used to build a name_regex matching exactly the given name, escaped for a Terraform string
+func exactNameRegex(name string) string {
+	return strings.ReplaceAll("^"+regexp.QuoteMeta(name)+"$", `\`, `\\`)
+}
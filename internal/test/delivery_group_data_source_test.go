@@ -50,6 +50,15 @@ func TestDeliveryGroupDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.citrix_delivery_group.test_delivery_group", "vdas.#", strconv.Itoa(len(strings.Split(vdas, ",")))),
 				),
 			},
+			// Read testing using the list data source filtered by name
+			{
+				Config: BuildDeliveryGroupDataSource(t, delivery_groups_test_data_source, exactNameRegex(name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify that only the delivery group is returned
+					resource.TestCheckResourceAttr("data.citrix_delivery_groups.test_delivery_groups", "delivery_groups.#", "1"),
+					resource.TestCheckResourceAttr("data.citrix_delivery_groups.test_delivery_groups", "delivery_groups.0.id", id),
+				),
+			},
 		},
 	})
 }
@@ -64,4 +73,9 @@ var (
 		name = "%s"
 	}
 	`
+	delivery_groups_test_data_source = `
+	data "citrix_delivery_groups" "test_delivery_groups" {
+		name_regex = "%s"
+	}
+	`
 )
@@ -40,6 +40,15 @@ func TestHypervisorDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.citrix_hypervisor.test_hypervisor_by_name", "id", id),
 				),
 			},
+			// Read testing using the list data source filtered by name
+			{
+				Config: fmt.Sprintf(hypervisors_test_data_source_using_name_regex, exactNameRegex(os.Getenv("TEST_HYPERVISOR_DATASOURCE_NAME"))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify that only the hypervisor is returned
+					resource.TestCheckResourceAttr("data.citrix_hypervisors.test_hypervisors", "hypervisors.#", "1"),
+					resource.TestCheckResourceAttr("data.citrix_hypervisors.test_hypervisors", "hypervisors.0.id", id),
+				),
+			},
 		},
 	})
 }
@@ -56,4 +65,9 @@ var (
 		name = "%s"
 	}
 	`
+	hypervisors_test_data_source_using_name_regex = `
+	data "citrix_hypervisors" "test_hypervisors" {
+		name_regex = "%s"
+	}
+	`
 )
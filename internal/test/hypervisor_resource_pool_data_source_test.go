@@ -50,6 +50,15 @@ func TestHypervisorResourcePoolDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.citrix_hypervisor_resource_pool.test_resource_pool_by_name", "networks.#", strconv.Itoa(len(strings.Split(networks, ",")))),
 				),
 			},
+			// Read testing of the networks in the resource pool inventory
+			{
+				Config: BuildHypervisorResourcePoolDataSource(t, hypervisor_resource_pool_test_data_source_using_name+hypervisor_resources_test_data_source_networks),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the networks in the inventory of the resource pool
+					resource.TestCheckResourceAttr("data.citrix_hypervisor_resources.test_networks", "resources.#", strconv.Itoa(len(strings.Split(networks, ",")))),
+					resource.TestCheckResourceAttr("data.citrix_hypervisor_resources.test_networks", "resources.0.resource_type", "Network"),
+				),
+			},
 		},
 	})
 }
@@ -68,4 +77,12 @@ var (
 		hypervisor_name = "%s"
 	}
 	`
+
+	hypervisor_resources_test_data_source_networks = `
+	data "citrix_hypervisor_resources" "test_networks" {
+		hypervisor_name               = data.citrix_hypervisor_resource_pool.test_resource_pool_by_name.hypervisor_name
+		hypervisor_resource_pool_name = data.citrix_hypervisor_resource_pool.test_resource_pool_by_name.name
+		resource_type                 = "Network"
+	}
+	`
 )
@@ -0,0 +1,132 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestImageDefinitionPreCheck_Vsphere(t *testing.T) {
+	if v := os.Getenv("TEST_IMAGE_DEFINITION_NAME"); v == "" {
+		t.Fatal("TEST_IMAGE_DEFINITION_NAME must be set for acceptance tests")
+	}
+	if v := os.Getenv("TEST_IMAGE_VERSION_MASTER_IMAGE_VM_VSPHERE"); v == "" {
+		t.Fatal("TEST_IMAGE_VERSION_MASTER_IMAGE_VM_VSPHERE must be set for acceptance tests")
+	}
+	if v := os.Getenv("TEST_IMAGE_VERSION_IMAGE_SNAPSHOT_VSPHERE"); v == "" {
+		t.Fatal("TEST_IMAGE_VERSION_IMAGE_SNAPSHOT_VSPHERE must be set for acceptance tests")
+	}
+}
+
+func TestImageDefinitionAndVersionVsphere(t *testing.T) {
+	name := os.Getenv("TEST_IMAGE_DEFINITION_NAME")
+	zoneInput := os.Getenv("TEST_ZONE_INPUT_VSPHERE")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			TestProviderPreCheck(t)
+			TestHypervisorPreCheck_Vsphere(t)
+			TestHypervisorResourcePoolPreCheck_Vsphere(t)
+			TestImageDefinitionPreCheck_Vsphere(t)
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: composeTestResourceTf(
+					BuildImageVersionResourceVsphere(t, image_version_testResource_vsphere, "image version"),
+					BuildImageDefinitionResource(t, image_definition_testResource),
+					BuildHypervisorResourcePoolResourceVsphere(t, hypervisor_resource_pool_testResource_vsphere),
+					BuildHypervisorResourceVsphere(t, hypervisor_testResources_vsphere),
+					BuildZoneResource(t, zoneInput, false),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("citrix_image_definition.testImageDefinition", "name", name),
+					resource.TestCheckResourceAttr("citrix_image_definition.testImageDefinition", "description", "image definition"),
+					resource.TestCheckResourceAttr("citrix_image_definition.testImageDefinition", "os_type", "Windows"),
+					resource.TestCheckResourceAttr("citrix_image_definition.testImageDefinition", "session_support", "MultiSession"),
+					resource.TestCheckResourceAttr("citrix_image_version.testImageVersion", "version_number", "1"),
+					resource.TestCheckResourceAttr("citrix_image_version.testImageVersion", "description", "image version"),
+					resource.TestCheckResourceAttr("citrix_image_version.testImageVersion", "status", "Success"),
+					resource.TestCheckResourceAttrPair("citrix_image_version.testImageVersion", "image_definition", "citrix_image_definition.testImageDefinition", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "citrix_image_definition.testImageDefinition",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The latest version is increased by the image version created in the previous step
+				ImportStateVerifyIgnore: []string{"latest_version"},
+			},
+			// Update and Read testing
+			{
+				Config: composeTestResourceTf(
+					BuildImageVersionResourceVsphere(t, image_version_testResource_vsphere, "image version updated"),
+					BuildImageDefinitionResource(t, image_definition_updated_testResource),
+					BuildHypervisorResourcePoolResourceVsphere(t, hypervisor_resource_pool_testResource_vsphere),
+					BuildHypervisorResourceVsphere(t, hypervisor_testResources_vsphere),
+					BuildZoneResource(t, zoneInput, false),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("citrix_image_definition.testImageDefinition", "name", fmt.Sprintf("%s-updated", name)),
+					resource.TestCheckResourceAttr("citrix_image_definition.testImageDefinition", "description", "updated image definition"),
+					resource.TestCheckResourceAttr("citrix_image_definition.testImageDefinition", "latest_version", "1"),
+					resource.TestCheckResourceAttr("citrix_image_version.testImageVersion", "version_number", "1"),
+					resource.TestCheckResourceAttr("citrix_image_version.testImageVersion", "description", "image version updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+var (
+	image_definition_testResource = `
+resource "citrix_image_definition" "testImageDefinition" {
+	name            = "%s"
+	description     = "image definition"
+	os_type         = "Windows"
+	session_support = "MultiSession"
+	hypervisor      = citrix_vsphere_hypervisor.testHypervisor.id
+}
+`
+	image_definition_updated_testResource = `
+resource "citrix_image_definition" "testImageDefinition" {
+	name            = "%s-updated"
+	description     = "updated image definition"
+	os_type         = "Windows"
+	session_support = "MultiSession"
+	hypervisor      = citrix_vsphere_hypervisor.testHypervisor.id
+}
+`
+	image_version_testResource_vsphere = `
+resource "citrix_image_version" "testImageVersion" {
+	image_definition         = citrix_image_definition.testImageDefinition.id
+	description              = "%s"
+	hypervisor               = citrix_vsphere_hypervisor.testHypervisor.id
+	hypervisor_resource_pool = citrix_vsphere_hypervisor_resource_pool.testHypervisorResourcePool.id
+	vsphere_image_specs = {
+		master_image_vm = "%s"
+		image_snapshot  = "%s"
+		cpu_count       = 2
+		memory_mb       = 4096
+	}
+}
+`
+)
+
+func BuildImageDefinitionResource(t *testing.T, imageDefinition string) string {
+	name := os.Getenv("TEST_IMAGE_DEFINITION_NAME")
+	return fmt.Sprintf(imageDefinition, name)
+}
+
+func BuildImageVersionResourceVsphere(t *testing.T, imageVersion string, description string) string {
+	masterImageVm := os.Getenv("TEST_IMAGE_VERSION_MASTER_IMAGE_VM_VSPHERE")
+	imageSnapshot := os.Getenv("TEST_IMAGE_VERSION_IMAGE_SNAPSHOT_VSPHERE")
+	return fmt.Sprintf(imageVersion, description, masterImageVm, imageSnapshot)
+}
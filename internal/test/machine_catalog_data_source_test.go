@@ -48,6 +48,19 @@ func TestMachineCatalogDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.citrix_machine_catalog.test_machine_catalog", "id", id),
 					// Verify the list of VDAs in the Machine Catalog
 					resource.TestCheckResourceAttr("data.citrix_machine_catalog.test_machine_catalog", "vdas.#", strconv.Itoa(len(strings.Split(vdas, ",")))),
+					// Verify the properties of the Machine Catalog
+					resource.TestCheckResourceAttrSet("data.citrix_machine_catalog.test_machine_catalog", "provisioning_type"),
+					resource.TestCheckResourceAttrSet("data.citrix_machine_catalog.test_machine_catalog", "session_support"),
+					resource.TestCheckNoResourceAttr("data.citrix_machine_catalog.test_machine_catalog", "provisioning_scheme.machine_domain_identity.service_account_password"),
+				),
+			},
+			// Read testing using the list data source filtered by name
+			{
+				Config: BuildMachineCatalogDataSource(t, machine_catalogs_test_data_source, exactNameRegex(name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify that only the machine catalog is returned
+					resource.TestCheckResourceAttr("data.citrix_machine_catalogs.test_machine_catalogs", "machine_catalogs.#", "1"),
+					resource.TestCheckResourceAttr("data.citrix_machine_catalogs.test_machine_catalogs", "machine_catalogs.0.id", id),
 				),
 			},
 		},
@@ -64,4 +77,9 @@ var (
 		name = "%s"
 	}
 	`
+	machine_catalogs_test_data_source = `
+	data "citrix_machine_catalogs" "test_machine_catalogs" {
+		name_regex = "%s"
+	}
+	`
 )
@@ -123,6 +123,8 @@ func TestActiveDirectoryMachineCatalogResourceAzure(t *testing.T) {
 					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalog-AD", "name", name),
 					// Verify total number of machines
 					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalog-AD", "provisioning_scheme.number_of_total_machines", "1"),
+					// Verify scale-in policy
+					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalog-AD", "provisioning_scheme.scale_in_policy.machine_account_action", "Delete"),
 					// Verify machine catalog identity type
 					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalog-AD", "provisioning_scheme.identity_type", "ActiveDirectory"),
 				),
@@ -817,6 +819,11 @@ func TestMachineCatalogResourceSCVMM(t *testing.T) {
 					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalog", "provisioning_scheme.number_of_total_machines", "1"),
 					// Verify master image note
 					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalog", "provisioning_scheme.scvmm_machine_config.master_image_note", masterImageNote),
+					// Verify image rollback is disabled by default
+					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalog", "provisioning_scheme.rollback_to_previous_image", "false"),
+					// Verify the current image is the most recent image in the image history
+					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalog", "provisioning_scheme.image_history.0.status", "Current"),
+					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalog", "provisioning_scheme.image_history.0.master_image_note", masterImageNote),
 				),
 			},
 			// ImportState testing
@@ -845,6 +852,9 @@ func TestMachineCatalogResourceSCVMM(t *testing.T) {
 					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalog", "provisioning_scheme.number_of_total_machines", "2"),
 					// Verify updated master image note
 					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalog", "provisioning_scheme.scvmm_machine_config.master_image_note", masterImageNote+"-updated"),
+					// Verify the previous image is kept in the image history
+					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalog", "provisioning_scheme.image_history.0.master_image_note", masterImageNote+"-updated"),
+					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalog", "provisioning_scheme.image_history.1.master_image_note", masterImageNote),
 				),
 			},
 			//Delete testing automatically occurs in TestCase
@@ -1425,6 +1435,19 @@ func TestMachineCatalogResource_Manual_Non_Power_Managed(t *testing.T) {
 				// API, therefore there is no value for it during import.
 				ImportStateVerifyIgnore: []string{"machine_accounts", "is_remote_pc", "is_power_managed"},
 			},
+			// Update machine settings testing
+			{
+				Config: composeTestResourceTf(
+					BuildMachineCatalogResourceManualNonPowerManagedWithMachineSettings(t, machinecatalog_testResources_manual_non_power_managed_machine_settings),
+					BuildZoneResource(t, zoneInput, false),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify name of catalog
+					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalogNonManualPowerManaged", "name", name),
+					// Verify machine settings
+					resource.TestCheckResourceAttr("citrix_machine_catalog.testMachineCatalogNonManualPowerManaged", "machine_settings.%", "1"),
+				),
+			},
 			//Delete testing automatically occurs in TestCase
 		},
 	})
@@ -1638,6 +1661,9 @@ resource "citrix_machine_catalog" "testMachineCatalog%s" {
 			]
 			availability_zones = ["1","3"]
 			number_of_total_machines = 	1
+			scale_in_policy = {
+				prefer = ["Unregistered", "Oldest"]
+			}
 			machine_account_creation_rules ={
 				naming_scheme =     "%s"
 				naming_scheme_type ="Numeric"
@@ -2434,6 +2460,32 @@ resource "citrix_machine_catalog" "testMachineCatalog%s" {
 		]
 	}
 	`
+	machinecatalog_testResources_manual_non_power_managed_machine_settings = `
+	resource "citrix_machine_catalog" "testMachineCatalogNonManualPowerManaged" {
+		name                		= "%s"
+		description					= "manual non power managed multi-session catalog testing"
+		zone						= citrix_zone.test.id
+		allocation_type				= "%s"
+		session_support				= "%s"
+		is_power_managed			= false
+		is_remote_pc			    = false
+		provisioning_type			= "Manual"
+		machine_accounts = [
+			{
+				machines = [
+					{
+						machine_account = "%s"
+					}
+				]
+			}
+		]
+		machine_settings = {
+			"%s" = {
+				in_maintenance_mode = true
+			}
+		}
+	}
+	`
 	machinecatalog_testResources_remote_pc = `
 	resource "citrix_machine_catalog" "testMachineCatalog" {
 		name                		= "%s"
@@ -2697,6 +2749,15 @@ func BuildMachineCatalogResourceManualNonPowerManaged(t *testing.T, machineResou
 	return fmt.Sprintf(machineResource, name, allocation_type, session_support, machine_account)
 }
 
+func BuildMachineCatalogResourceManualNonPowerManagedWithMachineSettings(t *testing.T, machineResource string) string {
+	name := os.Getenv("TEST_MC_NAME_MANUAL")
+	machine_account := os.Getenv("TEST_MC_MACHINE_ACCOUNT_MANUAL_NON_POWER_MANAGED")
+	allocation_type := os.Getenv("TEST_MC_ALLOCATION_TYPE_MANUAL_NON_POWER_MANAGED")
+	session_support := os.Getenv("TEST_MC_SESSION_SUPPORT_MANUAL_NON_POWER_MANAGED")
+
+	return fmt.Sprintf(machineResource, name, allocation_type, session_support, machine_account, machine_account)
+}
+
 func BuildMachineCatalogResourceRemotePC(t *testing.T, machineResource string) string {
 	name := os.Getenv("TEST_MC_NAME_REMOTE_PC")
 	machine_account := os.Getenv("TEST_MC_MACHINE_ACCOUNT_REMOTE_PC")
@@ -56,6 +56,19 @@ func TestResourceLocationResource(t *testing.T) {
 					resource.TestCheckResourceAttr("citrix_cloud_resource_location.test_resource_location", "time_zone", "Eastern Standard Time"),
 				),
 			},
+			// Data source testing
+			{
+				Config: BuildResourceLocationResource(t, resourceLocationTestResource_updated, name) + resourceLocationTestDataSource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the resource location is found by name
+					resource.TestCheckResourceAttrPair("data.citrix_cloud_resource_location.test_resource_location_by_name", "id", "citrix_cloud_resource_location.test_resource_location", "id"),
+					resource.TestCheckResourceAttr("data.citrix_cloud_resource_location.test_resource_location_by_name", "time_zone", "Eastern Standard Time"),
+					// Verify the resource location is found by id
+					resource.TestCheckResourceAttr("data.citrix_cloud_resource_location.test_resource_location_by_id", "name", fmt.Sprintf("%s-updated", name)),
+					// Verify the new resource location has no Cloud Connectors
+					resource.TestCheckResourceAttr("data.citrix_cloud_connectors.test_cloud_connectors", "connectors.#", "0"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -72,6 +85,19 @@ resource "citrix_cloud_resource_location" "test_resource_location" {
 	name = "%s-updated"
 	time_zone = "Eastern Standard Time"
 }
+`
+	resourceLocationTestDataSource = `
+data "citrix_cloud_resource_location" "test_resource_location_by_name" {
+	name = citrix_cloud_resource_location.test_resource_location.name
+}
+
+data "citrix_cloud_resource_location" "test_resource_location_by_id" {
+	id = citrix_cloud_resource_location.test_resource_location.id
+}
+
+data "citrix_cloud_connectors" "test_cloud_connectors" {
+	resource_location_id = citrix_cloud_resource_location.test_resource_location.id
+}
 `
 )
 
@@ -65,6 +65,20 @@ func TestSTFAuthenticationServiceResource(t *testing.T) {
 					resource.TestCheckResourceAttr("citrix_stf_authentication_service.testSTFAuthenticationService", "claims_factory_name", "testClaimsFactoryNameUpdated"),
 				),
 			},
+
+			// Data source testing
+			{
+				Config: BuildSTFAuthenticationServiceResource(t, testSTFAuthenticationServiceResources_updated) + testSTFAuthenticationServiceDataSource,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify parameters of the STF authentication service data source
+					resource.TestCheckResourceAttr("data.citrix_stf_authentication_service.testSTFAuthenticationService", "site_id", siteId),
+					resource.TestCheckResourceAttr("data.citrix_stf_authentication_service.testSTFAuthenticationService", "virtual_path", virtualPath),
+					resource.TestCheckResourceAttr("data.citrix_stf_authentication_service.testSTFAuthenticationService", "friendly_name", "testAuthServiceUpdated"),
+					resource.TestCheckResourceAttr("data.citrix_stf_authentication_service.testSTFAuthenticationService", "claims_factory_name", "testClaimsFactoryNameUpdated"),
+					resource.TestCheckResourceAttrSet("data.citrix_stf_authentication_service.testSTFAuthenticationService", "protocols.#"),
+				),
+			},
 		},
 	})
 }
@@ -106,4 +120,11 @@ var (
 		claims_factory_name = "testClaimsFactoryNameUpdated"
 	}
 	`
+
+	testSTFAuthenticationServiceDataSource = `
+	data "citrix_stf_authentication_service" "testSTFAuthenticationService" {
+		site_id      = citrix_stf_authentication_service.testSTFAuthenticationService.site_id
+		virtual_path = citrix_stf_authentication_service.testSTFAuthenticationService.virtual_path
+	}
+	`
 )
@@ -67,6 +67,17 @@ func TestSTFDeploymentResource(t *testing.T) {
 					resource.TestCheckResourceAttr("citrix_stf_deployment.testSTFDeployment", "host_base_url", "http://test_updated"),
 				),
 			},
+
+			// Data source testing
+			{
+				Config: BuildSTFDeploymentResource(t, testSTFDeploymentResources_updated, siteId_updated) + testSTFDeploymentDataSource,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the STF deployment is read by site_id
+					resource.TestCheckResourceAttr("data.citrix_stf_deployment.testSTFDeployment", "site_id", siteId_updated),
+					resource.TestCheckResourceAttr("data.citrix_stf_deployment.testSTFDeployment", "host_base_url", "http://test_updated"),
+				),
+			},
 		},
 	})
 }
@@ -88,4 +99,9 @@ var (
 		host_base_url = "http://test_updated"
 	}
 	`
+	testSTFDeploymentDataSource = `
+	data "citrix_stf_deployment" "testSTFDeployment" {
+		site_id = citrix_stf_deployment.testSTFDeployment.site_id
+	}
+	`
 )
@@ -117,6 +117,26 @@ func TestSTFStoreServiceResource(t *testing.T) {
 					resource.TestCheckResourceAttr("citrix_stf_store_service.testSTFStoreService", "farms.0.farm_type", "XenDesktop"),
 				),
 			},
+
+			// Data source testing
+			{
+				Config: BuildSTFStoreServiceResource(t, testSTFStoreServiceResources_updated) + testSTFStoreServiceDataSource,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify parameters of the STF Store Service data source
+					resource.TestCheckResourceAttr("data.citrix_stf_store_service.testSTFStoreService", "site_id", siteId),
+					resource.TestCheckResourceAttr("data.citrix_stf_store_service.testSTFStoreService", "virtual_path", virtualPath),
+					resource.TestCheckResourceAttr("data.citrix_stf_store_service.testSTFStoreService", "friendly_name", "Store_Updated"),
+					resource.TestCheckResourceAttrPair("data.citrix_stf_store_service.testSTFStoreService", "authentication_service_virtual_path", "citrix_stf_authentication_service.testSTFAuthenticationService", "virtual_path"),
+					resource.TestCheckResourceAttr("data.citrix_stf_store_service.testSTFStoreService", "enumeration_options.enhanced_enumeration", "true"),
+					resource.TestCheckResourceAttr("data.citrix_stf_store_service.testSTFStoreService", "launch_options.vda_logon_data_provider", "UpdatedLogonDataProvider"),
+					resource.TestCheckResourceAttr("data.citrix_stf_store_service.testSTFStoreService", "farm_settings.cert_revocation_policy", "NoCheck"),
+					resource.TestCheckResourceAttr("data.citrix_stf_store_service.testSTFStoreService", "roaming_account.published", "false"),
+					resource.TestCheckResourceAttr("data.citrix_stf_store_service.testSTFStoreService", "farms.#", "1"),
+					resource.TestCheckResourceAttr("data.citrix_stf_store_service.testSTFStoreService", "farms.0.farm_name", "Controller1"),
+					resource.TestCheckResourceAttr("data.citrix_stf_store_service.testSTFStoreService", "farms.0.farm_type", "XenDesktop"),
+				),
+			},
 		},
 	})
 }
@@ -234,4 +254,12 @@ var (
 		}
 	  }
 	`
+
+	testSTFStoreServiceDataSource = `
+	data "citrix_stf_store_service" "testSTFStoreService" {
+		site_id      = citrix_stf_store_service.testSTFStoreService.site_id
+		virtual_path = citrix_stf_store_service.testSTFStoreService.virtual_path
+		farm_names   = ["Controller1"]
+	}
+	`
 )
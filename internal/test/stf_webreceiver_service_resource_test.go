@@ -127,6 +127,25 @@ func TestSTFWebReceiverServiceResource(t *testing.T) {
 					resource.TestCheckResourceAttr("citrix_stf_webreceiver_service.testSTFWebReceiverService", "web_receiver_site_style.link_color", "Dark moderate violet"),
 				),
 			},
+
+			// Data source testing
+			{
+				Config: BuildSTFWebReceiverServiceResource(t, testSTFWebReceiverServiceResources_updated) + testSTFWebReceiverServiceDataSource,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify parameters of the STF WebReceiver Service data source
+					resource.TestCheckResourceAttr("data.citrix_stf_webreceiver_service.testSTFWebReceiverService", "site_id", siteId),
+					resource.TestCheckResourceAttr("data.citrix_stf_webreceiver_service.testSTFWebReceiverService", "virtual_path", virtualPath),
+					resource.TestCheckResourceAttr("data.citrix_stf_webreceiver_service.testSTFWebReceiverService", "friendly_name", "WebReceiver_Updated"),
+					resource.TestCheckResourceAttrPair("data.citrix_stf_webreceiver_service.testSTFWebReceiverService", "store_virtual_path", "citrix_stf_store_service.testSTFStoreService", "virtual_path"),
+					resource.TestCheckResourceAttr("data.citrix_stf_webreceiver_service.testSTFWebReceiverService", "authentication_methods.#", "2"),
+					resource.TestCheckResourceAttr("data.citrix_stf_webreceiver_service.testSTFWebReceiverService", "plugin_assistant.enabled", "true"),
+					resource.TestCheckResourceAttr("data.citrix_stf_webreceiver_service.testSTFWebReceiverService", "communication.attempts", "3"),
+					resource.TestCheckResourceAttr("data.citrix_stf_webreceiver_service.testSTFWebReceiverService", "user_interface.workspace_control.logoff_action", "Terminate"),
+					resource.TestCheckResourceAttr("data.citrix_stf_webreceiver_service.testSTFWebReceiverService", "resources_service.ica_file_cache_expiry", "64"),
+					resource.TestCheckResourceAttr("data.citrix_stf_webreceiver_service.testSTFWebReceiverService", "web_receiver_site_style.link_color", "Dark moderate violet"),
+				),
+			},
 		},
 	})
 }
@@ -243,4 +262,11 @@ var (
 		}
 	  }
 	`
+
+	testSTFWebReceiverServiceDataSource = `
+	data "citrix_stf_webreceiver_service" "testSTFWebReceiverService" {
+		site_id      = citrix_stf_webreceiver_service.testSTFWebReceiverService.site_id
+		virtual_path = citrix_stf_webreceiver_service.testSTFWebReceiverService.virtual_path
+	}
+	`
 )
@@ -65,6 +65,33 @@ func TestVdaDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.citrix_vda.test_vda_by_delivery_group", "vdas.#", strconv.Itoa(len(strings.Split(deliveryGroupVdas, ",")))),
 				),
 			},
+			// Read testing using Machine Catalog with a name pattern matching all VDAs
+			{
+				Config: BuildVdaDataSource(t, vda_test_data_source_using_machine_catalog_and_name_pattern, machineCatalog),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the list of VDAs in the Machine Catalog
+					resource.TestCheckResourceAttr("data.citrix_vda.test_vda_by_name_pattern", "vdas.#", strconv.Itoa(len(strings.Split(machineCatalogVdas, ",")))),
+					// Verify the state of the VDAs is returned
+					resource.TestCheckResourceAttrSet("data.citrix_vda.test_vda_by_name_pattern", "vdas.0.registration_state"),
+					resource.TestCheckResourceAttrSet("data.citrix_vda.test_vda_by_name_pattern", "vdas.0.power_state"),
+					resource.TestCheckResourceAttrSet("data.citrix_vda.test_vda_by_name_pattern", "vdas.0.in_maintenance_mode"),
+				),
+			},
+			// Read testing of a single Machine of the Machine Catalog
+			{
+				Config: BuildVdaDataSource(t, machine_test_data_source, machineCatalog),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the Machine is returned by name
+					resource.TestCheckResourceAttrPair("data.citrix_machine.test_machine", "name", "data.citrix_vda.test_vda_by_machine_catalog", "vdas.0.machine_name"),
+					// Verify the Machine Catalog and hosting details of the Machine
+					resource.TestCheckResourceAttrPair("data.citrix_machine.test_machine", "machine_catalog", "data.citrix_vda.test_vda_by_machine_catalog", "vdas.0.associated_machine_catalog"),
+					resource.TestCheckResourceAttrPair("data.citrix_machine.test_machine", "hosted_machine_id", "data.citrix_vda.test_vda_by_machine_catalog", "vdas.0.hosted_machine_id"),
+					// Verify the state of the Machine is returned
+					resource.TestCheckResourceAttrSet("data.citrix_machine.test_machine", "sid"),
+					resource.TestCheckResourceAttrSet("data.citrix_machine.test_machine", "power_state"),
+					resource.TestCheckResourceAttrSet("data.citrix_machine.test_machine", "sessions.#"),
+				),
+			},
 		},
 	})
 }
@@ -80,6 +107,19 @@ var (
 	}
 	`
 
+	vda_test_data_source_using_machine_catalog_and_name_pattern = `
+	data "citrix_vda" "test_vda_by_name_pattern" {
+		machine_catalog = "%s"
+		name_pattern    = "*"
+	}
+	`
+
+	machine_test_data_source = vda_test_data_source_using_machine_catalog + `
+	data "citrix_machine" "test_machine" {
+		name = data.citrix_vda.test_vda_by_machine_catalog.vdas[0].machine_name
+	}
+	`
+
 	vda_test_data_source_using_delivery_group = `
 	data "citrix_vda" "test_vda_by_delivery_group" {
 		delivery_group = "%s"
@@ -40,6 +40,15 @@ func TestZoneDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.citrix_zone.test_zone_by_name", "id", id),
 				),
 			},
+			// Read testing using the list data source filtered by name
+			{
+				Config: fmt.Sprintf(zones_test_data_source_using_name_regex, exactNameRegex(os.Getenv("TEST_ZONE_DATASOURCE_NAME"))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify that only the zone is returned
+					resource.TestCheckResourceAttr("data.citrix_zones.test_zones", "zones.#", "1"),
+					resource.TestCheckResourceAttr("data.citrix_zones.test_zones", "zones.0.id", id),
+				),
+			},
 		},
 	})
 }
@@ -56,4 +65,9 @@ var (
 		name = "%s"
 	}
 	`
+	zones_test_data_source_using_name_regex = `
+	data "citrix_zones" "test_zones" {
+		name_regex = "%s"
+	}
+	`
 )
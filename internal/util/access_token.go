@@ -0,0 +1,262 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+)
+
+// Access tokens expiring within this window are considered expired, consistent with the token cache of the Citrix DaaS client
+const AccessTokenExpiryBuffer = time.Minute
+
+// Placeholder credentials used for the Citrix DaaS client when authenticating with a pre-issued access token.
+// The client refuses to use a cached token without credentials, but never signs in with them as long as the cached token is valid.
+const AccessTokenClientCredentialPlaceholder = "access_token"
+
+// AccessTokenClaims holds the claims of a JWT access token that are relevant for diagnostics
+type AccessTokenClaims struct {
+	ExpiresAt time.Time
+	Audience  []string
+	Subject   string
+}
+
+type accessTokenPayload struct {
+	Exp int64           `json:"exp"`
+	Aud json.RawMessage `json:"aud"`
+	Sub string          `json:"sub"`
+}
+
+// <summary>
+// Helper function to read the claims of a JWT access token without verifying its signature
+// </summary>
+// <param name="token">Access token</param>
+// <returns>Token claims, or nil when the token is not a JWT</returns>
+func GetAccessTokenClaims(token string) *AccessTokenClaims {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil
+	}
+	var tokenPayload accessTokenPayload
+	if err := json.Unmarshal(payload, &tokenPayload); err != nil {
+		return nil
+	}
+
+	claims := &AccessTokenClaims{Subject: tokenPayload.Sub}
+	if tokenPayload.Exp > 0 {
+		claims.ExpiresAt = time.Unix(tokenPayload.Exp, 0).UTC()
+	}
+	var audience string
+	if err := json.Unmarshal(tokenPayload.Aud, &audience); err == nil {
+		claims.Audience = []string{audience}
+	} else {
+		_ = json.Unmarshal(tokenPayload.Aud, &claims.Audience)
+	}
+	return claims
+}
+
+// IsExpired returns whether the token is expired or about to expire. Tokens without an expiration claim never expire.
+func (c *AccessTokenClaims) IsExpired() bool {
+	return c != nil && !c.ExpiresAt.IsZero() && time.Now().UTC().Add(AccessTokenExpiryBuffer).After(c.ExpiresAt)
+}
+
+// AccessTokenSource provides a pre-issued bearer token for the Citrix APIs, either from a static value or from a file maintained by an external token broker.
+// When the token comes from a file, the file is read again when the token is about to expire or when the file has been modified.
+type AccessTokenSource struct {
+	token     string
+	tokenFile string
+
+	mu          sync.Mutex
+	claims      *AccessTokenClaims
+	fileModTime time.Time
+}
+
+// <summary>
+// Helper function to create an access token source
+// </summary>
+// <param name="token">Static access token. Takes precedence over the token file</param>
+// <param name="tokenFile">Path of the file containing the access token</param>
+// <returns>Access token source</returns>
+func NewAccessTokenSource(token, tokenFile string) *AccessTokenSource {
+	source := &AccessTokenSource{tokenFile: tokenFile}
+	if token != "" {
+		source.token = normalizeAccessToken(token)
+		source.claims = GetAccessTokenClaims(source.token)
+		source.tokenFile = ""
+	}
+	return source
+}
+
+// <summary>
+// Helper function to get the current access token, reading the token file again if needed
+// </summary>
+// <returns>Access token, its claims when the token is a JWT, and an error if the token file cannot be read</returns>
+func (s *AccessTokenSource) Token() (string, *AccessTokenClaims, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.tokenFile == "" {
+		return s.token, s.claims, nil
+	}
+
+	info, err := os.Stat(s.tokenFile)
+	if err != nil {
+		return "", nil, fmt.Errorf("could not read access token file %s: %w", s.tokenFile, err)
+	}
+	if s.token != "" && !s.claims.IsExpired() && info.ModTime().Equal(s.fileModTime) {
+		return s.token, s.claims, nil
+	}
+
+	content, err := os.ReadFile(s.tokenFile)
+	if err != nil {
+		return "", nil, fmt.Errorf("could not read access token file %s: %w", s.tokenFile, err)
+	}
+	token := normalizeAccessToken(string(content))
+	if token == "" {
+		return "", nil, fmt.Errorf("access token file %s is empty", s.tokenFile)
+	}
+
+	s.token = token
+	s.claims = GetAccessTokenClaims(token)
+	s.fileModTime = info.ModTime()
+	return s.token, s.claims, nil
+}
+
+// Accept tokens copied together with their authorization scheme
+func normalizeAccessToken(token string) string {
+	token = strings.TrimSpace(token)
+	for _, prefix := range []string{"CWSAuth bearer=", "Bearer "} {
+		if len(token) >= len(prefix) && strings.EqualFold(token[:len(prefix)], prefix) {
+			token = strings.TrimSpace(token[len(prefix):])
+		}
+	}
+	return token
+}
+
+var accessTokenSources sync.Map
+var signInHttpClients sync.Map
+
+// <summary>
+// Helper function to authenticate the Citrix DaaS client with an access token source instead of client credentials
+// </summary>
+// <param name="client">Citrix DaaS client</param>
+// <param name="source">Access token source</param>
+func SetAccessTokenSource(client *citrixdaasclient.CitrixDaasClient, source *AccessTokenSource) {
+	accessTokenSources.Store(client, source)
+}
+
+// <summary>
+// Helper function to sign into Citrix Cloud through the given HTTP client instead of the default HTTP client.
+// On-premises and Citrix Cloud Gov sign in already use the HTTP client of the Orchestration API client.
+// </summary>
+// <param name="client">Citrix DaaS client</param>
+// <param name="httpClient">HTTP client used to sign in</param>
+func SetSignInHttpClient(client *citrixdaasclient.CitrixDaasClient, httpClient *http.Client) {
+	signInHttpClients.Store(client, httpClient)
+}
+
+// <summary>
+// Helper function to get the authorization header value for the Citrix APIs.
+// Uses the access token source of the client when configured, otherwise signs in with the client credentials.
+// </summary>
+// <param name="client">Citrix DaaS client</param>
+// <returns>Authorization header value, the sign in response, and error if any</returns>
+func SignIn(client *citrixdaasclient.CitrixDaasClient) (string, *http.Response, error) {
+	value, ok := accessTokenSources.Load(client)
+	if !ok {
+		httpClient, ok := signInHttpClients.Load(client)
+		if ok && !client.AuthConfig.OnPremises && !client.AuthConfig.IsGov {
+			return signInToCitrixCloud(client, httpClient.(*http.Client))
+		}
+		return client.SignIn()
+	}
+
+	token, claims, err := value.(*AccessTokenSource).Token()
+	if err != nil {
+		return "", nil, err
+	}
+	if claims.IsExpired() {
+		return "", nil, fmt.Errorf("access token expired at %s", claims.ExpiresAt.Format(time.RFC3339))
+	}
+
+	// Keep the token cache of the client in sync so that it never falls back to the placeholder credentials
+	expiresAt := time.Now().UTC().Add(time.Hour)
+	if claims != nil && !claims.ExpiresAt.IsZero() {
+		expiresAt = claims.ExpiresAt
+	}
+	authToken := "CWSAuth bearer=" + token
+	client.AuthToken = &citrixdaasclient.AuthTokenModel{
+		Token:     authToken,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	}
+	return authToken, nil, nil
+}
+
+// signInToCitrixCloud signs in with the Citrix Cloud API client credentials in the same way as the Citrix DaaS client, using the given HTTP client
+func signInToCitrixCloud(client *citrixdaasclient.CitrixDaasClient, httpClient *http.Client) (string, *http.Response, error) {
+	if client.AuthConfig.ClientId == "" || client.AuthConfig.ClientSecret == "" {
+		return "", nil, fmt.Errorf("make sure customerid, clientid and clientsecret are not null")
+	}
+
+	if client.AuthToken != nil {
+		tokenExpirationTime, err := time.Parse(time.RFC3339, client.AuthToken.ExpiresAt)
+		if err == nil && tokenExpirationTime.After(time.Now().UTC().Add(AccessTokenExpiryBuffer)) {
+			return client.AuthToken.Token, nil, nil
+		}
+	}
+
+	operation := func() (citrixdaasclient.CCAuthResponse, *http.Response, error) {
+		authResponse := citrixdaasclient.CCAuthResponse{}
+		httpResp, err := httpClient.PostForm(client.AuthConfig.AuthUrl, url.Values{
+			"grant_type":    {"client_credentials"},
+			"client_id":     {client.AuthConfig.ClientId},
+			"client_secret": {client.AuthConfig.ClientSecret},
+		})
+		if err != nil {
+			return authResponse, httpResp, err
+		}
+		defer httpResp.Body.Close()
+		body, err := io.ReadAll(httpResp.Body)
+		if err != nil {
+			return authResponse, httpResp, err
+		}
+		if httpResp.StatusCode > 200 {
+			return authResponse, httpResp, fmt.Errorf("could not sign into Citrix Cloud, %s", string(body))
+		}
+		if err := json.Unmarshal(body, &authResponse); err != nil {
+			return authResponse, httpResp, err
+		}
+		if authResponse.Error != "" {
+			return authResponse, httpResp, fmt.Errorf("could not sign into Citrix Cloud, %s: %s", authResponse.Error, authResponse.ErrorDescription)
+		}
+		return authResponse, httpResp, nil
+	}
+
+	authResponse, httpResp, err := citrixdaasclient.RetryOperationWithExponentialBackOff(operation, 10, 3)
+	if err != nil {
+		return "", httpResp, err
+	}
+
+	token := fmt.Sprintf("CWSAuth bearer=%s", authResponse.Token)
+	expiryInSeconds, _ := strconv.Atoi(authResponse.Expiration)
+	client.AuthToken = &citrixdaasclient.AuthTokenModel{
+		Token:     token,
+		ExpiresAt: time.Now().UTC().Add(time.Second * time.Duration(expiryInSeconds)).Format(time.RFC3339),
+	}
+	return token, nil, nil
+}
@@ -0,0 +1,304 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Request bodies larger than this are not written to the audit log
+const AuditLogMaxBodySize = 1024 * 1024
+
+// Field names that are always redacted, in addition to the sensitive attributes of the resource schemas
+var auditLogSensitiveFields = []string{"password", "secret", "clientsecret", "accesstoken", "token"}
+
+// AuditLog writes a JSON line for every mutating request sent to the Citrix APIs and every StoreFront change, for change-management evidence.
+// A single audit log is shared by all API clients of the provider.
+type AuditLog struct {
+	mu              sync.Mutex
+	writer          io.Writer
+	sensitiveFields map[string]bool
+}
+
+// AuditRecord is a single line of the audit log
+type AuditRecord struct {
+	Timestamp     string      `json:"timestamp"`
+	TransactionId string      `json:"transaction_id,omitempty"`
+	Service       string      `json:"service"`
+	Method        string      `json:"method"`
+	Path          string      `json:"path"`
+	Status        int         `json:"status,omitempty"`
+	DurationMs    int64       `json:"duration_ms"`
+	Body          interface{} `json:"body,omitempty"`
+	Error         string      `json:"error,omitempty"`
+}
+
+// <summary>
+// Helper function to create an audit log appending to a file
+// </summary>
+// <param name="filePath">Path of the audit log file. The file is created if it does not exist</param>
+// <param name="sensitiveFields">Names of the sensitive attributes of the resource schemas, redacted from the request bodies</param>
+// <returns>Audit log, and error if the file cannot be opened</returns>
+func NewAuditLog(filePath string, sensitiveFields map[string]bool) (*AuditLog, error) {
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return NewAuditLogWithWriter(file, sensitiveFields), nil
+}
+
+// <summary>
+// Helper function to create an audit log writing to the given writer
+// </summary>
+// <param name="writer">Writer receiving the JSON lines</param>
+// <param name="sensitiveFields">Names of the sensitive attributes of the resource schemas, redacted from the request bodies</param>
+// <returns>Audit log</returns>
+func NewAuditLogWithWriter(writer io.Writer, sensitiveFields map[string]bool) *AuditLog {
+	auditLog := &AuditLog{
+		writer:          writer,
+		sensitiveFields: map[string]bool{},
+	}
+	for _, field := range auditLogSensitiveFields {
+		auditLog.sensitiveFields[field] = true
+	}
+	for field := range sensitiveFields {
+		auditLog.sensitiveFields[normalizeAuditFieldName(field)] = true
+	}
+	return auditLog
+}
+
+// Schema attributes are snake_case while the API models use PascalCase or camelCase
+func normalizeAuditFieldName(name string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+}
+
+// Write appends a record to the audit log. Auditing is best effort and never fails the operation being audited.
+func (l *AuditLog) Write(ctx context.Context, record AuditRecord) {
+	if record.Timestamp == "" {
+		record.Timestamp = time.Now().UTC().Format(time.RFC3339Nano)
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		tflog.Warn(ctx, "Could not write audit log record", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.writer.Write(line); err != nil {
+		tflog.Warn(ctx, "Could not write audit log record", map[string]interface{}{"error": err.Error()})
+	}
+}
+
+// <summary>
+// Helper function to redact the sensitive fields of a request body
+// </summary>
+// <param name="contentType">Content type of the body</param>
+// <param name="body">Request body</param>
+// <returns>Redacted JSON or form body, or nil when the body is empty or has an unsupported content type</returns>
+func (l *AuditLog) RedactBody(contentType string, body []byte) interface{} {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	if len(body) > AuditLogMaxBodySize {
+		return fmt.Sprintf("body of %d bytes omitted", len(body))
+	}
+
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil
+		}
+		redacted := map[string]interface{}{}
+		for key, value := range values {
+			redacted[key] = l.redactValue(key, strings.Join(value, ","))
+		}
+		return redacted
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		// Only structured bodies can be redacted reliably
+		return "non-JSON body omitted"
+	}
+	return l.redactJsonValue(value)
+}
+
+func (l *AuditLog) redactJsonValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range typedValue {
+			if l.sensitiveFields[normalizeAuditFieldName(key)] {
+				typedValue[key] = SensitiveFieldMaskedValue
+			} else {
+				typedValue[key] = l.redactJsonValue(fieldValue)
+			}
+		}
+	case []interface{}:
+		for i, element := range typedValue {
+			typedValue[i] = l.redactJsonValue(element)
+		}
+	}
+	return value
+}
+
+func (l *AuditLog) redactValue(key string, value interface{}) interface{} {
+	if l.sensitiveFields[normalizeAuditFieldName(key)] {
+		return SensitiveFieldMaskedValue
+	}
+	return value
+}
+
+// <summary>
+// Helper function to wrap an HTTP client so that every mutating request it sends is written to the audit log
+// </summary>
+// <param name="client">HTTP client to wrap. The default HTTP client is used when nil</param>
+// <param name="service">Name of the Citrix service the client sends requests to</param>
+// <returns>HTTP client writing to the audit log</returns>
+func (l *AuditLog) WrapHttpClient(client *http.Client, service string) *http.Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	wrapped := *client
+	base := wrapped.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	wrapped.Transport = &auditTransport{base: base, auditLog: l, service: service}
+	return &wrapped
+}
+
+type auditTransport struct {
+	base     http.RoundTripper
+	auditLog *AuditLog
+	service  string
+}
+
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+
+	record := AuditRecord{
+		Timestamp:     start.UTC().Format(time.RFC3339Nano),
+		TransactionId: req.Header.Get("Citrix-TransactionId"),
+		Service:       t.service,
+		Method:        req.Method,
+		Path:          req.URL.Path,
+		DurationMs:    time.Since(start).Milliseconds(),
+		Body:          t.auditLog.RedactBody(req.Header.Get("Content-Type"), body),
+	}
+	if resp != nil {
+		record.Status = resp.StatusCode
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	t.auditLog.Write(req.Context(), record)
+
+	return resp, err
+}
+
+// readRequestBody returns a copy of the request body without consuming it
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		bodyCopy, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer bodyCopy.Close()
+		return io.ReadAll(bodyCopy)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return body, nil
+}
+
+var auditLogs sync.Map
+
+// <summary>
+// Helper function to register the audit log of a Citrix DaaS client
+// </summary>
+// <param name="client">Citrix DaaS client</param>
+// <param name="auditLog">Audit log</param>
+func SetAuditLog(client *citrixdaasclient.CitrixDaasClient, auditLog *AuditLog) {
+	auditLogs.Store(client, auditLog)
+}
+
+// <summary>
+// Helper function to write a StoreFront change to the audit log of the client.
+// StoreFront is managed through PowerShell rather than HTTP, so the record holds the Terraform operation as method and the resource type as path.
+// Meant to be deferred once the plan or state has been read, so that the outcome of the operation is recorded.
+// </summary>
+// <param name="ctx">Context</param>
+// <param name="client">Citrix DaaS client</param>
+// <param name="diagnostics">Diagnostics of the operation, used to determine whether the operation failed</param>
+// <param name="operation">Terraform operation, i.e. Create, Update or Delete</param>
+// <param name="resourceType">Terraform resource type</param>
+// <param name="model">Plan or state of the resource</param>
+// <param name="start">Start time of the operation</param>
+func AuditStoreFrontOperation(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, operation, resourceType string, model ModelWithAttributes, start time.Time) {
+	value, ok := auditLogs.Load(client)
+	if !ok {
+		return
+	}
+	auditLog := value.(*AuditLog)
+
+	record := AuditRecord{
+		Timestamp:  start.UTC().Format(time.RFC3339Nano),
+		Service:    "StoreFront",
+		Method:     operation,
+		Path:       resourceType,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if client.StorefrontClient != nil {
+		record.Path = client.StorefrontClient.GetComputerName() + "/" + resourceType
+	}
+
+	// Values of sensitive attributes are masked in the same way as in the resource logs
+	var configDiagnostics diag.Diagnostics
+	_, configValues := GetConfigValuesForSchema(ctx, &configDiagnostics, model)
+	if !configDiagnostics.HasError() {
+		record.Body = configValues
+	}
+
+	for _, d := range diagnostics.Errors() {
+		record.Error = d.Summary()
+		break
+	}
+	auditLog.Write(ctx, record)
+}
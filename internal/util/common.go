@@ -31,8 +31,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -143,6 +145,8 @@ const StorageResourceType string = "Storage"
 const NetworkResourceType string = "Network"
 const SecurityGroupResourceType = "SecurityGroup"
 const HostResourceType = "Host"
+const TemplateSpecResourceType = "TemplateSpec"
+const DiskEncryptionSetResourceType = "DiskEncryptionSet"
 
 // Azure Storage Types
 const StandardLRS = "Standard_LRS"
@@ -154,6 +158,12 @@ const AzureEphemeralOSDisk = "Azure_Ephemeral_OS_Disk"
 const WindowsClientLicenseType string = "Windows_Client"
 const WindowsServerLicenseType string = "Windows_Server"
 
+// Machine Catalog Scale-in Preferences
+const ScaleInPreferUnassigned string = "Unassigned"
+const ScaleInPreferUnregistered string = "Unregistered"
+const ScaleInPreferOldest string = "Oldest"
+const ScaleInPreferInMaintenanceMode string = "InMaintenanceMode"
+
 // GAC
 const AssignmentPriority = 0
 const GacAppName = "Workspace"
@@ -445,6 +455,97 @@ func ReadResource[ResponseType any](request any, ctx context.Context, client *ci
 	return response, httpResp, err
 }
 
+// Key of the private resource state entry used to track an in-flight Orchestration job
+const PendingAsyncJobPrivateStateKey = "pending_async_job"
+
+// Resource operations that can leave an Orchestration job pending
+const (
+	PendingAsyncJobOperationCreate = "create"
+	PendingAsyncJobOperationUpdate = "update"
+	PendingAsyncJobOperationDelete = "delete"
+)
+
+// PendingAsyncJob is an Orchestration job that was still running when the provider stopped waiting for it
+type PendingAsyncJob struct {
+	JobId         string `json:"job_id"`
+	TransactionId string `json:"transaction_id"`
+	Operation     string `json:"operation"`
+}
+
+// PrivateState is implemented by the private state data of the resource requests and responses
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// <summary>
+// Helper function to get the pending async job from the private resource state
+// </summary>
+// <param name="ctx">Context from caller</param>
+// <param name="diagnostics">Terraform diagnostics from context</param>
+// <param name="privateState">Private state from the resource request</param>
+// <returns>Pending async job, nil if there is no pending job</returns>
+func GetPendingAsyncJob(ctx context.Context, diagnostics *diag.Diagnostics, privateState PrivateState) *PendingAsyncJob {
+	value, diags := privateState.GetKey(ctx, PendingAsyncJobPrivateStateKey)
+	diagnostics.Append(diags...)
+	if len(value) == 0 {
+		return nil
+	}
+
+	var pendingJob PendingAsyncJob
+	if err := json.Unmarshal(value, &pendingJob); err != nil || pendingJob.JobId == "" {
+		tflog.Warn(ctx, "Ignoring invalid pending async job in private state: "+string(value))
+		return nil
+	}
+	return &pendingJob
+}
+
+// <summary>
+// Helper function to persist the pending async job in the private resource state
+// </summary>
+// <param name="ctx">Context from caller</param>
+// <param name="diagnostics">Terraform diagnostics from context</param>
+// <param name="privateState">Private state from the resource response</param>
+// <param name="pendingJob">Pending async job, nil to remove the pending job</param>
+func SetPendingAsyncJob(ctx context.Context, diagnostics *diag.Diagnostics, privateState PrivateState, pendingJob *PendingAsyncJob) {
+	if privateState == nil || reflect.ValueOf(privateState).IsNil() {
+		return
+	}
+
+	var value []byte
+	if pendingJob != nil {
+		var err error
+		value, err = json.Marshal(pendingJob)
+		if err != nil {
+			diagnostics.AddError("Error persisting pending job "+pendingJob.JobId, err.Error())
+			return
+		}
+	}
+
+	// An empty value removes the key from the private state
+	diagnostics.Append(privateState.SetKey(ctx, PendingAsyncJobPrivateStateKey, value)...)
+}
+
+// <summary>
+// Helper function to replace the unknown values in the resource state with null values.
+// Used when persisting the state of a resource whose creation is still in progress.
+// </summary>
+// <param name="diagnostics">Terraform diagnostics from context</param>
+// <param name="state">Resource state</param>
+func NullifyUnknownStateValues(diagnostics *diag.Diagnostics, state *tfsdk.State) {
+	raw, err := tftypes.Transform(state.Raw, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		diagnostics.AddError("Error converting unknown values in resource state", err.Error())
+		return
+	}
+	state.Raw = raw
+}
+
 // <summary>
 // Helper function to process async job response. Takes async job response and polls for result.
 // </summary>
@@ -456,6 +557,21 @@ func ReadResource[ResponseType any](request any, ctx context.Context, client *ci
 // <param name="maxTimeout">Maximum timeout threashold for job status polling</param>
 // <returns>Error if job polling failed or job itself ended in failed state</returns>
 func ProcessAsyncJobResponse(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, jobResp *http.Response, errContext string, diagnostics *diag.Diagnostics, maxTimeout int, returnJobError bool) (err error) {
+	_, err = ProcessAsyncJobResponseWithResultLocation(ctx, client, jobResp, errContext, diagnostics, maxTimeout, returnJobError)
+	return err
+}
+
+// <summary>
+// Helper function to process async job response. Takes async job response, polls for result and returns the location of the job result.
+// </summary>
+// <param name="ctx">Context from caller</param>
+// <param name="client">Citrix DaaS client from provider context</param>
+// <param name="jobResp">Job response from async API call</param>
+// <param name="errContext">Context of the job to be use as Terraform diagnostic error message title</param>
+// <param name="diagnostics">Terraform diagnostics from context</param>
+// <param name="maxTimeout">Maximum timeout threashold for job status polling</param>
+// <returns>Location of the object created by the job, which is empty if the job does not report it, and error if job polling failed or job itself ended in failed state</returns>
+func ProcessAsyncJobResponseWithResultLocation(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, jobResp *http.Response, errContext string, diagnostics *diag.Diagnostics, maxTimeout int, returnJobError bool) (string, error) {
 	txId := citrixdaasclient.GetTransactionIdFromHttpResponse(jobResp)
 
 	jobId := citrixdaasclient.GetJobIdFromHttpResponse(*jobResp)
@@ -468,41 +584,171 @@ func ProcessAsyncJobResponse(ctx context.Context, client *citrixdaasclient.Citri
 				"\nJobId: "+jobResponseModel.GetId()+
 				"\nError message: "+jobResponseModel.GetErrorString(),
 		)
-		return err
+		return "", err
+	}
+
+	err = processAsyncJobResponseModel(ctx, jobResponseModel, txId, errContext, diagnostics, maxTimeout, returnJobError)
+	return jobResponseModel.GetResultLocation(), err
+}
+
+// <summary>
+// Helper function to process async job response. Takes async job response and polls for result.
+// If the job is still running when the timeout is reached or Terraform is interrupted, the job ID is persisted in the private resource state
+// so that the provider can check on the job on the next plan or apply with ResumePendingAsyncJob.
+// </summary>
+// <param name="ctx">Context from caller</param>
+// <param name="client">Citrix DaaS client from provider context</param>
+// <param name="jobResp">Job response from async API call</param>
+// <param name="errContext">Context of the job to be use as Terraform diagnostic error message title</param>
+// <param name="diagnostics">Terraform diagnostics from context</param>
+// <param name="maxTimeout">Maximum timeout threashold for job status polling</param>
+// <param name="returnJobError">Whether to return an error if the job ended in failed state</param>
+// <param name="privateState">Private state from the resource response</param>
+// <param name="operation">Resource operation that started the job</param>
+// <returns>Whether the job is still pending, and error if job polling failed or job itself ended in failed state</returns>
+func ProcessAsyncJobResponseWithResume(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, jobResp *http.Response, errContext string, diagnostics *diag.Diagnostics, maxTimeout int, returnJobError bool, privateState PrivateState, operation string) (bool, error) {
+	pendingJob := PendingAsyncJob{
+		JobId:         citrixdaasclient.GetJobIdFromHttpResponse(*jobResp),
+		TransactionId: citrixdaasclient.GetTransactionIdFromHttpResponse(jobResp),
+		Operation:     operation,
+	}
+
+	return waitForPendingAsyncJob(ctx, client, pendingJob, errContext, diagnostics, maxTimeout, returnJobError, privateState)
+}
+
+// <summary>
+// Helper function to check on an async job persisted in the private resource state by ProcessAsyncJobResponseWithResume.
+// Intended to be called when reading the resource, so the job status is polled only once instead of waiting for the job to complete.
+// The job stays tracked until it reaches a final state. Failures of the job are reported as warnings so that they do not block the refresh of the resource.
+// </summary>
+// <param name="ctx">Context from caller</param>
+// <param name="client">Citrix DaaS client from provider context</param>
+// <param name="pendingJob">Pending job read from the private resource state</param>
+// <param name="errContext">Context of the job to be use as Terraform diagnostic warning message title</param>
+// <param name="diagnostics">Terraform diagnostics from context</param>
+// <param name="privateState">Private state from the resource response</param>
+// <returns>Whether the job is still pending, and error if the job itself ended in failed state</returns>
+func ResumePendingAsyncJob(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, pendingJob PendingAsyncJob, errContext string, diagnostics *diag.Diagnostics, privateState PrivateState) (bool, error) {
+	tflog.Info(ctx, fmt.Sprintf("Checking status of pending %s job %s", pendingJob.Operation, pendingJob.JobId))
+
+	getJobRequest := client.ApiClient.JobsAPIsDAAS.JobsGetJob(ctx, pendingJob.JobId)
+	jobResponseModel, httpResp, err := citrixdaasclient.AddRequestData(getJobRequest, client).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			// The job record no longer exists in Orchestration, so it can never be polled again
+			SetPendingAsyncJob(ctx, diagnostics, privateState, nil)
+			diagnostics.AddWarning(
+				errContext,
+				"TransactionId: "+pendingJob.TransactionId+
+					"\nJobId: "+pendingJob.JobId+
+					"\nWarning message: The job was not found and is no longer tracked.",
+			)
+			return false, nil
+		}
+
+		// Keep tracking the job, the status is polled again on the next refresh
+		diagnostics.AddWarning(
+			errContext,
+			"TransactionId: "+pendingJob.TransactionId+
+				"\nJobId: "+pendingJob.JobId+
+				"\nWarning message: Unable to get the status of the job, it will be checked again on the next plan or apply."+
+				"\nError message: "+ReadClientError(err),
+		)
+		return true, nil
+	}
+
+	jobStatus := jobResponseModel.GetStatus()
+	if jobStatus == citrixorchestration.JOBSTATUS_UNKNOWN ||
+		jobStatus == citrixorchestration.JOBSTATUS_NOT_STARTED ||
+		jobStatus == citrixorchestration.JOBSTATUS_IN_PROGRESS {
+		diagnostics.AddWarning(
+			errContext,
+			"TransactionId: "+pendingJob.TransactionId+
+				"\nJobId: "+pendingJob.JobId+
+				fmt.Sprintf("\nWarning message: Job is still %s. The resource state is refreshed once the job completes.", jobStatus),
+		)
+		return true, nil
+	}
+
+	// Job reached a final state, it no longer needs to be tracked
+	SetPendingAsyncJob(ctx, diagnostics, privateState, nil)
+
+	jobDiagnostics := diag.Diagnostics{}
+	err = processAsyncJobResponseModel(ctx, jobResponseModel, pendingJob.TransactionId, errContext, &jobDiagnostics, 0, true)
+	for _, jobDiagnostic := range jobDiagnostics {
+		diagnostics.AddWarning(jobDiagnostic.Summary(), jobDiagnostic.Detail())
+	}
+
+	return false, err
+}
+
+func waitForPendingAsyncJob(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, pendingJob PendingAsyncJob, errContext string, diagnostics *diag.Diagnostics, maxTimeout int, returnJobError bool, privateState PrivateState) (bool, error) {
+	jobResponseModel, err := client.WaitForJob(ctx, pendingJob.JobId, maxTimeout)
+	if err != nil {
+		if ctx.Err() != nil {
+			// Terraform was interrupted, keep track of the job so that it can be resumed
+			SetPendingAsyncJob(ctx, diagnostics, privateState, &pendingJob)
+			diagnostics.AddWarning(
+				errContext,
+				"TransactionId: "+pendingJob.TransactionId+
+					"\nJobId: "+pendingJob.JobId+
+					"\nWarning message: Terraform was interrupted while waiting for the job. The provider will check the status of the job on the next plan or apply.",
+			)
+			return true, nil
+		}
+
+		// Polling failed for a reason other than the job itself, keep track of the job so that it can be resumed
+		SetPendingAsyncJob(ctx, diagnostics, privateState, &pendingJob)
+		diagnostics.AddError(
+			errContext,
+			"TransactionId: "+pendingJob.TransactionId+
+				"\nJobId: "+pendingJob.JobId+
+				"\nError message: "+jobResponseModel.GetErrorString(),
+		)
+		return false, err
+	}
+
+	jobStatus := jobResponseModel.GetStatus()
+	if jobStatus == citrixorchestration.JOBSTATUS_UNKNOWN ||
+		jobStatus == citrixorchestration.JOBSTATUS_NOT_STARTED ||
+		jobStatus == citrixorchestration.JOBSTATUS_IN_PROGRESS {
+		SetPendingAsyncJob(ctx, diagnostics, privateState, &pendingJob)
+		diagnostics.AddWarning(
+			errContext,
+			"TransactionId: "+pendingJob.TransactionId+
+				"\nJobId: "+pendingJob.JobId+
+				fmt.Sprintf("\nWarning message: Job did not complete within %d minutes and is still %s. The provider will check the status of the job on the next plan or apply. Consider increasing the value in the `timeouts` attribute of the resource.", maxTimeout, jobStatus),
+		)
+		return true, nil
 	}
 
+	// Job reached a final state, it no longer needs to be tracked
+	SetPendingAsyncJob(ctx, diagnostics, privateState, nil)
+
+	return false, processAsyncJobResponseModel(ctx, jobResponseModel, pendingJob.TransactionId, errContext, diagnostics, maxTimeout, returnJobError)
+}
+
+func processAsyncJobResponseModel(ctx context.Context, jobResponseModel *citrixorchestration.JobResponseModel, txId string, errContext string, diagnostics *diag.Diagnostics, maxTimeout int, returnJobError bool) error {
 	if jobResponseModel.GetStatus() != citrixorchestration.JOBSTATUS_COMPLETE {
 		errorMessage := "TransactionId: " + txId +
 			"\nJobId: " + jobResponseModel.GetId()
 
 		if jobResponseModel.GetStatus() == citrixorchestration.JOBSTATUS_FAILED {
-			detailedErrorFound := false
 			for _, kvp := range jobResponseModel.GetErrorParameters() {
-				if kvp.GetName() == "ErrorDetails" {
-					errorDetails := kvp.GetValue()
-					tflog.Error(ctx, errContext+"\n"+errorMessage+"\nError details: "+errorDetails)
-
-					// Add additional specific error handling for Orchestration job failures here
-					if strings.Contains(errorDetails, "No Citrix Workspace Cloud Connector was found") ||
-						strings.Contains(errorDetails, "Hcl request is not allowed when connector is in outage mode") {
-						detailedErrorFound = true
-						errorMessage += "\nError Message: Ensure the Citrix Cloud Connectors in the zone are available and try again."
-					}
-					if strings.Contains(errorDetails, "Machine profile is not provided and master image has security type as trusted launch") {
-						detailedErrorFound = true
-						errorMessage += "\nError Message: Master image has security type as trusted launch, this requires machine_profile to be provided."
-					}
-					if strings.Contains(errorDetails, "The master image associated with this catalog is associated with a VM Generation that is not supported by the configured Service Offering") {
-						detailedErrorFound = true
-						errorMessage += "\nError Message: service_offering does not support the VM Generation of the master image associated with this catalog."
-					}
+				if kvp.GetName() == JobErrorDetailsParameter {
+					tflog.Error(ctx, errContext+"\n"+errorMessage+"\nError details: "+kvp.GetValue())
 					break
 				}
 			}
 
-			if !detailedErrorFound {
-				errorMessage += "\nError message: " + jobResponseModel.GetErrorString()
+			// Specific error handling for Orchestration job failures is defined in job_errors.go
+			if classification := ClassifyJobError(jobResponseModel); classification != nil {
+				errorMessage += classification.GetDiagnosticDetail()
 			}
+			errorMessage += "\nError message: " + jobResponseModel.GetErrorString()
+		} else if jobResponseModel.GetStatus() == citrixorchestration.JOBSTATUS_NOT_STARTED ||
+			jobResponseModel.GetStatus() == citrixorchestration.JOBSTATUS_IN_PROGRESS {
+			errorMessage += fmt.Sprintf("\nError message: Job did not complete within %d minutes and is still %s. Consider increasing the value in the `timeouts` attribute of the resource.", maxTimeout, jobResponseModel.GetStatus())
 		}
 
 		diagnostics.AddError(
@@ -742,6 +988,18 @@ func GetAllowedFunctionalLevelValues() []string {
 	return res
 }
 
+// <summary>
+// Helper function to get the allowed registration state values for filtering VDAs.
+// </summary>
+func GetAllowedRegistrationStateValues() []string {
+	res := []string{}
+	for _, v := range citrixorchestration.AllowedRegistrationStateEnumValues {
+		res = append(res, string(v))
+	}
+
+	return res
+}
+
 // <summary>
 // Helper function to check the version requirement for DDC.
 // </summary>
@@ -1231,3 +1489,43 @@ func PollQcsTask(ctx context.Context, client *citrixdaasclient.CitrixDaasClient,
 
 	return taskResponse, httpResp, err
 }
+
+// <summary>
+// Helper function to find the candidate closest to a value, to suggest a correction for a misspelled value.
+// The comparison is case-insensitive, and candidates that differ too much from the value are not suggested.
+// </summary>
+// <param name="value">Misspelled value</param>
+// <param name="candidates">Valid values</param>
+// <returns>The closest candidate, or empty string if no candidate is close enough</returns>
+func GetClosestMatch(value string, candidates []string) string {
+	closestMatch := ""
+	closestDistance := len(value)/3 + 1 // Do not suggest candidates requiring to edit more than a third of the value
+	for _, candidate := range candidates {
+		distance := levenshteinDistance(strings.ToLower(value), strings.ToLower(candidate))
+		if distance < closestDistance {
+			closestMatch = candidate
+			closestDistance = distance
+		}
+	}
+	return closestMatch
+}
+
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			substitutionCost := 1
+			if a[i-1] == b[j-1] {
+				substitutionCost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+substitutionCost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
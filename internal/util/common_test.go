@@ -0,0 +1,150 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type testPrivateState struct {
+	values map[string][]byte
+}
+
+func (p *testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p.values[key], nil
+}
+
+func (p *testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p.values, key)
+		return nil
+	}
+	p.values[key] = value
+	return nil
+}
+
+func TestPendingAsyncJobPrivateStateRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	privateState := &testPrivateState{values: map[string][]byte{}}
+	diagnostics := diag.Diagnostics{}
+
+	if pendingJob := GetPendingAsyncJob(ctx, &diagnostics, privateState); pendingJob != nil {
+		t.Fatalf("expected no pending job in empty private state, got %+v", *pendingJob)
+	}
+
+	expected := PendingAsyncJob{
+		JobId:         "job-id",
+		TransactionId: "transaction-id",
+		Operation:     PendingAsyncJobOperationCreate,
+	}
+	SetPendingAsyncJob(ctx, &diagnostics, privateState, &expected)
+
+	pendingJob := GetPendingAsyncJob(ctx, &diagnostics, privateState)
+	if pendingJob == nil {
+		t.Fatal("expected pending job to be read back from private state")
+	}
+	if *pendingJob != expected {
+		t.Errorf("expected pending job %+v, got %+v", expected, *pendingJob)
+	}
+
+	SetPendingAsyncJob(ctx, &diagnostics, privateState, nil)
+	if pendingJob := GetPendingAsyncJob(ctx, &diagnostics, privateState); pendingJob != nil {
+		t.Errorf("expected pending job to be removed from private state, got %+v", *pendingJob)
+	}
+
+	if diagnostics.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diagnostics)
+	}
+}
+
+func TestGetPendingAsyncJobIgnoresInvalidValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "InvalidJson", value: "not json"},
+		{name: "MissingJobId", value: `{"transaction_id":"transaction-id","operation":"create"}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			privateState := &testPrivateState{values: map[string][]byte{
+				PendingAsyncJobPrivateStateKey: []byte(test.value),
+			}}
+			diagnostics := diag.Diagnostics{}
+
+			if pendingJob := GetPendingAsyncJob(context.Background(), &diagnostics, privateState); pendingJob != nil {
+				t.Errorf("expected invalid pending job to be ignored, got %+v", *pendingJob)
+			}
+		})
+	}
+}
+
+func TestSetPendingAsyncJobWithNilPrivateState(t *testing.T) {
+	var privateState *testPrivateState
+	diagnostics := diag.Diagnostics{}
+
+	SetPendingAsyncJob(context.Background(), &diagnostics, privateState, &PendingAsyncJob{JobId: "job-id"})
+
+	if diagnostics.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diagnostics)
+	}
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "", b: "", expected: 0},
+		{a: "", b: "abc", expected: 3},
+		{a: "abc", b: "", expected: 3},
+		{a: "abc", b: "abc", expected: 0},
+		{a: "abc", b: "abd", expected: 1},
+		{a: "abc", b: "ab", expected: 1},
+		{a: "ab", b: "abc", expected: 1},
+		{a: "kitten", b: "sitting", expected: 3},
+		{a: "flaw", b: "lawn", expected: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+"_"+test.b, func(t *testing.T) {
+			if distance := levenshteinDistance(test.a, test.b); distance != test.expected {
+				t.Errorf("expected distance between %q and %q to be %d, got %d", test.a, test.b, test.expected, distance)
+			}
+		})
+	}
+}
+
+func TestGetClosestMatch(t *testing.T) {
+	candidates := []string{"Director_DismissAlerts", "Director_DiscardAlerts", "Catalog_Read", "Catalog_Update"}
+
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "ExactMatch", value: "Catalog_Read", expected: "Catalog_Read"},
+		{name: "CaseInsensitive", value: "catalog_read", expected: "Catalog_Read"},
+		{name: "Misspelled", value: "Director_DismisAlerts", expected: "Director_DismissAlerts"},
+		{name: "ClosestCandidate", value: "Catalog_Updat", expected: "Catalog_Update"},
+		{name: "TooDifferent", value: "Hypervisor_Create", expected: ""},
+		{name: "Empty", value: "", expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if match := GetClosestMatch(test.value, candidates); match != test.expected {
+				t.Errorf("expected closest match of %q to be %q, got %q", test.value, test.expected, match)
+			}
+		})
+	}
+
+	if match := GetClosestMatch("Catalog_Read", nil); match != "" {
+		t.Errorf("expected no match without candidates, got %q", match)
+	}
+}
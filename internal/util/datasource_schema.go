@@ -0,0 +1,254 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package util
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// <summary>
+// Helper function to convert the attributes of a resource schema to computed data source attributes, so that a data source can expose the same shape as the resource.
+// Sensitive attributes are omitted, and validators, defaults and plan modifiers are dropped.
+// </summary>
+// <param name="attributes">Attributes of the resource schema</param>
+// <returns>Computed data source attributes</returns>
+func ResourceAttributesToDataSourceAttributes(attributes map[string]resourceSchema.Attribute) map[string]datasourceSchema.Attribute {
+	result := map[string]datasourceSchema.Attribute{}
+	for name, attribute := range attributes {
+		if attribute.IsSensitive() {
+			continue
+		}
+		if dataSourceAttribute := resourceAttributeToDataSourceAttribute(attribute); dataSourceAttribute != nil {
+			result[name] = dataSourceAttribute
+		}
+	}
+	return result
+}
+
+func resourceAttributeToDataSourceAttribute(attribute resourceSchema.Attribute) datasourceSchema.Attribute {
+	switch a := attribute.(type) {
+	case resourceSchema.StringAttribute:
+		return datasourceSchema.StringAttribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Computed: true}
+	case resourceSchema.BoolAttribute:
+		return datasourceSchema.BoolAttribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Computed: true}
+	case resourceSchema.Int64Attribute:
+		return datasourceSchema.Int64Attribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Computed: true}
+	case resourceSchema.Int32Attribute:
+		return datasourceSchema.Int32Attribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Computed: true}
+	case resourceSchema.Float64Attribute:
+		return datasourceSchema.Float64Attribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Computed: true}
+	case resourceSchema.Float32Attribute:
+		return datasourceSchema.Float32Attribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Computed: true}
+	case resourceSchema.NumberAttribute:
+		return datasourceSchema.NumberAttribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, Computed: true}
+	case resourceSchema.ListAttribute:
+		return datasourceSchema.ListAttribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, ElementType: a.ElementType, Computed: true}
+	case resourceSchema.SetAttribute:
+		return datasourceSchema.SetAttribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, ElementType: a.ElementType, Computed: true}
+	case resourceSchema.MapAttribute:
+		return datasourceSchema.MapAttribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, ElementType: a.ElementType, Computed: true}
+	case resourceSchema.ObjectAttribute:
+		return datasourceSchema.ObjectAttribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, CustomType: a.CustomType, AttributeTypes: a.AttributeTypes, Computed: true}
+	case resourceSchema.SingleNestedAttribute:
+		return datasourceSchema.SingleNestedAttribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, Attributes: ResourceAttributesToDataSourceAttributes(a.Attributes), Computed: true}
+	case resourceSchema.ListNestedAttribute:
+		return datasourceSchema.ListNestedAttribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, NestedObject: datasourceSchema.NestedAttributeObject{Attributes: ResourceAttributesToDataSourceAttributes(a.NestedObject.Attributes)}, Computed: true}
+	case resourceSchema.SetNestedAttribute:
+		return datasourceSchema.SetNestedAttribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, NestedObject: datasourceSchema.NestedAttributeObject{Attributes: ResourceAttributesToDataSourceAttributes(a.NestedObject.Attributes)}, Computed: true}
+	case resourceSchema.MapNestedAttribute:
+		return datasourceSchema.MapNestedAttribute{Description: a.Description, MarkdownDescription: a.MarkdownDescription, NestedObject: datasourceSchema.NestedAttributeObject{Attributes: ResourceAttributesToDataSourceAttributes(a.NestedObject.Attributes)}, Computed: true}
+	}
+	return nil
+}
+
+// <summary>
+// Helper function to convert a value of a resource attribute to the type of the data source attribute created by ResourceAttributesToDataSourceAttributes, dropping the omitted nested attributes
+// </summary>
+// <param name="ctx">Context</param>
+// <param name="diagnostics">Diagnostics</param>
+// <param name="value">Value of the resource attribute</param>
+// <param name="targetType">Type of the data source attribute</param>
+// <returns>Value of the data source attribute</returns>
+func ResourceValueToDataSourceValue(ctx context.Context, diagnostics *diag.Diagnostics, value attr.Value, targetType attr.Type) attr.Value {
+	switch target := targetType.(type) {
+	case basetypes.ObjectType:
+		objectValuable, ok := value.(basetypes.ObjectValuable)
+		if !ok {
+			break
+		}
+		object, diags := objectValuable.ToObjectValue(ctx)
+		diagnostics.Append(diags...)
+		if object.IsNull() {
+			return types.ObjectNull(target.AttrTypes)
+		}
+		if object.IsUnknown() {
+			return types.ObjectUnknown(target.AttrTypes)
+		}
+		attributes := map[string]attr.Value{}
+		for name, attributeType := range target.AttrTypes {
+			attributes[name] = ResourceValueToDataSourceValue(ctx, diagnostics, object.Attributes()[name], attributeType)
+		}
+		result, diags := types.ObjectValue(target.AttrTypes, attributes)
+		diagnostics.Append(diags...)
+		return result
+	case basetypes.ListType:
+		listValuable, ok := value.(basetypes.ListValuable)
+		if !ok {
+			break
+		}
+		list, diags := listValuable.ToListValue(ctx)
+		diagnostics.Append(diags...)
+		if list.IsNull() {
+			return types.ListNull(target.ElemType)
+		}
+		if list.IsUnknown() {
+			return types.ListUnknown(target.ElemType)
+		}
+		result, diags := types.ListValue(target.ElemType, convertResourceElements(ctx, diagnostics, list.Elements(), target.ElemType))
+		diagnostics.Append(diags...)
+		return result
+	case basetypes.SetType:
+		setValuable, ok := value.(basetypes.SetValuable)
+		if !ok {
+			break
+		}
+		set, diags := setValuable.ToSetValue(ctx)
+		diagnostics.Append(diags...)
+		if set.IsNull() {
+			return types.SetNull(target.ElemType)
+		}
+		if set.IsUnknown() {
+			return types.SetUnknown(target.ElemType)
+		}
+		result, diags := types.SetValue(target.ElemType, convertResourceElements(ctx, diagnostics, set.Elements(), target.ElemType))
+		diagnostics.Append(diags...)
+		return result
+	case basetypes.MapType:
+		mapValuable, ok := value.(basetypes.MapValuable)
+		if !ok {
+			break
+		}
+		mapValue, diags := mapValuable.ToMapValue(ctx)
+		diagnostics.Append(diags...)
+		if mapValue.IsNull() {
+			return types.MapNull(target.ElemType)
+		}
+		if mapValue.IsUnknown() {
+			return types.MapUnknown(target.ElemType)
+		}
+		elements := map[string]attr.Value{}
+		for key, element := range mapValue.Elements() {
+			elements[key] = ResourceValueToDataSourceValue(ctx, diagnostics, element, target.ElemType)
+		}
+		result, diags := types.MapValue(target.ElemType, elements)
+		diagnostics.Append(diags...)
+		return result
+	default:
+		if value == nil {
+			break
+		}
+		return value
+	}
+
+	diagnostics.AddError("Error converting resource value", fmt.Sprintf("Value %v cannot be converted to %s", value, targetType.String()))
+	return nil
+}
+
+func convertResourceElements(ctx context.Context, diagnostics *diag.Diagnostics, elements []attr.Value, targetType attr.Type) []attr.Value {
+	result := []attr.Value{}
+	for _, element := range elements {
+		result = append(result, ResourceValueToDataSourceValue(ctx, diagnostics, element, targetType))
+	}
+	return result
+}
+
+// <summary>
+// Helper function to create an empty resource model in the same way as an imported resource, where only the key attributes are set.
+// Used to refresh a resource model for a data source, so that the data source has the same values as the resource.
+// </summary>
+// <param name="ctx">Context</param>
+// <param name="diagnostics">Diagnostics</param>
+// <param name="schema">Schema of the resource</param>
+// <param name="keyAttributes">Values of the key attributes of the resource, keyed by attribute name</param>
+// <returns>Resource model with only the key attributes set</returns>
+func NewImportedResourceModel[modelType any](ctx context.Context, diagnostics *diag.Diagnostics, schema resourceSchema.Schema, keyAttributes map[string]any) modelType {
+	var model modelType
+	state := tfsdk.State{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+	}
+	for name, value := range keyAttributes {
+		diagnostics.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	if diagnostics.HasError() {
+		return model
+	}
+	diagnostics.Append(state.Get(ctx, &model)...)
+	return model
+}
+
+// <summary>
+// Helper function to create a known object whose attributes are all unknown.
+// Used to seed a resource model before refreshing it for a data source, since resource refresh functions only refresh the nested attributes which are not null in state.
+// </summary>
+// <param name="ctx">Context</param>
+// <param name="diagnostics">Diagnostics</param>
+// <param name="attributeTypes">Attribute types of the object</param>
+// <returns>Object with unknown attributes</returns>
+func ObjectWithUnknownAttributes(ctx context.Context, diagnostics *diag.Diagnostics, attributeTypes map[string]attr.Type) types.Object {
+	attributes := map[string]attr.Value{}
+	for name, attributeType := range attributeTypes {
+		value, err := attributeType.ValueFromTerraform(ctx, tftypes.NewValue(attributeType.TerraformType(ctx), tftypes.UnknownValue))
+		if err != nil {
+			diagnostics.AddError("Error creating unknown value", err.Error())
+			return types.ObjectUnknown(attributeTypes)
+		}
+		attributes[name] = value
+	}
+	object, diags := types.ObjectValue(attributeTypes, attributes)
+	diagnostics.Append(diags...)
+	return object
+}
+
+// <summary>
+// Helper function to replace the unknown values nested in a value with null values, since a data source cannot save unknown values into state.
+// Used after refreshing a resource model seeded with ObjectWithUnknownAttributes, for the attributes which are not returned by the remote.
+// </summary>
+// <param name="ctx">Context</param>
+// <param name="diagnostics">Diagnostics</param>
+// <param name="value">Value which may contain unknown values</param>
+// <returns>Value with the unknown values replaced by null values</returns>
+func UnknownValuesToNull(ctx context.Context, diagnostics *diag.Diagnostics, value attr.Value) attr.Value {
+	terraformValue, err := value.ToTerraformValue(ctx)
+	if err != nil {
+		diagnostics.AddError("Error converting value", err.Error())
+		return value
+	}
+	terraformValue, err = tftypes.Transform(terraformValue, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		diagnostics.AddError("Error converting value", err.Error())
+		return value
+	}
+	result, err := value.Type(ctx).ValueFromTerraform(ctx, terraformValue)
+	if err != nil {
+		diagnostics.AddError("Error converting value", err.Error())
+		return value
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testImportedResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Settings    types.Object `tfsdk:"settings"`
+}
+
+func TestNewImportedResourceModel(t *testing.T) {
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Computed: true},
+			"name":        schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true},
+			"settings": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{Optional: true},
+				},
+			},
+		},
+	}
+	diagnostics := diag.Diagnostics{}
+
+	model := NewImportedResourceModel[testImportedResourceModel](context.Background(), &diagnostics, resourceSchema, map[string]any{
+		"id":   "resource-id",
+		"name": "resource-name",
+	})
+
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+	if model.Id.ValueString() != "resource-id" {
+		t.Errorf("expected id %q, got %q", "resource-id", model.Id.ValueString())
+	}
+	if model.Name.ValueString() != "resource-name" {
+		t.Errorf("expected name %q, got %q", "resource-name", model.Name.ValueString())
+	}
+	if !model.Description.IsNull() {
+		t.Errorf("expected description to be null, got %s", model.Description)
+	}
+	if !model.Settings.IsNull() {
+		t.Errorf("expected settings to be null, got %s", model.Settings)
+	}
+}
+
+func TestNewImportedResourceModelWithUnknownAttribute(t *testing.T) {
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true},
+		},
+	}
+	diagnostics := diag.Diagnostics{}
+
+	NewImportedResourceModel[testImportedResourceModel](context.Background(), &diagnostics, resourceSchema, map[string]any{
+		"name": "resource-name",
+	})
+
+	if !diagnostics.HasError() {
+		t.Error("expected an error for a key attribute which is not in the schema")
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package util
+
+import (
+	"slices"
+	"strings"
+
+	citrixorchestration "github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+)
+
+// Name of the job error parameter containing the detailed failure reason
+const JobErrorDetailsParameter = "ErrorDetails"
+
+// JobErrorClassification maps a failed Orchestration job to an actionable diagnostic
+type JobErrorClassification struct {
+	// Stable error code reported in the diagnostic, can be used by automation to branch on the failure class
+	Code string
+	// Job types the classification applies to. Applies to all job types when empty.
+	JobTypes []citrixorchestration.JobType
+	// Job error codes matching the classification
+	ErrorCodes []citrixorchestration.JobErrorCode
+	// Substrings of job error parameter values matching the classification, keyed by error parameter name
+	ErrorParameters map[string][]string
+	// Description of the failure
+	Message string
+	// Hint on how to resolve the failure
+	Remediation string
+}
+
+// Known job error classifications. Classifications matching on error parameters take precedence over the ones matching on error codes.
+// The list is only read at runtime, new classifications are added here.
+var jobErrorClassifications = []JobErrorClassification{
+	{
+		Code: "JOB_CONNECTOR_UNAVAILABLE",
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_NO_WORKSPACE_CLOUD_CONNECTOR_FOUND,
+			citrixorchestration.JOBERRORCODE_WORKSPACE_CLOUD_CONNECTOR_TIMEOUT,
+			citrixorchestration.JOBERRORCODE_WORKSPACE_CLOUD_CONNECTOR_ENDPOINT_NOT_FOUND,
+		},
+		ErrorParameters: map[string][]string{
+			JobErrorDetailsParameter: {
+				"No Citrix Workspace Cloud Connector was found",
+				"Hcl request is not allowed when connector is in outage mode",
+			},
+		},
+		Message:     "The Citrix Cloud Connectors in the zone could not be reached.",
+		Remediation: "Ensure the Citrix Cloud Connectors in the zone are available and try again.",
+	},
+	{
+		Code: "JOB_TRUSTED_LAUNCH_REQUIRES_MACHINE_PROFILE",
+		ErrorParameters: map[string][]string{
+			JobErrorDetailsParameter: {"Machine profile is not provided and master image has security type as trusted launch"},
+		},
+		Message:     "Master image has security type as trusted launch, this requires machine_profile to be provided.",
+		Remediation: "Set `machine_profile` in the `azure_machine_config` of the machine catalog.",
+	},
+	{
+		Code: "JOB_VM_GENERATION_NOT_SUPPORTED",
+		ErrorParameters: map[string][]string{
+			JobErrorDetailsParameter: {"The master image associated with this catalog is associated with a VM Generation that is not supported by the configured Service Offering"},
+		},
+		Message:     "service_offering does not support the VM Generation of the master image associated with this catalog.",
+		Remediation: "Choose a `service_offering` that supports the VM Generation of the master image, or use a master image of a supported VM Generation.",
+	},
+	{
+		Code: "JOB_PERMISSION_DENIED",
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_ACCESS_DENIED,
+			citrixorchestration.JOBERRORCODE_PERMISSION_DENIED,
+			citrixorchestration.JOBERRORCODE_USER_NOT_AUTHORIZED,
+			citrixorchestration.JOBERRORCODE_AUTHORIZATION_FAILED,
+			citrixorchestration.JOBERRORCODE_AUTHORIZATION_ERROR,
+		},
+		Message:     "The administrator used by the provider is not allowed to perform the operation.",
+		Remediation: "Grant the administrator a role with the required permissions on the affected scopes and try again.",
+	},
+	{
+		Code: "JOB_NAME_CONFLICT",
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_DUPLICATE_OBJECT,
+			citrixorchestration.JOBERRORCODE_HOSTING_UNIT_DUPLICATE_OBJECT_EXISTS,
+			citrixorchestration.JOBERRORCODE_PROVISIONING_SCHEME_NAME_ALREADY_EXISTS,
+			citrixorchestration.JOBERRORCODE_IDENTITY_POOL_DUPLICATE_OBJECT_EXISTS,
+		},
+		Message:     "An object with the same name already exists.",
+		Remediation: "Choose a different name, or import the existing object into the Terraform state.",
+	},
+	{
+		Code: "JOB_OBJECT_IN_USE",
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_CATALOG_IN_USE,
+			citrixorchestration.JOBERRORCODE_MACHINE_IN_USE,
+			citrixorchestration.JOBERRORCODE_DESKTOP_GROUP_IN_USE,
+			citrixorchestration.JOBERRORCODE_TASK_ACTIVE,
+		},
+		Message:     "The object is in use by another object or operation.",
+		Remediation: "Remove the dependent objects or wait for the running operation to complete, then try again.",
+	},
+	{
+		Code: "JOB_MACHINE_NAMING_SCHEME_INVALID",
+		JobTypes: []citrixorchestration.JobType{
+			citrixorchestration.JOBTYPE_CREATE_MACHINE_CATALOG,
+			citrixorchestration.JOBTYPE_UPDATE_MACHINE_CATALOG,
+			citrixorchestration.JOBTYPE_ADD_MACHINE_CATALOG_MACHINE,
+		},
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_NAMING_SCHEME_HAS_MORE_THAN_ONE_SET_OF_HASHES,
+			citrixorchestration.JOBERRORCODE_NAMING_SCHEME_ILLEGAL_CHARACTER,
+			citrixorchestration.JOBERRORCODE_NAMING_SCHEME_ILLEGAL_COMPUTER_NAME,
+			citrixorchestration.JOBERRORCODE_NAMING_SCHEME_MAY_NOT_BE_ALL_NUMBERS,
+			citrixorchestration.JOBERRORCODE_NAMING_SCHEME_MAY_NOT_START_WITH_PERIOD,
+			citrixorchestration.JOBERRORCODE_NAMING_SCHEME_MISSING_NUMERIC_SPECIFICATIONS,
+			citrixorchestration.JOBERRORCODE_NAMING_SCHEME_NOT_ENOUGH_CHARACTERS,
+			citrixorchestration.JOBERRORCODE_NAMING_SCHEME_TOO_MANY_CHARACTERS,
+			citrixorchestration.JOBERRORCODE_NAMING_SCHEME_UNICODE_CHARACTERS_NOT_ENABLED,
+		},
+		Message:     "The machine naming scheme is invalid.",
+		Remediation: "Update `machine_account_creation_rules.naming_scheme` of the machine catalog.",
+	},
+	{
+		Code: "JOB_DOMAIN_OU_INVALID",
+		JobTypes: []citrixorchestration.JobType{
+			citrixorchestration.JOBTYPE_CREATE_MACHINE_CATALOG,
+			citrixorchestration.JOBTYPE_UPDATE_MACHINE_CATALOG,
+			citrixorchestration.JOBTYPE_ADD_MACHINE_CATALOG_MACHINE,
+		},
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_IDENTITY_POOL_OU_INVALID,
+			citrixorchestration.JOBERRORCODE_IDENTITY_POOL_OUOF_WRONG_DOMAIN,
+		},
+		Message:     "The organizational unit for the machine accounts is invalid.",
+		Remediation: "Ensure `machine_domain_identity.domain_ou` exists in the domain configured for the machine catalog.",
+	},
+	{
+		Code: "JOB_HYPERVISOR_INVALID_CREDENTIALS",
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_CONNECTION_INVALID_CREDENTIALS,
+			citrixorchestration.JOBERRORCODE_CONNECTION_EXPIRED_CREDENTIALS,
+		},
+		Message:     "The credentials of the hypervisor connection are invalid or expired.",
+		Remediation: "Update the credentials of the hypervisor connection and try again.",
+	},
+	{
+		Code: "JOB_HYPERVISOR_PERMISSION_DENIED",
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_HYPERVISOR_PERMISSION_DENIED,
+		},
+		Message:     "The account of the hypervisor connection does not have the required permissions on the hypervisor.",
+		Remediation: "Grant the required permissions to the account used by the hypervisor connection.",
+	},
+	{
+		Code: "JOB_HYPERVISOR_SSL_FAILURE",
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_V_CENTER_CONNECTION_SSL_FAILURE,
+			citrixorchestration.JOBERRORCODE_XEN_SERVER_CONNECTION_SSL_FAILURE,
+			citrixorchestration.JOBERRORCODE_V_CENTER_HTTPS_CONNECTION_REQUIRED_FOR_ENDPOINT,
+		},
+		Message:     "A secure connection to the hypervisor could not be established.",
+		Remediation: "Use an HTTPS address for the hypervisor and trust its certificate, for example with `ssl_thumbprints`.",
+	},
+	{
+		Code: "JOB_HYPERVISOR_UNREACHABLE",
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_HYPERVISOR_NOT_CONTACTABLE,
+			citrixorchestration.JOBERRORCODE_NAME_RESOLUTION_FAILURE,
+			citrixorchestration.JOBERRORCODE_HYPERVISOR_ADDRESS_INVALID_FORMAT,
+		},
+		Message:     "The hypervisor could not be reached.",
+		Remediation: "Verify the hypervisor address and that it can be reached from the Delivery Controllers or Cloud Connectors.",
+	},
+	{
+		Code: "JOB_RESOURCE_POOL_INVALID_RESOURCE",
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_HOSTING_UNIT_NETWORK_PATH_INVALID,
+			citrixorchestration.JOBERRORCODE_HOSTING_UNIT_ROOT_PATH_INVALID,
+			citrixorchestration.JOBERRORCODE_HOSTING_UNIT_STORAGE_PATH_INVALID,
+			citrixorchestration.JOBERRORCODE_INVALID_HYPERVISOR_ITEM,
+			citrixorchestration.JOBERRORCODE_HYPERVISOR_CONNECTION_OBJECT_NOT_FOUND,
+		},
+		Message:     "A resource referenced by the resource pool could not be found on the hypervisor.",
+		Remediation: "Verify the region, networks and storage of the resource pool exist on the hypervisor.",
+	},
+	{
+		Code: "JOB_HYPERVISOR_IN_MAINTENANCE_MODE",
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_HYPERVISOR_IN_MAINTENANCE_MODE,
+		},
+		Message:     "The hypervisor connection is in maintenance mode.",
+		Remediation: "Turn off maintenance mode on the hypervisor connection and try again.",
+	},
+	{
+		Code: "JOB_STOREFRONT_SERVER_INVALID",
+		JobTypes: []citrixorchestration.JobType{
+			citrixorchestration.JOBTYPE_CREATE_STORE_FRONT_SERVER,
+			citrixorchestration.JOBTYPE_UPDATE_STORE_FRONT_SERVER,
+			citrixorchestration.JOBTYPE_DELETE_STORE_FRONT_SERVER,
+		},
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_INVALID_ARGUMENT,
+			citrixorchestration.JOBERRORCODE_OBJECT_NOT_FOUND,
+		},
+		Message:     "The StoreFront server configuration is invalid.",
+		Remediation: "Verify the `url` of the StoreFront server and that the server is not referenced by a delivery group when it is being deleted.",
+	},
+	{
+		Code: "JOB_COMMUNICATION_ERROR",
+		ErrorCodes: []citrixorchestration.JobErrorCode{
+			citrixorchestration.JOBERRORCODE_COMMUNICATION_ERROR,
+			citrixorchestration.JOBERRORCODE_COMMUNICATIONS_ERROR,
+			citrixorchestration.JOBERRORCODE_SERVER_TIMEOUT,
+			citrixorchestration.JOBERRORCODE_NO_SUITABLE_SERVICE_INSTANCE_ERROR_ID,
+		},
+		Message:     "A transient communication error occurred while running the job.",
+		Remediation: "Try the operation again.",
+	},
+}
+
+// <summary>
+// Helper function to classify a failed Orchestration job.
+// The job is matched against the known classifications, including its failed sub-jobs.
+// </summary>
+// <param name="job">Failed job</param>
+// <returns>Matching classification, nil if the failure is not classified</returns>
+func ClassifyJobError(job *citrixorchestration.JobResponseModel) *JobErrorClassification {
+	if job == nil {
+		return nil
+	}
+
+	// Classifications on error parameters are more specific than the ones on error codes
+	for _, classification := range jobErrorClassifications {
+		if classification.appliesToJobType(job.GetType()) && classification.matchesErrorParameters(job.GetErrorParameters()) {
+			return &classification
+		}
+	}
+	for _, classification := range jobErrorClassifications {
+		if classification.appliesToJobType(job.GetType()) && job.ErrorCode != nil && slices.Contains(classification.ErrorCodes, job.GetErrorCode()) {
+			return &classification
+		}
+	}
+
+	for _, subJob := range job.GetSubJobs() {
+		if subJob.GetStatus() != citrixorchestration.JOBSTATUS_FAILED {
+			continue
+		}
+		if classification := ClassifyJobError(&subJob); classification != nil {
+			return classification
+		}
+	}
+
+	return nil
+}
+
+// <summary>
+// Helper function to format the diagnostic detail for a classified job failure
+// </summary>
+// <param name="classification">Job error classification</param>
+// <returns>Diagnostic detail with the stable error code, the failure description and the remediation hint</returns>
+func (classification JobErrorClassification) GetDiagnosticDetail() string {
+	return "\nError code: " + classification.Code +
+		"\nError description: " + classification.Message +
+		"\nRemediation: " + classification.Remediation
+}
+
+func (classification JobErrorClassification) appliesToJobType(jobType citrixorchestration.JobType) bool {
+	return len(classification.JobTypes) == 0 || slices.Contains(classification.JobTypes, jobType)
+}
+
+func (classification JobErrorClassification) matchesErrorParameters(errorParameters []citrixorchestration.NameValueStringPairModel) bool {
+	for _, errorParameter := range errorParameters {
+		for _, substring := range classification.ErrorParameters[errorParameter.GetName()] {
+			if strings.Contains(errorParameter.GetValue(), substring) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package util
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	citrixorchestration "github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func newTestFailedJob(jobType citrixorchestration.JobType, errorCode *citrixorchestration.JobErrorCode, errorDetails string) *citrixorchestration.JobResponseModel {
+	job := citrixorchestration.JobResponseModel{
+		Id:     "job-id",
+		Type:   jobType,
+		Status: citrixorchestration.JOBSTATUS_FAILED,
+	}
+	job.SetErrorString("job failed")
+	job.ErrorCode = errorCode
+	if errorDetails != "" {
+		errorParameter := citrixorchestration.NameValueStringPairModel{}
+		errorParameter.SetName(JobErrorDetailsParameter)
+		errorParameter.SetValue(errorDetails)
+		job.ErrorParameters = []citrixorchestration.NameValueStringPairModel{errorParameter}
+	}
+	return &job
+}
+
+func TestClassifyJobError(t *testing.T) {
+	tests := []struct {
+		name         string
+		job          *citrixorchestration.JobResponseModel
+		expectedCode string
+	}{
+		{
+			name: "NilJob",
+			job:  nil,
+		},
+		{
+			name: "UnclassifiedErrorCode",
+			job:  newTestFailedJob(citrixorchestration.JOBTYPE_CREATE_MACHINE_CATALOG, citrixorchestration.JOBERRORCODE_UNKNOWN_ERROR.Ptr(), ""),
+		},
+		{
+			name:         "ErrorCode",
+			job:          newTestFailedJob(citrixorchestration.JOBTYPE_DELETE_MACHINE_CATALOG, citrixorchestration.JOBERRORCODE_PERMISSION_DENIED.Ptr(), ""),
+			expectedCode: "JOB_PERMISSION_DENIED",
+		},
+		{
+			name:         "ErrorParameter",
+			job:          newTestFailedJob(citrixorchestration.JOBTYPE_CREATE_MACHINE_CATALOG, nil, "Error: No Citrix Workspace Cloud Connector was found in zone"),
+			expectedCode: "JOB_CONNECTOR_UNAVAILABLE",
+		},
+		{
+			name:         "ErrorParameterTakesPrecedenceOverErrorCode",
+			job:          newTestFailedJob(citrixorchestration.JOBTYPE_CREATE_MACHINE_CATALOG, citrixorchestration.JOBERRORCODE_PERMISSION_DENIED.Ptr(), "Machine profile is not provided and master image has security type as trusted launch"),
+			expectedCode: "JOB_TRUSTED_LAUNCH_REQUIRES_MACHINE_PROFILE",
+		},
+		{
+			name:         "JobTypeMatches",
+			job:          newTestFailedJob(citrixorchestration.JOBTYPE_CREATE_MACHINE_CATALOG, citrixorchestration.JOBERRORCODE_NAMING_SCHEME_ILLEGAL_CHARACTER.Ptr(), ""),
+			expectedCode: "JOB_MACHINE_NAMING_SCHEME_INVALID",
+		},
+		{
+			name: "JobTypeDoesNotMatch",
+			job:  newTestFailedJob(citrixorchestration.JOBTYPE_DELETE_MACHINE_CATALOG, citrixorchestration.JOBERRORCODE_NAMING_SCHEME_ILLEGAL_CHARACTER.Ptr(), ""),
+		},
+		{
+			name: "FailedSubJob",
+			job: func() *citrixorchestration.JobResponseModel {
+				job := newTestFailedJob(citrixorchestration.JOBTYPE_CREATE_MACHINE_CATALOG, nil, "")
+				job.SubJobs = []citrixorchestration.JobResponseModel{
+					*newTestFailedJob(citrixorchestration.JOBTYPE_CREATE_MACHINE_CATALOG, citrixorchestration.JOBERRORCODE_HYPERVISOR_NOT_CONTACTABLE.Ptr(), ""),
+				}
+				return job
+			}(),
+			expectedCode: "JOB_HYPERVISOR_UNREACHABLE",
+		},
+		{
+			name: "CompletedSubJobIsIgnored",
+			job: func() *citrixorchestration.JobResponseModel {
+				job := newTestFailedJob(citrixorchestration.JOBTYPE_CREATE_MACHINE_CATALOG, nil, "")
+				subJob := newTestFailedJob(citrixorchestration.JOBTYPE_CREATE_MACHINE_CATALOG, citrixorchestration.JOBERRORCODE_HYPERVISOR_NOT_CONTACTABLE.Ptr(), "")
+				subJob.Status = citrixorchestration.JOBSTATUS_COMPLETE
+				job.SubJobs = []citrixorchestration.JobResponseModel{*subJob}
+				return job
+			}(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			classification := ClassifyJobError(test.job)
+			if test.expectedCode == "" {
+				if classification != nil {
+					t.Errorf("expected job error to be unclassified, got %s", classification.Code)
+				}
+				return
+			}
+
+			if classification == nil {
+				t.Fatalf("expected job error classification %s, got none", test.expectedCode)
+			}
+			if classification.Code != test.expectedCode {
+				t.Errorf("expected job error classification %s, got %s", test.expectedCode, classification.Code)
+			}
+		})
+	}
+}
+
+func TestProcessAsyncJobResponseModelKeepsJobErrorString(t *testing.T) {
+	tests := []struct {
+		name     string
+		job      *citrixorchestration.JobResponseModel
+		expected []string
+	}{
+		{
+			name:     "Unclassified",
+			job:      newTestFailedJob(citrixorchestration.JOBTYPE_CREATE_MACHINE_CATALOG, nil, ""),
+			expected: []string{"\nError message: job failed"},
+		},
+		{
+			name: "Classified",
+			job:  newTestFailedJob(citrixorchestration.JOBTYPE_DELETE_MACHINE_CATALOG, citrixorchestration.JOBERRORCODE_PERMISSION_DENIED.Ptr(), ""),
+			expected: []string{
+				"\nError code: JOB_PERMISSION_DENIED",
+				"\nRemediation: ",
+				"\nError message: job failed",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diagnostics := diag.Diagnostics{}
+			err := processAsyncJobResponseModel(context.Background(), test.job, "transaction-id", "Error", &diagnostics, 10, true)
+			if err == nil {
+				t.Fatal("expected an error for the failed job")
+			}
+			if diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("expected 1 error diagnostic, got %d", diagnostics.ErrorsCount())
+			}
+
+			detail := diagnostics.Errors()[0].Detail()
+			for _, expected := range test.expected {
+				if !strings.Contains(detail, expected) {
+					t.Errorf("expected diagnostic detail to contain %q, got %q", expected, detail)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package util
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Number of objects requested per page by the list data sources
+const ListDataSourcePageSize int32 = 1000
+
+// <summary>
+// Helper function to get the schema of the name_regex filter of the list data sources
+// </summary>
+// <param name="objectType">Plural name of the listed objects, e.g. machine catalogs</param>
+// <returns>Schema of the name_regex attribute</returns>
+func GetNameRegexFilterDataSourceSchema(objectType string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Only return the %s whose name matches this regular expression, e.g. `^prod-`. Matching is case-sensitive unless the expression starts with `(?i)`.", objectType),
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+// <summary>
+// Helper function to get the schema of the folder path filter of the list data sources
+// </summary>
+// <param name="objectType">Plural name of the listed objects, e.g. machine catalogs</param>
+// <param name="folderType">Type of the folder, i.e. admin folder or application folder</param>
+// <returns>Schema of the folder path attribute</returns>
+func GetFolderPathFilterDataSourceSchema(objectType string, folderType string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Only return the %s directly in the %s with this path, e.g. `Production\\Europe`.", objectType, folderType),
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+// <summary>
+// Helper function to get the schema of the scope filter of the list data sources
+// </summary>
+// <param name="objectType">Plural name of the listed objects, e.g. machine catalogs</param>
+// <returns>Schema of the scope attribute</returns>
+func GetScopeFilterDataSourceSchema(objectType string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Only return the %s in the delegated admin scope with this name or ID.", objectType),
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+// <summary>
+// Helper function to get the schema of the tag filter of the list data sources
+// </summary>
+// <param name="objectType">Plural name of the listed objects, e.g. machine catalogs</param>
+// <returns>Schema of the tag attribute</returns>
+func GetTagFilterDataSourceSchema(objectType string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Only return the %s with the tag with this name or ID.", objectType),
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+// ListFilter holds the client-side filters of the list data sources. Empty filters match every object.
+type ListFilter struct {
+	nameRegex  *regexp.Regexp
+	folderPath string
+	scope      string
+}
+
+// <summary>
+// Helper function to create the client-side filters of a list data source
+// </summary>
+// <param name="nameRegex">Regular expression the object names must match</param>
+// <param name="folderPath">Path of the admin or application folder the objects must be in</param>
+// <param name="scope">Name or ID of the delegated admin scope the objects must be in</param>
+// <returns>List filter, and error if the regular expression is invalid</returns>
+func NewListFilter(nameRegex, folderPath, scope string) (*ListFilter, error) {
+	filter := &ListFilter{
+		folderPath: NormalizeFolderPath(folderPath),
+		scope:      scope,
+	}
+	if nameRegex != "" {
+		regex, err := regexp.Compile(nameRegex)
+		if err != nil {
+			return nil, fmt.Errorf("name_regex `%s` is not a valid regular expression: %s", nameRegex, err.Error())
+		}
+		filter.nameRegex = regex
+	}
+	return filter, nil
+}
+
+// MatchesName returns whether the name matches the name_regex filter
+func (f *ListFilter) MatchesName(name string) bool {
+	return f.nameRegex == nil || f.nameRegex.MatchString(name)
+}
+
+// MatchesFolder returns whether the folder returned by Orchestration matches the folder path filter
+func (f *ListFilter) MatchesFolder(folder citrixorchestration.RefResponseModel) bool {
+	if f.folderPath == "" {
+		return true
+	}
+	return strings.EqualFold(NormalizeFolderPath(folder.GetName()), f.folderPath)
+}
+
+// MatchesScopes returns whether one of the scopes returned by Orchestration matches the scope filter by name or ID
+func (f *ListFilter) MatchesScopes(scopes []citrixorchestration.ScopeResponseModel) bool {
+	if f.scope == "" {
+		return true
+	}
+	for _, scope := range scopes {
+		if strings.EqualFold(scope.GetId(), f.scope) || strings.EqualFold(scope.GetName(), f.scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesScopeNamesOrIds returns whether one of the given scope names or IDs matches the scope filter
+func (f *ListFilter) MatchesScopeNamesOrIds(scopeNamesOrIds ...[]string) bool {
+	if f.scope == "" {
+		return true
+	}
+	for _, scopes := range scopeNamesOrIds {
+		for _, scope := range scopes {
+			if strings.EqualFold(scope, f.scope) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// <summary>
+// Helper function to normalize an admin or application folder path, so that paths with and without leading or trailing backslashes compare equal
+// </summary>
+// <param name="folderPath">Folder path</param>
+// <returns>Folder path without leading or trailing backslashes</returns>
+func NormalizeFolderPath(folderPath string) string {
+	return strings.Trim(strings.TrimSpace(folderPath), "\\")
+}
+
+// PagedCollection is a page of objects returned by an Orchestration list API
+type PagedCollection[T any] interface {
+	GetItems() []T
+	GetContinuationToken() string
+}
+
+// <summary>
+// Helper function to get all the pages of an Orchestration list API
+// </summary>
+// <param name="diagnostics">Diagnostics</param>
+// <param name="errorSummary">Summary of the error added to the diagnostics when a page cannot be read</param>
+// <param name="getPage">Function reading the page with the given continuation token, which is empty for the first page</param>
+// <returns>All the objects, and error if any page cannot be read</returns>
+func GetAllPages[T any, C PagedCollection[T]](diagnostics *diag.Diagnostics, errorSummary string, getPage func(continuationToken string) (C, *http.Response, error)) ([]T, error) {
+	items := []T{}
+	continuationToken := ""
+	for {
+		page, httpResp, err := getPage(continuationToken)
+		if err != nil {
+			diagnostics.AddError(
+				errorSummary,
+				"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+					"\nError message: "+ReadClientError(err),
+			)
+			return nil, err
+		}
+		items = append(items, page.GetItems()...)
+
+		continuationToken = page.GetContinuationToken()
+		if continuationToken == "" {
+			return items, nil
+		}
+	}
+}
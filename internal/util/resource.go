@@ -60,18 +60,22 @@ func GetMachineCatalog(ctx context.Context, client *citrixdaasclient.CitrixDaasC
 	return catalog, err
 }
 
+// Gets all pages of machines of the machine catalog and logs any errors
 func GetMachineCatalogMachines(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, machineCatalogId string) (*citrixorchestration.MachineResponseModelCollection, error) {
-	getMachineCatalogMachinesRequest := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalogMachines(ctx, machineCatalogId).Fields("Id,Name,Hosting,DeliveryGroup")
-	machines, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.MachineResponseModelCollection](getMachineCatalogMachinesRequest, client)
+	getMachineCatalogMachinesRequest := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalogMachines(ctx, machineCatalogId).Fields("Id,Uid,Name,Hosting,MachineCatalog,DeliveryGroup,InMaintenanceMode,PowerState,Tags,SessionCount,RegistrationState,LastDeregistrationTime,LastDeregistrationReason,AssignedUsers,AgentVersion,OSType,IPAddress").Limit(ListDataSourcePageSize)
+	machines, err := GetAllPages[citrixorchestration.MachineResponseModel](diagnostics, "Error reading Machines for Machine Catalog "+machineCatalogId, func(continuationToken string) (*citrixorchestration.MachineResponseModelCollection, *http.Response, error) {
+		if continuationToken != "" {
+			getMachineCatalogMachinesRequest = getMachineCatalogMachinesRequest.ContinuationToken(continuationToken)
+		}
+		return citrixdaasclient.ExecuteWithRetry[*citrixorchestration.MachineResponseModelCollection](getMachineCatalogMachinesRequest, client)
+	})
 	if err != nil {
-		diagnostics.AddError(
-			"Error reading Machines for Machine Catalog "+machineCatalogId,
-			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
-				"\nError message: "+ReadClientError(err),
-		)
+		return nil, err
 	}
 
-	return machines, err
+	machineCollection := citrixorchestration.MachineResponseModelCollection{}
+	machineCollection.SetItems(machines)
+	return &machineCollection, nil
 }
 
 func GetSingleResourceFromHypervisor(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, hypervisorName, hypervisorPoolName, folderPath, resourceName, resourceType, resourceGroupName string) (*citrixorchestration.HypervisorResourceResponseModel, *http.Response, error) {
@@ -219,11 +223,7 @@ func GetFilteredResourcePathList(ctx context.Context, client *citrixdaasclient.C
 	req := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsGetHypervisorAllResources(ctx, hypervisorId)
 	req = req.Children(1)
 	req = req.Path(folderPath)
-	// Skip resource type filter for on-prem hypervisors to avoid server side filtering timeout
-	if connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM &&
-		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER &&
-		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER &&
-		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_SCVMM {
+	if isServerSideResourceTypeFilterSupported(connectionType) {
 		req = req.Type_([]string{resourceType})
 	}
 
@@ -283,6 +283,82 @@ func GetFilteredResourcePathList(ctx context.Context, client *citrixdaasclient.C
 	return result, nil
 }
 
+// Skip resource type filter for on-prem hypervisors to avoid server side filtering timeout
+func isServerSideResourceTypeFilterSupported(connectionType citrixorchestration.HypervisorConnectionType) bool {
+	return connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM &&
+		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER &&
+		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER &&
+		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_SCVMM
+}
+
+// <summary>
+// Helper function to list the resources in a folder of the hypervisor inventory, optionally filtered by resource type.
+// Template specs and disk encryption sets are not filtered on the server side and are matched by the extension of their path instead.
+// </summary>
+// <param name="ctx">Context</param>
+// <param name="client">Citrix DaaS client</param>
+// <param name="hypervisor">Hypervisor whose inventory is listed</param>
+// <param name="hypervisorPoolName">Name or Id of the resource pool to list the resources of. When empty, the resources of the whole hypervisor are listed</param>
+// <param name="folderPath">Path of the folder to list. When empty, the root of the inventory is listed</param>
+// <param name="resourceType">Type of the resources to return. When empty, resources of all types are returned</param>
+// <returns>Resources in the folder, the http response and the error if any</returns>
+func GetHypervisorResources(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, hypervisor *citrixorchestration.HypervisorDetailResponseModel, hypervisorPoolName, folderPath, resourceType string) ([]citrixorchestration.HypervisorResourceResponseModel, *http.Response, error) {
+	serverSideType := []string{}
+	if resourceType != "" &&
+		!strings.EqualFold(resourceType, TemplateSpecResourceType) &&
+		!strings.EqualFold(resourceType, DiskEncryptionSetResourceType) &&
+		isServerSideResourceTypeFilterSupported(hypervisor.GetConnectionType()) {
+		serverSideType = []string{resourceType}
+	}
+
+	var resources *citrixorchestration.HypervisorResourceResponseModel
+	var httpResp *http.Response
+	var err error
+	if hypervisorPoolName != "" {
+		req := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsGetHypervisorResourcePoolResources(ctx, hypervisor.GetId(), hypervisorPoolName)
+		req = req.Children(1)
+		if folderPath != "" {
+			req = req.Path(folderPath)
+		}
+		if len(serverSideType) > 0 {
+			req = req.Type_(serverSideType)
+		}
+		resources, httpResp, err = citrixdaasclient.AddRequestData(req, client).Execute()
+	} else {
+		req := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsGetHypervisorAllResources(ctx, hypervisor.GetId())
+		req = req.Children(1)
+		if folderPath != "" {
+			req = req.Path(folderPath)
+		}
+		if len(serverSideType) > 0 {
+			req = req.Type_(serverSideType)
+		}
+		resources, httpResp, err = citrixdaasclient.AddRequestData(req, client).Execute()
+	}
+	if err != nil {
+		return nil, httpResp, err
+	}
+
+	result := []citrixorchestration.HypervisorResourceResponseModel{}
+	for _, child := range resources.GetChildren() {
+		if matchesHypervisorResourceType(child, resourceType) {
+			result = append(result, child)
+		}
+	}
+
+	return result, httpResp, nil
+}
+
+func matchesHypervisorResourceType(resource citrixorchestration.HypervisorResourceResponseModel, resourceType string) bool {
+	if resourceType == "" || strings.EqualFold(resource.GetResourceType(), resourceType) {
+		return true
+	}
+	if strings.EqualFold(resourceType, TemplateSpecResourceType) || strings.EqualFold(resourceType, DiskEncryptionSetResourceType) {
+		return strings.HasSuffix(strings.ToLower(resource.GetXDPath()), "."+strings.ToLower(resourceType))
+	}
+	return false
+}
+
 func ValidateHypervisorResource(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, hypervisorName, hypervisorPoolName, resourcePath string) (bool, string) {
 	req := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsValidateHypervisorResourcePoolResource(ctx, hypervisorName, hypervisorPoolName)
 	var validationRequestModel citrixorchestration.HypervisorResourceValidationRequestModel
@@ -0,0 +1,238 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package util
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Default values for the provider level retry policy
+const DefaultRetryMaxAttempts int64 = 5
+const DefaultRetryBackoffBaseSeconds int64 = 2
+const DefaultRetryBackoffCapSeconds int64 = 60
+
+// RetryPolicy controls how requests sent to the Citrix APIs are retried when they are throttled or when the service is temporarily unavailable.
+// A single policy is shared by all API clients of the provider so that the concurrency limit applies to the provider as a whole.
+type RetryPolicy struct {
+	MaxAttempts       int
+	BackoffBase       time.Duration
+	BackoffCap        time.Duration
+	Jitter            bool
+	RespectRetryAfter bool
+
+	inFlight chan struct{}
+}
+
+// <summary>
+// Helper function to create a retry policy shared by the API clients
+// </summary>
+// <param name="maxAttempts">Maximum number of attempts for a request including the first attempt</param>
+// <param name="backoffBase">Delay before the first retry, doubled for every subsequent retry</param>
+// <param name="backoffCap">Upper bound of the delay between two attempts</param>
+// <param name="jitter">Whether to randomize the delay between two attempts</param>
+// <param name="respectRetryAfter">Whether to wait for the duration requested by the Retry-After response header</param>
+// <param name="maxConcurrentRequests">Maximum number of requests in flight at the same time. 0 means unlimited</param>
+// <returns>Retry policy</returns>
+func NewRetryPolicy(maxAttempts int, backoffBase, backoffCap time.Duration, jitter, respectRetryAfter bool, maxConcurrentRequests int) *RetryPolicy {
+	policy := &RetryPolicy{
+		MaxAttempts:       maxAttempts,
+		BackoffBase:       backoffBase,
+		BackoffCap:        backoffCap,
+		Jitter:            jitter,
+		RespectRetryAfter: respectRetryAfter,
+	}
+	if maxConcurrentRequests > 0 {
+		policy.inFlight = make(chan struct{}, maxConcurrentRequests)
+	}
+	return policy
+}
+
+// <summary>
+// Helper function to wrap the transport of an HTTP client with the retry policy
+// </summary>
+// <param name="client">HTTP client to wrap. The default HTTP client is used when nil</param>
+// <returns>A new HTTP client using the retry policy. The original client is left untouched</returns>
+func (p *RetryPolicy) WrapHttpClient(client *http.Client) *http.Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	wrapped := *client
+	base := wrapped.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	wrapped.Transport = &retryTransport{base: base, policy: p}
+	return &wrapped
+}
+
+type retryTransport struct {
+	base   http.RoundTripper
+	policy *RetryPolicy
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	attemptReq := req
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			var err error
+			attemptReq, err = cloneRequestForRetry(req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := t.policy.acquire(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if err != nil {
+			t.policy.release()
+			return nil, err
+		}
+
+		if attempt >= t.policy.MaxAttempts || !isRetryableResponse(req, resp) {
+			resp.Body = &releaseOnCloseBody{ReadCloser: resp.Body, release: t.policy.release}
+			return resp, nil
+		}
+
+		delay := t.policy.getRetryDelay(attempt, resp)
+		tflog.Info(ctx, "Retrying API request", map[string]interface{}{
+			"url":           req.URL.String(),
+			"method":        req.Method,
+			"statusCode":    resp.StatusCode,
+			"attempt":       attempt,
+			"delay":         delay.String(),
+			"transactionId": req.Header.Get("Citrix-TransactionId"),
+		})
+
+		// Drain the body so that the underlying connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		t.policy.release()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (p *RetryPolicy) acquire(ctx context.Context) error {
+	if p.inFlight == nil {
+		return nil
+	}
+	select {
+	case p.inFlight <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *RetryPolicy) release() {
+	if p.inFlight == nil {
+		return
+	}
+	<-p.inFlight
+}
+
+func (p *RetryPolicy) getRetryDelay(attempt int, resp *http.Response) time.Duration {
+	if p.RespectRetryAfter {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return retryAfter
+		}
+	}
+
+	delay := p.BackoffBase
+	for i := 1; i < attempt && delay < p.BackoffCap; i++ {
+		delay *= 2
+	}
+	if delay > p.BackoffCap {
+		delay = p.BackoffCap
+	}
+	if p.Jitter && delay > 1 {
+		// Equal jitter: wait between half and the full backoff delay
+		half := delay / 2
+		delay = half + time.Duration(rand.Int63n(int64(delay-half)))
+	}
+	return delay
+}
+
+func parseRetryAfter(retryAfter string) (time.Duration, bool) {
+	if retryAfter == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if retryAt, err := http.ParseTime(retryAfter); err == nil {
+		delay := time.Until(retryAt)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// Throttled requests and requests rejected with 503 were not processed by the service and are always safe to retry.
+// Gateway errors may be returned after the request has been processed, so only idempotent requests are retried for those.
+func isRetryableResponse(req *http.Request, resp *http.Response) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// Request body cannot be replayed
+		return false
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		switch req.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+			return true
+		}
+	}
+	return false
+}
+
+func cloneRequestForRetry(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
+
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	release  func()
+	released bool
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	if !b.released {
+		b.released = true
+		b.release()
+	}
+	return err
+}
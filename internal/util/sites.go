@@ -0,0 +1,176 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const SiteAttributeName = "site"
+
+// SiteClientInitializer creates the client of a named site configuration
+type SiteClientInitializer func(ctx context.Context) (*citrixdaasclient.CitrixDaasClient, diag.Diagnostics)
+
+// SiteClients holds the clients of the named site configurations of the provider.
+// Each client is initialized on first use, so that sites which are not used by the configuration are never signed into.
+type SiteClients struct {
+	mu    sync.Mutex
+	sites map[string]*siteClient
+}
+
+type siteClient struct {
+	once       sync.Once
+	initialize SiteClientInitializer
+	client     *citrixdaasclient.CitrixDaasClient
+	diags      diag.Diagnostics
+}
+
+func NewSiteClients() *SiteClients {
+	return &SiteClients{sites: map[string]*siteClient{}}
+}
+
+// <summary>
+// Helper function to add a named site configuration
+// </summary>
+// <param name="name">Name of the site configuration</param>
+// <param name="initialize">Function creating the client of the site on first use</param>
+func (s *SiteClients) AddSite(name string, initialize SiteClientInitializer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sites[name] = &siteClient{initialize: initialize}
+}
+
+func (s *SiteClients) getSiteNames() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := []string{}
+	for name := range s.sites {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *SiteClients) getClient(ctx context.Context, name string) (*citrixdaasclient.CitrixDaasClient, diag.Diagnostics, bool) {
+	s.mu.Lock()
+	site, ok := s.sites[name]
+	s.mu.Unlock()
+	if !ok {
+		return nil, nil, false
+	}
+
+	site.once.Do(func() {
+		tflog.Info(ctx, "Initializing Citrix API client for site "+name)
+		site.client, site.diags = site.initialize(ctx)
+	})
+	return site.client, site.diags, true
+}
+
+var siteClientsByDefaultClient sync.Map
+
+// <summary>
+// Helper function to register the named site configurations of the provider with its default client
+// </summary>
+// <param name="defaultClient">Client passed to resources and data sources in Configure</param>
+// <param name="siteClients">Named site configurations</param>
+func RegisterSiteClients(defaultClient *citrixdaasclient.CitrixDaasClient, siteClients *SiteClients) {
+	siteClientsByDefaultClient.Store(defaultClient, siteClients)
+}
+
+// SiteAttributeSource is implemented by tfsdk.Plan, tfsdk.State and tfsdk.Config
+type SiteAttributeSource interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// <summary>
+// Helper function to get the client selected by the `site` attribute of a resource or data source
+// </summary>
+// <param name="ctx">Request context</param>
+// <param name="diagnostics">Terraform diagnostics</param>
+// <param name="client">Client passed to the resource or data source in Configure</param>
+// <param name="sources">Plan, state or config to read the `site` attribute from. The first non null value is used</param>
+// <returns>Client of the selected site, or the default client when no site is selected</returns>
+func GetClientForSite(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, sources ...SiteAttributeSource) *citrixdaasclient.CitrixDaasClient {
+	if client == nil {
+		return nil
+	}
+
+	site := types.StringNull()
+	for _, source := range sources {
+		diags := source.GetAttribute(ctx, path.Root(SiteAttributeName), &site)
+		if diags.HasError() {
+			diagnostics.Append(diags...)
+			return client
+		}
+		if !site.IsNull() {
+			break
+		}
+	}
+	if site.IsNull() || site.IsUnknown() {
+		return client
+	}
+
+	siteName := site.ValueString()
+	value, ok := siteClientsByDefaultClient.Load(client)
+	if !ok {
+		diagnostics.AddAttributeError(
+			path.Root(SiteAttributeName),
+			"Site not found",
+			fmt.Sprintf("Site %s is not configured. Add the site to the `sites` attribute of the provider configuration.", siteName),
+		)
+		return client
+	}
+
+	siteClients := value.(*SiteClients)
+	siteClient, diags, ok := siteClients.getClient(ctx, siteName)
+	if !ok {
+		diagnostics.AddAttributeError(
+			path.Root(SiteAttributeName),
+			"Site not found",
+			fmt.Sprintf("Site %s is not configured. Available sites: %s", siteName, strings.Join(siteClients.getSiteNames(), ", ")),
+		)
+		return client
+	}
+	diagnostics.Append(diags...)
+	return siteClient
+}
+
+// <summary>
+// Helper function to get the schema of the `site` attribute of a resource
+// </summary>
+// <returns>Schema of the `site` attribute</returns>
+func GetSiteSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Name of the site configuration in the `sites` attribute of the provider used to manage the resource. Defaults to the `cvad_config` and `storefront_remote_host` configuration of the provider. " +
+			"Changing the site forces a new resource to be created. Resources of a named site cannot be imported.",
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// <summary>
+// Helper function to get the schema of the `site` attribute of a data source
+// </summary>
+// <returns>Schema of the `site` attribute</returns>
+func GetSiteDataSourceSchema() datasourceSchema.StringAttribute {
+	return datasourceSchema.StringAttribute{
+		Description: "Name of the site configuration in the `sites` attribute of the provider used to read the data source. Defaults to the `cvad_config` and `storefront_remote_host` configuration of the provider.",
+		Optional:    true,
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright © 2024. Citrix Systems, Inc.
+
+package util
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Default timeouts in minutes for Orchestration jobs and QuickCreate tasks
+const DefaultMachineCatalogCreateTimeout int64 = 120
+const DefaultMachineCatalogUpdateTimeout int64 = 60
+const DefaultMachineCatalogDeleteTimeout int64 = 60
+const DefaultDeliveryGroupCreateTimeout int64 = 10
+const DefaultDeliveryGroupUpdateTimeout int64 = 10
+const DefaultDeliveryGroupDeleteTimeout int64 = 10
+const DefaultHypervisorCreateTimeout int64 = 10
+const DefaultHypervisorUpdateTimeout int64 = 10
+const DefaultHypervisorResourcePoolCreateTimeout int64 = 10
+const DefaultHypervisorResourcePoolUpdateTimeout int64 = 5
+const DefaultStoreFrontServerCreateTimeout int64 = 10
+const DefaultStoreFrontServerUpdateTimeout int64 = 10
+const DefaultQcsDirectoryConnectionCreateTimeout int64 = 5
+const DefaultQcsDeploymentCreateTimeout int64 = 120
+const DefaultQcsDeploymentUpdateTimeout int64 = 60
+const DefaultQcsDeploymentSettingsUpdateTimeout int64 = 10
+const DefaultQcsDeploymentDeleteTimeout int64 = 60
+const DefaultQcsImageCreateTimeout int64 = 120
+const DefaultImageVersionCreateTimeout int64 = 120
+const DefaultImageVersionDeleteTimeout int64 = 30
+const DefaultImageDefinitionDeleteTimeout int64 = 10
+
+// TimeoutConfigs describes which operations of a resource support a configurable timeout, along with the default and minimum values in minutes.
+type TimeoutConfigs struct {
+	Create        bool
+	CreateDefault int64
+	CreateMin     int64
+
+	Update        bool
+	UpdateDefault int64
+	UpdateMin     int64
+
+	Delete        bool
+	DeleteDefault int64
+	DeleteMin     int64
+}
+
+// <summary>
+// Helper function to build the `timeouts` nested attribute schema for a resource
+// </summary>
+// <param name="resourceName">Display name of the resource used in attribute descriptions</param>
+// <param name="timeoutConfigs">Operations supported by the resource with their default and minimum timeouts</param>
+// <returns>Nested attribute schema for the resource timeouts</returns>
+func GetTimeoutsSchema(resourceName string, timeoutConfigs TimeoutConfigs) schema.SingleNestedAttribute {
+	attributes := map[string]schema.Attribute{}
+	if timeoutConfigs.Create {
+		attributes["create"] = getTimeoutAttribute(resourceName, "created", timeoutConfigs.CreateDefault, timeoutConfigs.CreateMin)
+	}
+	if timeoutConfigs.Update {
+		attributes["update"] = getTimeoutAttribute(resourceName, "updated", timeoutConfigs.UpdateDefault, timeoutConfigs.UpdateMin)
+	}
+	if timeoutConfigs.Delete {
+		attributes["delete"] = getTimeoutAttribute(resourceName, "deleted", timeoutConfigs.DeleteDefault, timeoutConfigs.DeleteMin)
+	}
+
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("Timeouts in minutes for long running operations on the %s.", resourceName),
+		Optional:    true,
+		Attributes:  attributes,
+	}
+}
+
+func getTimeoutAttribute(resourceName string, operation string, defaultValue int64, minValue int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: fmt.Sprintf("Timeout in minutes to wait for the %s to be %s. Defaults to `%d`.", resourceName, operation, defaultValue),
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(minValue),
+		},
+	}
+}
+
+// <summary>
+// Helper function to resolve a configured timeout, falling back to the default value when the timeout is not configured
+// </summary>
+// <param name="timeout">Timeout in minutes from the Terraform plan or state</param>
+// <param name="defaultValue">Default timeout in minutes</param>
+// <returns>Timeout in minutes</returns>
+func GetTimeoutValue(timeout types.Int64, defaultValue int64) int {
+	if timeout.IsNull() || timeout.IsUnknown() {
+		return int(defaultValue)
+	}
+	return int(timeout.ValueInt64())
+}
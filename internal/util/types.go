@@ -343,6 +343,65 @@ func TypedArrayToObjectSet[objTyp ModelWithAttributes](ctx context.Context, diag
 	return set
 }
 
+// <summary>
+// Helper function to convert a native terraform map of objects to a golang map of the specified type
+// Use TypedMapToObjectMap to go the other way.
+// </summary>
+// <param name="ctx">context</param>
+// <param name="diagnostics">Any issues will be appended to these diagnostics</param>
+// <param name="v">Map of object in the native terraform types.Map wrapper</param>
+// <returns>Map of the specified type</returns>
+func ObjectMapToTypedMap[objTyp any](ctx context.Context, diagnostics *diag.Diagnostics, v types.Map) map[string]objTyp {
+	res := make(map[string]types.Object, len(v.Elements()))
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	// convert to map of TF type
+	diags := v.ElementsAs(ctx, &res, false)
+	if diags != nil {
+		diagnostics.Append(diags...)
+		return nil
+	}
+
+	// convert to map of real objects
+	typedMap := make(map[string]objTyp, len(res))
+	for key, val := range res {
+		typedMap[key] = ObjectValueToTypedObject[objTyp](ctx, diagnostics, val)
+	}
+	return typedMap
+}
+
+// <summary>
+// Helper function to convert a golang map to a native terraform map of objects.
+// Use ObjectMapToTypedMap to go the other way.
+// </summary>
+// <param name="diagnostics">Any issues will be appended to these diagnostics</param>
+// <param name="v">Map of objects</param>
+// <returns>types.Map</returns>
+func TypedMapToObjectMap[objTyp ModelWithAttributes](ctx context.Context, diagnostics *diag.Diagnostics, v map[string]objTyp) types.Map {
+	var t objTyp
+	attributesMap, err := AttributeMapFromObject(t)
+	if err != nil {
+		diagnostics.AddError("Error converting schema to attribute map", err.Error())
+	}
+
+	if v == nil {
+		return types.MapNull(types.ObjectType{AttrTypes: attributesMap})
+	}
+
+	res := make(map[string]types.Object, len(v))
+	for key, val := range v {
+		res[key] = TypedObjectToObjectValue(ctx, diagnostics, val)
+	}
+	typedMap, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: attributesMap}, res)
+	if diags != nil {
+		diagnostics.Append(diags...)
+		return types.MapNull(types.ObjectType{AttrTypes: attributesMap})
+	}
+	return typedMap
+}
+
 // <summary>
 // Helper function to convert a terraform list of terraform strings to array of golang primitive strings.
 // Use StringArrayToStringList to go the other way.